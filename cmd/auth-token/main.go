@@ -0,0 +1,37 @@
+// auth-token - выдача токена пользователя для заголовка "Authorization: Bearer <токен>".
+//
+// Токен подписывается тем же ключом, что задан серверу в FORUM_AUTH_TOKEN_KEY.
+//
+//	FORUM_AUTH_TOKEN_KEY=<ключ> go run ./cmd/auth-token -user <nickname> -ttl 24h
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/storm5758/Forum-test/internal/app/auth"
+)
+
+func main() {
+	user := flag.String("user", "", "nickname пользователя")
+	ttl := flag.Duration("ttl", 24*time.Hour, "срок действия токена")
+	flag.Parse()
+
+	key := os.Getenv("FORUM_AUTH_TOKEN_KEY")
+	if len(key) == 0 {
+		log.Fatal("FORUM_AUTH_TOKEN_KEY is not set")
+	}
+	if len(*user) == 0 || *ttl <= 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	tokens, err := auth.NewTokens([]byte(key))
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(tokens.Issue(*user, *ttl))
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/spf13/afero"
+	"github.com/storm5758/Forum-test/internal/pkg/tlsutil"
 	"github.com/storm5758/Forum-test/pkg/api"
 	"github.com/yandex/pandora/cli"
 	"github.com/yandex/pandora/core"
@@ -28,11 +29,17 @@ type Sample struct {
 
 type GunConfig struct {
 	Target string `validate:"required"` // Configuration will fail, without target defined
+	// TLS settings, plaintext if both are empty
+	CAFile     string `config:"ca_file"`
+	ServerName string `config:"server_name"`
+	// Client certificate for mutual TLS
+	CertFile string `config:"cert_file"`
+	KeyFile  string `config:"key_file"`
 }
 
 type Gun struct {
 	// Configured on construction.
-	client grpc.ClientConn
+	client *grpc.ClientConn
 	conf   GunConfig
 	// Configured on Bind, before shooting
 	aggr core.Aggregator // May be your custom Aggregator.
@@ -44,16 +51,25 @@ func NewGun(conf GunConfig) *Gun {
 }
 
 func (g *Gun) Bind(aggr core.Aggregator, deps core.GunDeps) error {
+	creds, _, err := tlsutil.DialOption(tlsutil.Config{
+		CertFile:   g.conf.CertFile,
+		KeyFile:    g.conf.KeyFile,
+		CAFile:     g.conf.CAFile,
+		ServerName: g.conf.ServerName,
+	})
+	if err != nil {
+		log.Fatalf("FATAL: %s", err)
+	}
 	// create gRPC stub at gun initialization
 	conn, err := grpc.Dial(
 		g.conf.Target,
-		grpc.WithInsecure(),
+		creds,
 		grpc.WithTimeout(time.Second),
 		grpc.WithUserAgent("load test, pandora custom shooter"))
 	if err != nil {
 		log.Fatalf("FATAL: %s", err)
 	}
-	g.client = *conn
+	g.client = conn
 	g.aggr = aggr
 	g.GunDeps = deps
 	return nil
@@ -88,8 +104,7 @@ func (g *Gun) shoot(ammo *Ammo) {
 	code := 0
 	sample := netsample.Acquire(ammo.Tag)
 
-	conn := g.client
-	client := api.NewUserClient(&conn)
+	client := api.NewUserClient(g.client)
 
 	switch ammo.Tag {
 	case "/MyCase1":
@@ -1,5 +1,10 @@
 package main
 
+import (
+	"os"
+	"time"
+)
+
 const (
 	// Database config
 	Host     = "localhost"
@@ -7,4 +12,85 @@ const (
 	User     = "test"
 	Password = "test"
 	DBname   = "forum"
+
+	// Окно, в течение которого сессия после записи читает с основного сервера
+	ReadYourWritesWindow = 3 * time.Second
+
+	// Cache config
+	CacheSize = 10000
+	CacheTTL  = time.Minute
+
+	// Срок, в течение которого удалённое сообщение можно восстановить, если не задан FORUM_POST_RETENTION
+	DefaultPostRetention = 30 * 24 * time.Hour
+
+	// Эмодзи реакций на сообщения, если не задан FORUM_REACTIONS
+	DefaultReactions = "👍,👎,😄,🎉,😕,❤️,🚀,👀"
+
+	// Каталог аватаров, если не задан FORUM_AVATAR_DIR
+	DefaultAvatarDir = "./avatars"
+
+	// Каталог файлов сообщений, если не задан FORUM_ATTACHMENT_DIR
+	DefaultAttachmentDir = "./attachments"
+	// Суммарный размер файлов одного пользователя в байтах, если не задан FORUM_ATTACHMENT_QUOTA
+	DefaultAttachmentQuota = 100 << 20
+	// Срок, в течение которого загруженный файл нужно прикрепить к сообщению, иначе он удаляется
+	AttachmentOrphanTTL = 24 * time.Hour
+)
+
+// DSN реплик базы данных через ";". Без реплик чтение выполняется на основном сервере.
+var DBReplicas = os.Getenv("FORUM_DB_REPLICAS")
+
+// Адрес Redis для кэша, общего между экземплярами сервера.
+// Если не указан, используется кэш в памяти процесса.
+var CacheRedisAddr = os.Getenv("FORUM_CACHE_REDIS_ADDR")
+
+// Ключ подписи токенов страниц. Должен совпадать у всех экземпляров сервера.
+// Если не указан, генерируется при запуске, и токены не переживают перезапуск.
+var PageTokenKey = os.Getenv("FORUM_PAGE_TOKEN_KEY")
+
+// Ключ подписи отметок времени записи клиента (заголовок X-Wrote-At). Должен совпадать у всех экземпляров сервера.
+// Если не указан, генерируется при запуске, и после записи клиент читает свои изменения с основного сервера
+// только через тот же экземпляр.
+var SessionKey = os.Getenv("FORUM_SESSION_KEY")
+
+// Ключ подписи токенов пользователей, см. cmd/auth-token. Должен совпадать у всех экземпляров сервера.
+// Если не указан, генерируется при запуске, и доступны только методы без входа.
+var AuthTokenKey = os.Getenv("FORUM_AUTH_TOKEN_KEY")
+
+// TLS config. Без сертификата сервер работает без шифрования.
+// Файлы сертификатов перечитываются при изменении.
+var (
+	// Сертификат и ключ сервера
+	TLSCertFile = os.Getenv("FORUM_TLS_CERT_FILE")
+	TLSKeyFile  = os.Getenv("FORUM_TLS_KEY_FILE")
+	// УЦ клиентских сертификатов. Если указан, gRPC сервер проверяет сертификат клиента (mutual TLS)
+	TLSClientCAFile = os.Getenv("FORUM_TLS_CLIENT_CA_FILE")
+	// Требовать сертификат от каждого клиента gRPC, а не только проверять предъявленный
+	TLSRequireClientCert = os.Getenv("FORUM_TLS_REQUIRE_CLIENT_CERT") == "true"
+	// УЦ сертификата сервера, которым HTTP шлюз проверяет gRPC сервер
+	TLSServerCAFile = os.Getenv("FORUM_TLS_SERVER_CA_FILE")
+)
+
+// Outbox config. События из outbox всегда публикуются в шину внутри процесса,
+// дополнительно их можно писать в журнал и в файл.
+var (
+	// Файл, в который дописываются события по одному JSON на строку
+	OutboxFile = os.Getenv("FORUM_OUTBOX_FILE")
+	// Писать события в журнал
+	OutboxLog = os.Getenv("FORUM_OUTBOX_LOG") == "true"
+)
+
+// Срок хранения удалённых сообщений в формате time.ParseDuration, например "720h".
+var PostRetention = os.Getenv("FORUM_POST_RETENTION")
+
+// Эмодзи, которыми можно реагировать на сообщения, через ",". Порядок задаёт порядок вывода.
+var Reactions = os.Getenv("FORUM_REACTIONS")
+
+// Каталог, в котором хранятся файлы аватаров пользователей.
+var AvatarDir = os.Getenv("FORUM_AVATAR_DIR")
+
+// Файлы, прикреплённые к сообщениям: каталог хранения и квота пользователя в байтах.
+var (
+	AttachmentDir   = os.Getenv("FORUM_ATTACHMENT_DIR")
+	AttachmentQuota = os.Getenv("FORUM_ATTACHMENT_QUOTA")
 )
@@ -2,18 +2,36 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"log"
 	"net/http"
 	_ "net/http/pprof"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/go-redis/redis/v8"
 	_ "github.com/jackc/pgx/v4"
 	_ "github.com/jackc/pgx/v4/stdlib"
 	_ "github.com/lib/pq"
+	"github.com/storm5758/Forum-test/internal/app/attachment"
+	"github.com/storm5758/Forum-test/internal/app/auth"
+	"github.com/storm5758/Forum-test/internal/app/events"
+	"github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/moderation"
+	"github.com/storm5758/Forum-test/internal/app/notification"
+	"github.com/storm5758/Forum-test/internal/app/outbox"
+	"github.com/storm5758/Forum-test/internal/app/repository/cached"
 	postgres "github.com/storm5758/Forum-test/internal/app/repository/postgres"
 	"github.com/storm5758/Forum-test/internal/app/server"
 	services "github.com/storm5758/Forum-test/internal/app/services"
+	"github.com/storm5758/Forum-test/internal/app/webhook"
+	"github.com/storm5758/Forum-test/internal/pkg/cache"
 	"github.com/storm5758/Forum-test/internal/pkg/database"
+	"github.com/storm5758/Forum-test/internal/pkg/pagetoken"
+	"github.com/storm5758/Forum-test/internal/pkg/storage"
+	"github.com/storm5758/Forum-test/internal/pkg/tlsutil"
 )
 
 func main() {
@@ -23,7 +41,9 @@ func main() {
 	// connection string
 	psqlConn := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=disable", Host, Port, User, Password, DBname)
 
-	db, err := database.NewPostgres(ctx, psqlConn, "pgx")
+	db, err := database.NewCluster(ctx, "pgx", psqlConn, replicaDSNs(),
+		database.WithReadYourWrites(ReadYourWritesWindow),
+	)
 	if err != nil {
 		log.Fatal("ping database error", err)
 	}
@@ -31,15 +51,114 @@ func main() {
 
 	// ceate repository
 	repo := postgres.NewRepository(db)
+	defer repo.Close()
+
+	// create cached repositories
+	c := newCache()
+	userRepo := cached.NewUserRepository(repo, c, CacheTTL)
+	forumRepo := cached.NewForumRepository(repo, c, CacheTTL)
+	threadRepo := cached.NewThreadRepository(repo, c, CacheTTL)
+	postRepo := cached.NewPostRepository(repo, c, CacheTTL)
+	reportRepo := cached.NewReportRepository(repo, repo, c, CacheTTL)
+
+	pageTokens, err := pagetoken.NewCodec([]byte(PageTokenKey))
+	if err != nil {
+		log.Fatal("can't create page token codec: ", err)
+	}
+	if len(PageTokenKey) == 0 {
+		log.Println("FORUM_PAGE_TOKEN_KEY is not set, page tokens are valid until restart")
+	}
+
+	if len(SessionKey) == 0 {
+		log.Println("FORUM_SESSION_KEY is not set, read-your-writes marks are valid on this instance until restart")
+	}
+
+	tokens, err := auth.NewTokens([]byte(AuthTokenKey))
+	if err != nil {
+		log.Fatal("can't create auth tokens: ", err)
+	}
+	if len(AuthTokenKey) == 0 {
+		log.Println("FORUM_AUTH_TOKEN_KEY is not set, only anonymous methods are available")
+	}
+
+	// события веток из уведомлений Postgres, общих для всех экземпляров сервера
+	hub := events.NewHub()
+	go database.NewListener(db.Primary(), events.Channel, hub.HandleNotification).Run(ctx)
+
+	// доменные события из outbox
+	bus := outbox.NewBus()
+	bus.Subscribe(webhook.Enqueue(repo), models.EventThreadCreated, models.EventPostsCreated, models.EventThreadVoted)
+	bus.Subscribe(notification.Notify(repo), models.EventThreadCreated, models.EventPostsCreated)
+	sinks := []outbox.Sink{bus}
+	if OutboxLog {
+		sinks = append(sinks, outbox.LogSink{})
+	}
+	if len(OutboxFile) > 0 {
+		fileSink, err := outbox.NewFileSink(OutboxFile)
+		if err != nil {
+			log.Fatal("can't open outbox file: ", err)
+		}
+		defer fileSink.Close()
+		sinks = append(sinks, fileSink)
+	}
+	go outbox.NewRelay(repo, sinks).Run(ctx)
+	go webhook.NewDispatcher(repo).Run(ctx)
+
+	// окончательное удаление сообщений
+	retention := DefaultPostRetention
+	if len(PostRetention) > 0 {
+		if retention, err = time.ParseDuration(PostRetention); err != nil {
+			log.Fatal("invalid FORUM_POST_RETENTION: ", err)
+		}
+	}
+	go moderation.NewPurger(db.Primary(), repo, retention).Run(ctx)
+
+	// файлы аватаров на локальном диске
+	avatarDir := DefaultAvatarDir
+	if len(AvatarDir) > 0 {
+		avatarDir = AvatarDir
+	}
+	avatars, err := storage.NewDisk(avatarDir)
+	if err != nil {
+		log.Fatal("can't open avatar storage: ", err)
+	}
+
+	// файлы сообщений на локальном диске и удаление неприкреплённых файлов
+	attachmentDir := DefaultAttachmentDir
+	if len(AttachmentDir) > 0 {
+		attachmentDir = AttachmentDir
+	}
+	attachments, err := storage.NewDisk(attachmentDir)
+	if err != nil {
+		log.Fatal("can't open attachment storage: ", err)
+	}
+	quota := int64(DefaultAttachmentQuota)
+	if len(AttachmentQuota) > 0 {
+		if quota, err = strconv.ParseInt(AttachmentQuota, 10, 64); err != nil || quota <= 0 {
+			log.Fatal("invalid FORUM_ATTACHMENT_QUOTA: ", AttachmentQuota)
+		}
+	}
+	go attachment.NewCollector(db.Primary(), repo, attachments, AttachmentOrphanTTL).Run(ctx)
 
 	// create server
 	srv, err := server.New(server.Services{
-		Admin:  services.NewAdminService(),
-		User:   services.NewUserService(repo),
-		Forum:  services.NewForumService(),
-		Post:   services.NewPostService(),
-		Thread: services.NewThreadService(),
-	})
+		Admin:        services.NewAdminService(),
+		User:         services.NewUserService(userRepo, avatars),
+		Forum:        services.NewForumService(forumRepo, userRepo, pageTokens),
+		Post:         services.NewPostService(postRepo, threadRepo, userRepo, forumRepo, repo, reactions()),
+		Thread:       services.NewThreadService(threadRepo, forumRepo, userRepo, postRepo, repo, pageTokens, hub),
+		Search:       services.NewSearchService(repo, pageTokens),
+		Webhook:      services.NewWebhookService(repo, forumRepo),
+		Ban:          services.NewBanService(repo, userRepo, forumRepo),
+		Report:       services.NewReportService(reportRepo, postRepo, threadRepo, forumRepo, pageTokens),
+		Notification: services.NewNotificationService(repo, pageTokens),
+		Attachment:   services.NewAttachmentService(repo, postRepo, attachments, quota),
+	},
+		server.WithAuthorizer(auth.NewAuthorizer(services.NewPolicy(forumRepo, threadRepo, repo, repo), repo, tokens)),
+		server.WithTLS(serverTLSConfig(), gatewayTLSConfig()),
+		server.WithAvatars(avatars),
+		server.WithSessionKey([]byte(SessionKey)),
+	)
 	if err != nil {
 		log.Fatalf("can't create server: %s", err.Error())
 	}
@@ -51,3 +170,63 @@ func main() {
 		log.Println(err)
 	}
 }
+
+func replicaDSNs() []string {
+	var dsns []string
+	for _, dsn := range strings.Split(DBReplicas, ";") {
+		if dsn = strings.TrimSpace(dsn); len(dsn) > 0 {
+			dsns = append(dsns, dsn)
+		}
+	}
+	return dsns
+}
+
+func reactions() []string {
+	list := Reactions
+	if len(list) == 0 {
+		list = DefaultReactions
+	}
+	var emoji []string
+	for _, e := range strings.Split(list, ",") {
+		if e = strings.TrimSpace(e); len(e) > 0 {
+			emoji = append(emoji, e)
+		}
+	}
+	return emoji
+}
+
+func newCache() cache.Cache {
+	if len(CacheRedisAddr) == 0 {
+		return cache.NewLRU(CacheSize)
+	}
+	return cache.NewRedis(redis.NewClient(&redis.Options{Addr: CacheRedisAddr}), "forum:")
+}
+
+func serverTLSConfig() tlsutil.Config {
+	cfg := tlsutil.Config{
+		CertFile: TLSCertFile,
+		KeyFile:  TLSKeyFile,
+		CAFile:   TLSClientCAFile,
+	}
+	if len(TLSClientCAFile) > 0 {
+		cfg.ClientAuth = tls.VerifyClientCertIfGiven
+		if TLSRequireClientCert {
+			cfg.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+	}
+	return cfg
+}
+
+// gatewayTLSConfig - HTTP шлюз подключается к gRPC серверу с сертификатом сервера,
+// поэтому при mutual TLS сертификат должен допускать использование клиентом.
+func gatewayTLSConfig() tlsutil.Config {
+	if len(TLSCertFile) == 0 {
+		return tlsutil.Config{}
+	}
+	return tlsutil.Config{
+		CertFile:   TLSCertFile,
+		KeyFile:    TLSKeyFile,
+		CAFile:     TLSServerCAFile,
+		ServerName: "localhost",
+	}
+}
@@ -0,0 +1,55 @@
+// webhook-receiver - локальный приёмник веб-хуков для проверки доставки событий.
+//
+// Проверяет подпись каждого запроса и печатает полученные события.
+// Флаг -fail задаёт, сколько первых запросов отклонить, чтобы проверить повторные попытки
+// и попадание доставки в список недоставленных.
+//
+//	go run ./cmd/webhook-receiver -secret <secret из WebhookCreate> -fail 2
+package main
+
+import (
+	"flag"
+	"io"
+	"log"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/storm5758/Forum-test/internal/app/webhook"
+)
+
+func main() {
+	addr := flag.String("addr", ":8090", "адрес приёмника")
+	secret := flag.String("secret", "", "секрет веб-хука")
+	fail := flag.Int64("fail", 0, "сколько первых запросов отклонить с кодом 503")
+	maxAge := flag.Duration("max-age", 5*time.Minute, "допустимый возраст X-Forum-Timestamp")
+	flag.Parse()
+
+	var received int64
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		delivery := r.Header.Get(webhook.HeaderDelivery)
+		if !webhook.Verify(*secret, r.Header.Get(webhook.HeaderTimestamp), r.Header.Get(webhook.HeaderSignature), body, *maxAge) {
+			log.Printf("delivery %s: invalid signature", delivery)
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+
+		if n := atomic.AddInt64(&received, 1); n <= *fail {
+			log.Printf("delivery %s: rejected (%d of %d)", delivery, n, *fail)
+			http.Error(w, "rejected by -fail", http.StatusServiceUnavailable)
+			return
+		}
+
+		log.Printf("delivery %s: %s %s", delivery, r.Header.Get(webhook.HeaderEvent), body)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	log.Println("listen webhooks on", *addr)
+	log.Fatal(http.ListenAndServe(*addr, nil))
+}
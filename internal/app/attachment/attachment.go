@@ -0,0 +1,123 @@
+// Package attachment проверяет файлы, прикрепляемые к сообщениям, и удаляет неиспользуемые файлы.
+package attachment
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"mime"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// MaxFileSize - размер загружаемого файла в байтах
+	MaxFileSize = 8 << 20
+	// MaxPerPost - файлов, которые можно прикрепить к одному сообщению
+	MaxPerPost = 10
+	// MaxFilenameLength - длина имени файла в символах, более длинные имена обрезаются
+	MaxFilenameLength = 255
+
+	// Path - путь HTTP шлюза, по которому загружаются и скачиваются файлы
+	Path = "/api/attachments"
+
+	// DefaultContentType - тип файлов, содержимое которых не относится к разрешённым типам.
+	// Такие файлы только скачиваются, браузер их не открывает.
+	DefaultContentType = "application/octet-stream"
+)
+
+var (
+	ErrTooLarge = errors.New("attachment is too large")
+	ErrEmpty    = errors.New("attachment is empty")
+)
+
+// contentTypes - типы, которые сохраняются как есть, true - файл можно открыть в браузере.
+// Тип определяется по содержимому, поэтому HTML и SVG со скриптами сохраняются как DefaultContentType.
+var contentTypes = map[string]bool{
+	"image/png":                 true,
+	"image/jpeg":                true,
+	"image/gif":                 true,
+	"image/webp":                true,
+	"text/plain; charset=utf-8": true,
+	"application/pdf":           false,
+	"application/zip":           false,
+	"application/x-gzip":        false,
+}
+
+// ContentType определяет тип файла по содержимому. Типы вне разрешённых заменяются DefaultContentType.
+func ContentType(data []byte) string {
+	contentType := http.DetectContentType(data)
+	if _, ok := contentTypes[contentType]; !ok {
+		return DefaultContentType
+	}
+	return contentType
+}
+
+// Inline проверяет, можно ли открыть файл типа contentType в браузере, а не только скачать.
+func Inline(contentType string) bool {
+	return contentTypes[contentType]
+}
+
+// Validate проверяет размер загружаемого файла.
+func Validate(data []byte) error {
+	if len(data) == 0 {
+		return ErrEmpty
+	}
+	if len(data) > MaxFileSize {
+		return ErrTooLarge
+	}
+	return nil
+}
+
+// Filename оставляет от имени загруженного файла имя без каталогов и управляющих символов.
+// Пустое имя заменяется на "file".
+func Filename(name string) string {
+	name = strings.ToValidUTF8(name, "")
+	name = path.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, name)
+	name = strings.TrimSpace(name)
+	if utf8.RuneCountInString(name) > MaxFilenameLength {
+		name = string([]rune(name)[:MaxFilenameLength])
+	}
+	if len(name) == 0 || name == "." || name == ".." || name == "/" {
+		return "file"
+	}
+	return name
+}
+
+// Disposition возвращает заголовок Content-Disposition для скачивания файла.
+func Disposition(filename, contentType string) string {
+	disposition := "attachment"
+	if Inline(contentType) {
+		disposition = "inline"
+	}
+	if header := mime.FormatMediaType(disposition, map[string]string{"filename": filename}); len(header) != 0 {
+		return header
+	}
+	return disposition
+}
+
+// NewKey возвращает случайный ключ содержимого файла в хранилище.
+// Ключ не зависит от имени файла, поэтому файлы с одинаковыми именами не пересекаются.
+func NewKey() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	sum := hex.EncodeToString(b[:])
+	return sum[:2] + "/" + sum, nil
+}
+
+// URL возвращает путь, по которому файл id скачивается через HTTP шлюз.
+func URL(id int64) string {
+	return Path + "/" + strconv.FormatInt(id, 10)
+}
@@ -0,0 +1,75 @@
+package attachment
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+	"github.com/storm5758/Forum-test/internal/pkg/database"
+	"github.com/storm5758/Forum-test/internal/pkg/storage"
+)
+
+const (
+	// CollectInterval - период удаления неиспользуемых файлов
+	CollectInterval = time.Hour
+	// CollectBatchSize - файлов, удаляемых за один запрос
+	CollectBatchSize = 500
+)
+
+// Collector удаляет файлы, которые не прикрепили к сообщению в течение ttl после загрузки,
+// и файлы окончательно удалённых сообщений.
+// Сведения о файле удаляются раньше содержимого, поэтому файл нельзя прикрепить во время удаления,
+// а при ошибке хранилища остаётся только недоступное содержимое.
+// Проход выполняет один экземпляр сервера за раз, чтобы экземпляры не выбирали пакеты файлов
+// наперегонки, см. database.RunSingleton.
+type Collector struct {
+	db                   *sqlx.DB
+	attachmentRepository repository.Attachment
+	files                storage.Storage
+	ttl                  time.Duration
+}
+
+// NewCollector возвращает Collector, берущий блокировку на основном сервере db.
+func NewCollector(db *sqlx.DB, attachmentRepository repository.Attachment, files storage.Storage, ttl time.Duration) *Collector {
+	return &Collector{
+		db:                   db,
+		attachmentRepository: attachmentRepository,
+		files:                files,
+		ttl:                  ttl,
+	}
+}
+
+// Run удаляет файлы раз в CollectInterval до отмены ctx.
+func (c *Collector) Run(ctx context.Context) {
+	database.RunSingleton(ctx, c.db, database.LockKindCollectAttachments, CollectInterval, c.collect)
+}
+
+// collect удаляет файлы пакетами по CollectBatchSize, пока они не закончатся.
+func (c *Collector) collect(ctx context.Context) error {
+	before := time.Now().Add(-c.ttl)
+	var total int
+	defer func() {
+		if total > 0 {
+			log.Printf("attachment: deleted %d orphaned files", total)
+		}
+	}()
+	for {
+		deleted, err := c.attachmentRepository.DeleteOrphanAttachments(ctx, before, CollectBatchSize)
+		if err != nil {
+			return err
+		}
+		keys := make([]string, 0, len(deleted))
+		for _, a := range deleted {
+			keys = append(keys, a.Key)
+		}
+		if err := c.files.Delete(ctx, keys...); err != nil {
+			return err
+		}
+		total += len(deleted)
+		if len(deleted) < CollectBatchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKeyAuthorization - ключ метаданных запроса с токеном пользователя "Bearer <токен>", см. Tokens.
+// HTTP шлюз передаёт в него заголовок Authorization.
+const MetadataKeyAuthorization = "authorization"
+
+// metadataKeyUser - ключ, которым раньше передавался nickname без подтверждения.
+// Запросы с ним отклоняются, чтобы клиент не считал себя вошедшим.
+const metadataKeyUser = "x-forum-user"
+
+const bearerPrefix = "bearer "
+
+type userKey struct{}
+
+// NewContext возвращает контекст с nickname вызывающего пользователя.
+func NewContext(ctx context.Context, nickname string) context.Context {
+	return context.WithValue(ctx, userKey{}, nickname)
+}
+
+// UserFromContext возвращает nickname пользователя, от имени которого выполняется запрос.
+func UserFromContext(ctx context.Context) (string, bool) {
+	nickname, ok := ctx.Value(userKey{}).(string)
+	return nickname, ok && len(nickname) > 0
+}
+
+// tokenFromMetadata достаёт токен из входящих метаданных gRPC.
+// ok = false, если метаданные содержат пользователя без токена или заголовок другого вида.
+func tokenFromMetadata(ctx context.Context) (token string, ok bool) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if len(md.Get(metadataKeyUser)) > 0 {
+		return "", false
+	}
+	values := md.Get(MetadataKeyAuthorization)
+	if len(values) == 0 {
+		return "", true
+	}
+	if len(values) > 1 || len(values[0]) < len(bearerPrefix) || !strings.EqualFold(values[0][:len(bearerPrefix)], bearerPrefix) {
+		return "", false
+	}
+	token = strings.TrimSpace(values[0][len(bearerPrefix):])
+	return token, len(token) > 0
+}
@@ -0,0 +1,119 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Authorizer проверяет права вызывающего пользователя согласно политике.
+// Пользователь определяется только по токену, подписанному tokens.
+type Authorizer struct {
+	policy         Policy
+	roleRepository repository.Role
+	tokens         *Tokens
+}
+
+// NewAuthorizer return new instance of Authorizer.
+func NewAuthorizer(policy Policy, roleRepository repository.Role, tokens *Tokens) *Authorizer {
+	return &Authorizer{
+		policy:         policy,
+		roleRepository: roleRepository,
+		tokens:         tokens,
+	}
+}
+
+// UnaryServerInterceptor проверяет права на вызов unary методов.
+func (a *Authorizer) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authorize(ctx, info.FullMethod, req)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor проверяет права на вызов stream методов.
+// Объект запроса для stream методов недоступен, поэтому проверка владельца не выполняется.
+func (a *Authorizer) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authorize(ss.Context(), info.FullMethod, nil)
+		if err != nil {
+			return err
+		}
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
+
+func (a *Authorizer) authorize(ctx context.Context, method string, req interface{}) (context.Context, error) {
+	rule, ok := a.policy[method]
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "method is not allowed")
+	}
+
+	nickname, err := a.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nickname) > 0 {
+		ctx = NewContext(ctx, nickname)
+	}
+	if rule.Anonymous {
+		return ctx, nil
+	}
+	if len(nickname) == 0 {
+		return nil, status.Error(codes.Unauthenticated, codes.Unauthenticated.String())
+	}
+
+	var resource Resource
+	if rule.Resource != nil && req != nil {
+		resource, err = rule.Resource(ctx, req)
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, codes.NotFound.String())
+		}
+		if err != nil {
+			log.Println(err)
+			return nil, status.Error(codes.Internal, codes.Internal.String())
+		}
+	}
+
+	roles, err := a.roleRepository.GetUserRoles(ctx, nickname)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+	// роль user есть у любого пользователя, от имени которого выполняется запрос
+	roles = append(roles, models.UserRole{Nickname: nickname, Role: models.RoleUser})
+
+	if !rule.allows(nickname, roles, resource) {
+		return nil, status.Error(codes.PermissionDenied, codes.PermissionDenied.String())
+	}
+	return ctx, nil
+}
+
+// authenticate возвращает nickname из токена запроса или пустую строку для запроса без токена.
+// Недействительный токен отклоняется и для методов, доступных без входа.
+func (a *Authorizer) authenticate(ctx context.Context) (string, error) {
+	token, ok := tokenFromMetadata(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "expected authorization: Bearer <token>")
+	}
+	if len(token) == 0 {
+		return "", nil
+	}
+	nickname, err := a.tokens.Verify(token)
+	if err != nil {
+		return "", status.Error(codes.Unauthenticated, err.Error())
+	}
+	return nickname, nil
+}
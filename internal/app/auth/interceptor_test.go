@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/storm5758/Forum-test/internal/app/models"
+	mock_repository "github.com/storm5758/Forum-test/internal/app/repository/mocks"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	methodAnyone = "/test.Service/Anyone"
+	methodAdmin  = "/test.Service/Admin"
+)
+
+func newTestAuthorizer(t *testing.T) (*Authorizer, *Tokens) {
+	ctrl := gomock.NewController(t)
+	roles := mock_repository.NewMockRole(ctrl)
+	roles.EXPECT().GetUserRoles(gomock.Any(), "admin").
+		Return([]models.UserRole{{Nickname: "admin", Role: models.RoleAdmin}}, nil).AnyTimes()
+	roles.EXPECT().GetUserRoles(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	tokens, err := NewTokens([]byte("test key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy := Policy{
+		methodAnyone: {Anonymous: true},
+		methodAdmin:  {Roles: []models.Role{models.RoleAdmin}},
+	}
+	return NewAuthorizer(policy, roles, tokens), tokens
+}
+
+func TestAuthorize(t *testing.T) {
+	a, tokens := newTestAuthorizer(t)
+	forged, err := NewTokens([]byte("other key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		md     metadata.MD
+		user   string
+		code   codes.Code
+	}{
+		{"anonymous", methodAnyone, nil, "", codes.OK},
+		{"anonymous admin method", methodAdmin, nil, "", codes.Unauthenticated},
+		{"admin token", methodAdmin, metadata.Pairs("authorization", "Bearer "+tokens.Issue("Admin", time.Hour)), "admin", codes.OK},
+		{"user token", methodAdmin, metadata.Pairs("authorization", "bearer "+tokens.Issue("user", time.Hour)), "", codes.PermissionDenied},
+		{"user header", methodAdmin, metadata.Pairs("x-forum-user", "admin"), "", codes.Unauthenticated},
+		{"user header with token", methodAnyone, metadata.Pairs("x-forum-user", "admin", "authorization", "Bearer "+tokens.Issue("user", time.Hour)), "", codes.Unauthenticated},
+		{"forged token", methodAdmin, metadata.Pairs("authorization", "Bearer "+forged.Issue("admin", time.Hour)), "", codes.Unauthenticated},
+		{"forged token on anonymous method", methodAnyone, metadata.Pairs("authorization", "Bearer "+forged.Issue("admin", time.Hour)), "", codes.Unauthenticated},
+		{"expired token", methodAdmin, metadata.Pairs("authorization", "Bearer "+tokens.Issue("admin", -time.Second)), "", codes.Unauthenticated},
+		{"basic auth", methodAdmin, metadata.Pairs("authorization", "Basic YWRtaW46YWRtaW4="), "", codes.Unauthenticated},
+		{"empty bearer", methodAnyone, metadata.Pairs("authorization", "Bearer "), "", codes.Unauthenticated},
+		{"unknown method", "/test.Service/Unknown", nil, "", codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			ctx, err := a.authorize(ctx, tt.method, nil)
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("code = %s, want %s (%v)", code, tt.code, err)
+			}
+			if err != nil {
+				return
+			}
+			if user, _ := UserFromContext(ctx); user != tt.user {
+				t.Errorf("user = %q, want %q", user, tt.user)
+			}
+		})
+	}
+}
+
+func TestTokensVerify(t *testing.T) {
+	tokens, err := NewTokens([]byte("test key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := tokens.Issue("User", time.Hour)
+
+	user, err := tokens.Verify(token)
+	if err != nil || user != "user" {
+		t.Fatalf("Verify() = %q, %v, want \"user\"", user, err)
+	}
+
+	payload, sig, _ := strings.Cut(token, ".")
+	for name, token := range map[string]string{
+		"empty":        "",
+		"no signature": payload,
+		"bad base64":   payload + ".!",
+		"swapped":      sig + "." + payload,
+		"other user":   tokenEncoding.EncodeToString([]byte(`{"u":"admin","exp":4102444800}`)) + "." + sig,
+	} {
+		if _, err := tokens.Verify(token); err != ErrInvalidToken {
+			t.Errorf("%s: Verify() error = %v, want ErrInvalidToken", name, err)
+		}
+	}
+
+	tokens.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	if _, err := tokens.Verify(token); err != ErrInvalidToken {
+		t.Errorf("expired: Verify() error = %v, want ErrInvalidToken", err)
+	}
+}
@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/storm5758/Forum-test/internal/app/models"
+)
+
+// Resource - защищаемый объект, к которому обращается запрос.
+type Resource struct {
+	// Owner - nickname владельца объекта.
+	Owner string
+	// Forum - форум, в котором находится объект (пусто для объектов вне форумов).
+	Forum string
+}
+
+// ResourceFunc извлекает из запроса защищаемый объект.
+type ResourceFunc func(ctx context.Context, req interface{}) (Resource, error)
+
+// Rule - правило доступа к одному методу API.
+type Rule struct {
+	// Anonymous разрешает вызов метода без указания пользователя.
+	Anonymous bool
+	// Roles - роли, любой из которых достаточно для вызова метода.
+	// Роли, выданные в рамках форума, учитываются только для объектов этого форума.
+	Roles []models.Role
+	// Owner разрешает вызов владельцу объекта, который возвращает Resource.
+	Owner bool
+	// Resource извлекает объект запроса для проверки владельца и форума.
+	Resource ResourceFunc
+}
+
+// Policy - правила доступа к методам API по полному имени метода gRPC.
+// Методы, отсутствующие в политике, запрещены.
+type Policy map[string]Rule
+
+// Method возвращает полное имя метода gRPC по имени сервиса и метода.
+func Method(service, method string) string {
+	return "/" + service + "/" + method
+}
+
+// allows проверяет, разрешён ли вызов пользователю с ролями roles к объекту resource.
+func (r Rule) allows(nickname string, roles []models.UserRole, resource Resource) bool {
+	if r.Owner && len(resource.Owner) > 0 && strings.EqualFold(resource.Owner, nickname) {
+		return true
+	}
+	for _, role := range roles {
+		// администратору доступны все методы
+		if role.Role == models.RoleAdmin && len(role.Forum) == 0 {
+			return true
+		}
+		if len(role.Forum) > 0 && !strings.EqualFold(role.Forum, resource.Forum) {
+			continue
+		}
+		for _, allowed := range r.Roles {
+			if role.Role == allowed {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+// ErrInvalidToken возвращается для повреждённого, подделанного или просроченного токена.
+var ErrInvalidToken = errors.New("invalid auth token")
+
+// claims - содержимое токена: nickname пользователя и время окончания действия в секундах Unix.
+type claims struct {
+	User    string `json:"u"`
+	Expires int64  `json:"exp"`
+}
+
+// Tokens выдаёт и проверяет токены пользователей, подписанные ключом HMAC-SHA256.
+// Токен - base64url(claims) "." base64url(подпись), передаётся в заголовке "Authorization: Bearer <токен>".
+type Tokens struct {
+	key []byte
+	now func() time.Time
+}
+
+// NewTokens возвращает Tokens с ключом key.
+// При пустом ключе генерируется случайный: выданные ранее токены не действуют,
+// а новые может выдать только сам процесс.
+func NewTokens(key []byte) (*Tokens, error) {
+	if len(key) == 0 {
+		key = make([]byte, sha256.Size)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+	}
+	return &Tokens{key: key, now: time.Now}, nil
+}
+
+// Issue возвращает токен пользователя nickname, действующий ttl.
+func (t *Tokens) Issue(nickname string, ttl time.Duration) string {
+	payload, _ := json.Marshal(claims{
+		User:    strings.ToLower(nickname),
+		Expires: t.now().Add(ttl).Unix(),
+	})
+	return tokenEncoding.EncodeToString(payload) + "." + tokenEncoding.EncodeToString(t.sign(payload))
+}
+
+// Verify проверяет подпись и срок действия токена и возвращает nickname пользователя.
+func (t *Tokens) Verify(token string) (string, error) {
+	encodedPayload, encodedSig, ok := strings.Cut(token, ".")
+	if !ok {
+		return "", ErrInvalidToken
+	}
+	payload, err := tokenEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+	sig, err := tokenEncoding.DecodeString(encodedSig)
+	if err != nil || !hmac.Equal(sig, t.sign(payload)) {
+		return "", ErrInvalidToken
+	}
+
+	var c claims
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&c); err != nil || len(c.User) == 0 {
+		return "", ErrInvalidToken
+	}
+	if !t.now().Before(time.Unix(c.Expires, 0)) {
+		return "", ErrInvalidToken
+	}
+	return c.User, nil
+}
+
+func (t *Tokens) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, t.key)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+var tokenEncoding = base64.RawURLEncoding
@@ -0,0 +1,148 @@
+// Package avatar проверяет изображения аватаров и уменьшает их до стандартных размеров.
+package avatar
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"image"
+	"image/draw"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+	"strconv"
+	"strings"
+)
+
+const (
+	// MaxFileSize - размер загружаемого файла в байтах
+	MaxFileSize = 2 << 20
+	// MaxDimension - ширина и высота изображения в пикселях
+	MaxDimension = 4096
+	// DefaultSize - размер аватара в профиле пользователя
+	DefaultSize = 128
+
+	// Path - путь HTTP шлюза, по которому раздаются файлы аватаров
+	Path = "/avatars/"
+)
+
+// Sizes - стандартные размеры аватара в пикселях, аватар хранится в каждом из них.
+var Sizes = []int{32, 64, 128, 256}
+
+var (
+	ErrTooLarge          = errors.New("avatar is too large")
+	ErrUnsupportedFormat = errors.New("unsupported image format, expected png, jpeg or gif")
+)
+
+var formats = map[string]bool{"png": true, "jpeg": true, "gif": true}
+
+// Key возвращает ключ аватара по содержимому файла. Файлы размеров отличаются суффиксом, см. FileKey.
+// Ключ меняется вместе с файлом, поэтому файлы аватара можно кэшировать без ограничения срока.
+func Key(nickname string, data []byte) string {
+	h := sha256.New()
+	h.Write([]byte(strings.ToLower(nickname)))
+	h.Write([]byte{0})
+	h.Write(data)
+	sum := hex.EncodeToString(h.Sum(nil)[:16])
+	return sum[:2] + "/" + sum
+}
+
+// FileKey возвращает ключ файла аватара key размером size в хранилище.
+func FileKey(key string, size int) string {
+	return key + "_" + strconv.Itoa(size) + ".png"
+}
+
+// FileKeys возвращает ключи файлов аватара key всех размеров.
+func FileKeys(key string) []string {
+	keys := make([]string, 0, len(Sizes))
+	for _, size := range Sizes {
+		keys = append(keys, FileKey(key, size))
+	}
+	return keys
+}
+
+// URL возвращает путь к файлу аватара key размером size на HTTP шлюзе.
+func URL(key string, size int) string {
+	return Path + FileKey(key, size)
+}
+
+// Resize проверяет изображение PNG, JPEG или GIF, обрезает его до квадрата по центру
+// и возвращает PNG каждого размера из Sizes.
+func Resize(data []byte) (map[int][]byte, error) {
+	if len(data) > MaxFileSize {
+		return nil, ErrTooLarge
+	}
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || !formats[format] {
+		return nil, ErrUnsupportedFormat
+	}
+	if config.Width > MaxDimension || config.Height > MaxDimension {
+		return nil, ErrTooLarge
+	}
+	if config.Width == 0 || config.Height == 0 {
+		return nil, ErrUnsupportedFormat
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+
+	bounds := img.Bounds()
+	side := bounds.Dx()
+	if bounds.Dy() < side {
+		side = bounds.Dy()
+	}
+	square := image.NewRGBA(image.Rect(0, 0, side, side))
+	origin := image.Pt(bounds.Min.X+(bounds.Dx()-side)/2, bounds.Min.Y+(bounds.Dy()-side)/2)
+	draw.Draw(square, square.Bounds(), img, origin, draw.Src)
+
+	files := make(map[int][]byte, len(Sizes))
+	for _, size := range Sizes {
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, scale(square, size)); err != nil {
+			return nil, err
+		}
+		files[size] = buf.Bytes()
+	}
+	return files, nil
+}
+
+// scale масштабирует квадратное изображение до size x size, усредняя пиксели, попадающие
+// в каждый пиксель результата. При увеличении берётся ближайший пиксель.
+func scale(src *image.RGBA, size int) *image.RGBA {
+	n := src.Bounds().Dx()
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		y0, y1 := span(y, n, size)
+		for x := 0; x < size; x++ {
+			x0, x1 := span(x, n, size)
+
+			var sum [4]int
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[sy*src.Stride:]
+				for sx := x0; sx < x1; sx++ {
+					for c := 0; c < 4; c++ {
+						sum[c] += int(row[sx*4+c])
+					}
+				}
+			}
+			count := (y1 - y0) * (x1 - x0)
+			i := y*dst.Stride + x*4
+			for c := 0; c < 4; c++ {
+				dst.Pix[i+c] = uint8(sum[c] / count)
+			}
+		}
+	}
+	return dst
+}
+
+// span возвращает пиксели исходного изображения размером n, попадающие в пиксель i результата размером size.
+func span(i, n, size int) (int, int) {
+	from, to := i*n/size, (i+1)*n/size
+	if to <= from {
+		to = from + 1
+	}
+	return from, to
+}
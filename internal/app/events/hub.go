@@ -0,0 +1,133 @@
+// Package events раздаёт подписчикам события веток обсуждения,
+// полученные из канала уведомлений Postgres.
+package events
+
+import (
+	"encoding/json"
+	"log"
+	"sync"
+)
+
+// Channel - канал уведомлений Postgres о событиях веток.
+const Channel = "forum_events"
+
+// Kind - вид события.
+type Kind string
+
+const (
+	// KindPosts - в ветке созданы сообщения, Id - наибольший id среди них
+	KindPosts Kind = "posts"
+	// KindPostEdited - изменено сообщение Id
+	KindPostEdited Kind = "post_edited"
+	// KindVotes - изменился рейтинг ветки, новое значение - Votes
+	KindVotes Kind = "votes"
+	// KindThreadUpdated - изменилось состояние или закрепление ветки
+	KindThreadUpdated Kind = "thread_updated"
+	// KindResync - уведомления могли быть потеряны, подписчик должен перечитать состояние ветки
+	KindResync Kind = "resync"
+)
+
+// Event - событие ветки обсуждения.
+type Event struct {
+	Thread int32 `json:"thread"`
+	Kind   Kind  `json:"kind"`
+	Id     int64 `json:"id"`
+	Votes  int32 `json:"votes,omitempty"`
+}
+
+// Hub раздаёт события подписчикам веток.
+type Hub struct {
+	mu   sync.Mutex
+	subs map[int32]map[*Subscription]struct{}
+}
+
+func NewHub() *Hub {
+	return &Hub{subs: make(map[int32]map[*Subscription]struct{})}
+}
+
+// Subscription - подписка на события ветки.
+type Subscription struct {
+	// C получает события ветки. Если подписчик не успевает их читать,
+	// лишние события отбрасываются и Lagged возвращает true.
+	C <-chan Event
+
+	c      chan Event
+	hub    *Hub
+	thread int32
+
+	mu     sync.Mutex
+	lagged bool
+}
+
+// Subscribe подписывается на события ветки thread с буфером на buffer событий.
+// Подписку нужно закрыть методом Close.
+func (h *Hub) Subscribe(thread int32, buffer int) *Subscription {
+	c := make(chan Event, buffer)
+	sub := &Subscription{C: c, c: c, hub: h, thread: thread}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.subs[thread] == nil {
+		h.subs[thread] = make(map[*Subscription]struct{})
+	}
+	h.subs[thread][sub] = struct{}{}
+	return sub
+}
+
+// Lagged сообщает, что часть событий была отброшена из-за переполнения буфера.
+func (s *Subscription) Lagged() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lagged
+}
+
+// Close отменяет подписку.
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	delete(s.hub.subs[s.thread], s)
+	if len(s.hub.subs[s.thread]) == 0 {
+		delete(s.hub.subs, s.thread)
+	}
+}
+
+func (s *Subscription) send(e Event) {
+	select {
+	case s.c <- e:
+	default:
+		s.mu.Lock()
+		s.lagged = true
+		s.mu.Unlock()
+	}
+}
+
+// Publish отправляет событие подписчикам его ветки, не дожидаясь их.
+func (h *Hub) Publish(e Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for sub := range h.subs[e.Thread] {
+		sub.send(e)
+	}
+}
+
+// HandleNotification - database.NotificationHandler для канала Channel.
+// Пустое уведомление рассылает всем подписчикам KindResync.
+func (h *Hub) HandleNotification(payload string) {
+	if len(payload) == 0 {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		for thread, subs := range h.subs {
+			for sub := range subs {
+				sub.send(Event{Thread: thread, Kind: KindResync})
+			}
+		}
+		return
+	}
+
+	var e Event
+	if err := json.Unmarshal([]byte(payload), &e); err != nil {
+		log.Printf("events: invalid notification %q: %v", payload, err)
+		return
+	}
+	h.Publish(e)
+}
@@ -1,16 +1,24 @@
 package models
 
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
 type User struct {
-	Nickname string `db:"nickname"`
-	Email    string `db:"email"`
-	Fullname string `db:"full_name"`
-	About    string `db:"about"`
+	Nickname string `json:"nickname" db:"nickname"`
+	Email    string `json:"email"    db:"email"`
+	Fullname string `json:"fullname" db:"full_name"`
+	About    string `json:"about"    db:"about"`
+	// Avatar - ключ файлов аватара в хранилище, пуст, если аватар не загружен
+	Avatar string `json:"avatar,omitempty" db:"avatar"`
 }
 
 type UserUpdate struct {
 	About    string `json:"about"    db:"about"`
 	Email    string `json:"email"    db:"email"`
-	Fullname string `json:"fullname" db:"fullname"`
+	Fullname string `json:"fullname" db:"full_name"`
 }
 
 type NewForum struct {
@@ -25,6 +33,8 @@ type Forum struct {
 	Threads int32  `json:"threads"  db:"threads"`
 	Title   string `json:"title"    db:"title"`
 	User    string `json:"user"     db:"user_nick"`
+	// Tags - метки, из которых выбираются метки веток, пусто - метки веток произвольные
+	Tags Tags `json:"tags,omitempty" db:"tags"`
 }
 
 type Thread struct {
@@ -33,9 +43,60 @@ type Thread struct {
 	Forum   string `json:"forum"   db:"forum"`
 	Id      int32  `json:"id"      db:"id"`
 	Message string `json:"message" db:"message"`
-	Slug    string `json:"slug,omitempty"    db:"slug"`
-	Title   string `json:"title"   db:"title"`
-	Votes   int32  `json:"votes"   db:"votes"`
+	// MessageHTML - Message, отрисованное из Markdown, пусто у веток, созданных до отрисовки
+	MessageHTML string       `json:"message_html,omitempty" db:"message_html"`
+	Slug        string       `json:"slug,omitempty"    db:"slug"`
+	Title       string       `json:"title"   db:"title"`
+	Votes       int32        `json:"votes"   db:"votes"`
+	Status      ThreadStatus `json:"status" db:"status"`
+	Pinned      bool         `json:"pinned" db:"pinned"`
+	// Shadow - ветка создана при теневой блокировке автора и видна только ему
+	Shadow bool `json:"shadow,omitempty" db:"shadow"`
+	// Tags - метки ветки
+	Tags Tags `json:"tags,omitempty" db:"tags"`
+}
+
+// Tags - метки веток, сравниваются без учёта регистра. Выбираются из базы в jsonb.
+type Tags []string
+
+// Scan читает метки из jsonb.
+func (t *Tags) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case nil:
+		*t = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(src, t)
+	case string:
+		return json.Unmarshal([]byte(src), t)
+	}
+	return fmt.Errorf("models: can't scan %T into Tags", src)
+}
+
+// TagCount - метка и кол-во веток форума с ней.
+type TagCount struct {
+	Tag   string `db:"tag"`
+	Count int32  `db:"count"`
+}
+
+// ThreadStatus - состояние ветки обсуждения.
+type ThreadStatus string
+
+const (
+	ThreadOpen ThreadStatus = "open"
+	// ThreadClosed - ветка закрыта для новых сообщений
+	ThreadClosed ThreadStatus = "closed"
+	// ThreadArchived - ветка доступна только для чтения
+	ThreadArchived ThreadStatus = "archived"
+)
+
+type ThreadUpdate struct {
+	Message string `json:"message" db:"message"`
+	// MessageHTML - Message, отрисованное из Markdown, обновляется вместе с Message
+	MessageHTML string `json:"message_html,omitempty" db:"message_html"`
+	Title       string `json:"title"   db:"title"`
+	// Tags - новые метки ветки, nil - метки не меняются
+	Tags Tags `json:"tags,omitempty" db:"tags"`
 }
 
 type Post struct {
@@ -43,10 +104,86 @@ type Post struct {
 	Created  string `json:"created"  db:"created"`
 	Forum    string `json:"forum"    db:"forum"`
 	Id       int64  `json:"id"       db:"id"`
-	IsEdited bool   `json:"isEdited" db:"isEdited"`
+	IsEdited bool   `json:"isEdited" db:"isedited"`
 	Message  string `json:"message"  db:"message"`
 	Parent   int64  `json:"parent"   db:"parent"`
 	Thread   int32  `json:"thread"   db:"thread"`
+	// IsDeleted - сообщение удалено, Message пуст
+	IsDeleted bool `json:"isDeleted" db:"isdeleted"`
+	// Shadow - сообщение создано при теневой блокировке автора и видно только ему
+	Shadow bool `json:"shadow,omitempty" db:"shadow"`
+	// Reactions - кол-во реакций по эмодзи
+	Reactions Reactions `json:"reactions,omitempty" db:"reactions"`
+	// Score - сумма голосов за сообщение
+	Score int32 `json:"score" db:"score"`
+	// MessageHTML - Message, отрисованное из Markdown, пусто у сообщений, созданных до отрисовки
+	MessageHTML string `json:"message_html,omitempty" db:"message_html"`
+	// Attachments - файлы сообщения, при создании сообщения заполняются только их Id
+	Attachments Attachments `json:"attachments,omitempty" db:"attachments"`
+}
+
+// Reactions - кол-во реакций на сообщение по эмодзи, хранится в сообщении в jsonb.
+type Reactions map[string]int32
+
+// Scan читает кол-во реакций из jsonb.
+func (r *Reactions) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case nil:
+		*r = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(src, r)
+	case string:
+		return json.Unmarshal([]byte(src), r)
+	}
+	return fmt.Errorf("models: can't scan %T into Reactions", src)
+}
+
+// Attachment - файл, загруженный пользователем Owner.
+// Post равен 0, пока файл не прикреплён к сообщению, Key - ключ содержимого в хранилище файлов.
+type Attachment struct {
+	Id          int64  `json:"id"           db:"id"`
+	Owner       string `json:"owner"        db:"owner"`
+	Post        int64  `json:"post"         db:"post"`
+	Filename    string `json:"filename"     db:"filename"`
+	ContentType string `json:"content_type" db:"content_type"`
+	Size        int64  `json:"size"         db:"size"`
+	Key         string `json:"-"            db:"storage_key"`
+	Created     string `json:"created"      db:"created"`
+}
+
+// Attachments - файлы сообщения, выбираются вместе с сообщением в jsonb.
+type Attachments []Attachment
+
+// Scan читает файлы сообщения из jsonb.
+func (a *Attachments) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case nil:
+		*a = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(src, a)
+	case string:
+		return json.Unmarshal([]byte(src), a)
+	}
+	return fmt.Errorf("models: can't scan %T into Attachments", src)
+}
+
+// PostVote - данные события EventPostVoted.
+type PostVote struct {
+	Post   int64 `json:"post"`
+	Thread int32 `json:"thread"`
+	Vote   Vote  `json:"vote"`
+	Score  int32 `json:"score"`
+}
+
+// PostReaction - реакция пользователя на сообщение и данные событий
+// EventPostReactionAdded и EventPostReactionRemoved.
+type PostReaction struct {
+	Post     int64  `json:"post"`
+	Thread   int32  `json:"thread"`
+	Nickname string `json:"nickname"`
+	Emoji    string `json:"emoji"`
 }
 
 type PostAccount struct {
@@ -63,7 +200,389 @@ type Status struct {
 	User   int64 `json:"user"`
 }
 
+// ThreadFilter - параметры выборки веток форума.
+// Since - дата создания, начиная с которой выводятся ветки,
+// After - ключ ветки, после которой выводятся ветки. After важнее Since.
+// Viewer - пользователь, которому видны его скрытые теневой блокировкой ветки.
+// Tag - метка, ветки с которой выводятся, пусто - все ветки.
+type ThreadFilter struct {
+	Limit  int32
+	Since  string
+	Desc   bool
+	After  *ThreadKey
+	Viewer string
+	Tag    string
+}
+
+// ThreadKey - ключ сортировки веток форума. Закреплённые ветки выводятся первыми.
+type ThreadKey struct {
+	Pinned  bool
+	Created string
+	Id      int32
+}
+
+// UserFilter - параметры выборки пользователей форума.
+// Since - nickname, после которого выводятся пользователи.
+type UserFilter struct {
+	Limit int32
+	Since string
+	Desc  bool
+}
+
+// PostSort - вид сортировки сообщений ветки.
+type PostSort string
+
+const (
+	PostSortFlat       PostSort = "flat"
+	PostSortTree       PostSort = "tree"
+	PostSortParentTree PostSort = "parent_tree"
+	PostSortTop        PostSort = "top"
+)
+
+// PostFilter - параметры выборки сообщений ветки.
+// Since - id сообщения, после которого выводятся сообщения,
+// After - ключ корневого сообщения, после которого выводятся сообщения при сортировке top,
+// Viewer - пользователь, которому видны его скрытые теневой блокировкой сообщения.
+type PostFilter struct {
+	Limit  int32
+	Since  int64
+	Desc   bool
+	Sort   PostSort
+	After  *PostKey
+	Viewer string
+}
+
+// PostKey - ключ сортировки top корневых сообщений ветки.
+// Рейтинг хранится в ключе, чтобы его изменение не сдвигало следующие страницы.
+type PostKey struct {
+	Score int32
+	Id    int64
+}
+
+// SearchLanguage - конфигурация полнотекстового поиска Postgres.
+type SearchLanguage string
+
+const (
+	SearchRussian SearchLanguage = "russian"
+	SearchEnglish SearchLanguage = "english"
+)
+
+// SearchKind - вид найденной записи.
+type SearchKind string
+
+const (
+	SearchKindThread SearchKind = "thread"
+	SearchKindPost   SearchKind = "post"
+)
+
+// SearchQuery - параметры поиска. Пустые фильтры не применяются.
+// Since и Until ограничивают дату создания, Until не включается.
+type SearchQuery struct {
+	Query    string
+	Language SearchLanguage
+	Threads  bool
+	Posts    bool
+	Forum    string
+	Author   string
+	Since    string
+	Until    string
+	Limit    int32
+	After    *SearchKey
+}
+
+// SearchKey - ключ сортировки результатов поиска.
+type SearchKey struct {
+	Rank float64
+	Kind SearchKind
+	Id   int64
+}
+
+// SearchHit - найденная ветка или сообщение.
+type SearchHit struct {
+	Kind    SearchKind `db:"kind"`
+	Id      int64      `db:"id"`
+	Thread  int32      `db:"thread"`
+	Forum   string     `db:"forum"`
+	Author  string     `db:"author"`
+	Created string     `db:"created"`
+	Title   string     `db:"title"`
+	Snippet string     `db:"snippet"`
+	Rank    float64    `db:"rank"`
+}
+
 type Vote struct {
 	Nickname string `json:"nickname"`
 	Voice    int    `json:"voice"`
 }
+
+// EventType - вид доменного события.
+type EventType string
+
+const (
+	EventUserCreated         EventType = "user.created"
+	EventUserUpdated         EventType = "user.updated"
+	EventForumCreated        EventType = "forum.created"
+	EventForumUpdated        EventType = "forum.updated"
+	EventThreadCreated       EventType = "thread.created"
+	EventThreadUpdated       EventType = "thread.updated"
+	EventThreadVoted         EventType = "thread.voted"
+	EventThreadDeleted       EventType = "thread.deleted"
+	EventPostsCreated        EventType = "posts.created"
+	EventPostDeleted         EventType = "post.deleted"
+	EventPostRestored        EventType = "post.restored"
+	EventPostReactionAdded   EventType = "post.reaction_added"
+	EventPostReactionRemoved EventType = "post.reaction_removed"
+	EventPostVoted           EventType = "post.voted"
+	EventBanCreated          EventType = "ban.created"
+	EventBanLifted           EventType = "ban.lifted"
+	EventReportCreated       EventType = "report.created"
+	EventReportResolved      EventType = "report.resolved"
+)
+
+// Event - доменное событие из outbox.
+// Forum пуст для событий вне форума, Payload - изменённая сущность в JSON.
+type Event struct {
+	Id      int64           `json:"id"      db:"id"`
+	Type    EventType       `json:"type"    db:"type"`
+	Forum   string          `json:"forum"   db:"forum"`
+	Payload json.RawMessage `json:"payload" db:"payload"`
+	Created string          `json:"created" db:"created"`
+}
+
+// ThreadVote - данные события EventThreadVoted.
+type ThreadVote struct {
+	Thread int32 `json:"thread"`
+	Vote   Vote  `json:"vote"`
+	Votes  int32 `json:"votes"`
+	// Shadow - ветка скрыта теневой блокировкой автора
+	Shadow bool `json:"shadow,omitempty"`
+}
+
+// ThreadModeration - данные события EventThreadDeleted, Posts - кол-во удалённых вместе с веткой сообщений.
+type ThreadModeration struct {
+	Thread    int32  `json:"thread"`
+	Moderator string `json:"moderator"`
+	Reason    string `json:"reason,omitempty"`
+	Posts     int64  `json:"posts"`
+}
+
+// PostModeration - данные событий EventPostDeleted и EventPostRestored.
+type PostModeration struct {
+	Post      int64  `json:"post"`
+	Thread    int32  `json:"thread"`
+	Moderator string `json:"moderator"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// Ban - блокировка пользователя. Пустой Forum означает блокировку во всех форумах.
+type Ban struct {
+	Id        int32   `json:"id" db:"id"`
+	Nickname  string  `json:"nickname" db:"nickname"`
+	Forum     string  `json:"forum" db:"forum"`
+	Shadow    bool    `json:"shadow" db:"shadow"`
+	Reason    string  `json:"reason" db:"reason"`
+	Moderator string  `json:"moderator" db:"moderator"`
+	Created   string  `json:"created" db:"created"`
+	Expires   *string `json:"expires,omitempty" db:"expires"`
+	Lifted    *string `json:"lifted,omitempty" db:"lifted"`
+	LiftedBy  *string `json:"lifted_by,omitempty" db:"lifted_by"`
+	Active    bool    `json:"active" db:"active"`
+}
+
+// BanFilter - параметры выборки блокировок, от последних к первым.
+// Пустой Forum означает блокировки во всех форумах, пустой Nickname - блокировки любых пользователей.
+type BanFilter struct {
+	Forum           string
+	Nickname        string
+	IncludeInactive bool
+	Limit           int32
+}
+
+// Report - жалоба пользователя на сообщение или ветку.
+// Post равен 0 для жалобы на ветку, Resolution пуст, пока жалоба открыта.
+type Report struct {
+	Id         int32  `json:"id" db:"id"`
+	Forum      string `json:"forum" db:"forum"`
+	Thread     int32  `json:"thread" db:"thread"`
+	Post       int64  `json:"post,omitempty" db:"post"`
+	Reporter   string `json:"reporter" db:"reporter"`
+	Reason     string `json:"reason" db:"reason"`
+	Created    string `json:"created" db:"created"`
+	Resolution *int32 `json:"resolution,omitempty" db:"resolution"`
+}
+
+// ReportTarget - открытые жалобы на одно сообщение или ветку.
+// FirstReport - самая давняя из них, Reasons - причины последних жалоб.
+type ReportTarget struct {
+	Thread      int32
+	Post        int64
+	Author      string
+	Reports     int32
+	FirstReport int32
+	Created     string
+	Updated     string
+	Reasons     []string
+}
+
+// ReportQueueFilter - параметры выборки очереди модерации.
+// After - FirstReport последнего сообщения или ветки предыдущей страницы.
+type ReportQueueFilter struct {
+	Limit int32
+	After int32
+}
+
+// ReportAction - решение модератора по жалобе.
+type ReportAction string
+
+const (
+	ReportDismiss ReportAction = "dismiss"
+	ReportDelete  ReportAction = "delete"
+	ReportBan     ReportAction = "ban"
+)
+
+// ReportResolve - решение по жалобе, которое нужно выполнить.
+// BanShadow и BanExpires задают блокировку автора для ReportBan.
+type ReportResolve struct {
+	Action     ReportAction
+	Moderator  string
+	Comment    string
+	BanShadow  bool
+	BanExpires *string
+}
+
+// ReportResolution - принятое решение по жалобам на сообщение или ветку.
+// Post равен 0 для жалоб на ветку, Ban - блокировка автора для ReportBan,
+// Reports - кол-во закрытых решением жалоб.
+type ReportResolution struct {
+	Id        int32        `json:"id" db:"id"`
+	Forum     string       `json:"forum" db:"forum"`
+	Action    ReportAction `json:"action" db:"action"`
+	Moderator string       `json:"moderator" db:"moderator"`
+	Comment   string       `json:"comment,omitempty" db:"comment"`
+	Thread    int32        `json:"thread" db:"thread"`
+	Post      int64        `json:"post,omitempty" db:"post"`
+	Ban       int32        `json:"ban,omitempty" db:"ban"`
+	Created   string       `json:"created" db:"created"`
+	Reports   int32        `json:"reports" db:"-"`
+}
+
+// NotificationKind - вид уведомления.
+type NotificationKind string
+
+const (
+	// NotificationMention - пользователя упомянули через @nickname
+	NotificationMention NotificationKind = "mention"
+	// NotificationReply - ответ на сообщение пользователя
+	NotificationReply NotificationKind = "reply"
+)
+
+// Notification - уведомление пользователя Nickname о сообщении Actor.
+// Post равен 0 для упоминания в сообщении ветки, Read пуст для непрочитанного уведомления.
+type Notification struct {
+	Id       int64            `db:"id"`
+	Nickname string           `db:"nickname"`
+	Kind     NotificationKind `db:"kind"`
+	Actor    string           `db:"actor"`
+	Forum    string           `db:"forum"`
+	Thread   int32            `db:"thread"`
+	Post     int64            `db:"post"`
+	Created  string           `db:"created"`
+	Read     *string          `db:"read"`
+}
+
+// Mention - упоминание пользователя Nickname в сообщении Post или в сообщении ветки при пустом Post.
+// Nickname указан так, как его написал автор, и может не совпадать с регистром ника.
+type Mention struct {
+	Nickname string
+	Actor    string
+	Thread   int32
+	Post     int64
+}
+
+// NotificationFilter - параметры выборки уведомлений, от последних к первым.
+// After - идентификатор последнего уведомления предыдущей страницы.
+type NotificationFilter struct {
+	UnreadOnly bool
+	Limit      int32
+	After      int64
+}
+
+// Role - роль пользователя, определяющая доступные ему методы API.
+type Role string
+
+const (
+	RoleUser       Role = "user"
+	RoleModerator  Role = "moderator"
+	RoleForumOwner Role = "forum_owner"
+	RoleAdmin      Role = "admin"
+)
+
+// UserRole - роль, выданная пользователю.
+// Пустой Forum означает, что роль действует во всех форумах.
+type UserRole struct {
+	Nickname string `db:"nickname"`
+	Role     Role   `db:"role"`
+	Forum    string `db:"forum"`
+}
+
+// Webhook - адрес, на который отправляются события форума.
+// Пустой Events означает все события, для которых поддерживаются веб-хуки.
+type Webhook struct {
+	Id      int32
+	Forum   string
+	Url     string
+	Secret  string
+	Events  []EventType
+	Created string
+}
+
+// DeliveryStatus - состояние доставки события на веб-хук.
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliveryDelivered DeliveryStatus = "delivered"
+	// DeliveryDead - попытки доставки исчерпаны
+	DeliveryDead DeliveryStatus = "dead"
+)
+
+// WebhookDelivery - доставка события на веб-хук.
+type WebhookDelivery struct {
+	Id           int64          `db:"id"`
+	Webhook      int32          `db:"webhook"`
+	Event        int64          `db:"event"`
+	EventType    EventType      `db:"event_type"`
+	Status       DeliveryStatus `db:"status"`
+	Attempts     int32          `db:"attempts"`
+	NextAttempt  string         `db:"next_attempt"`
+	ResponseCode int32          `db:"response_code"`
+	LastError    string         `db:"last_error"`
+	Created      string         `db:"created"`
+	// Delivered - время доставки, nil для недоставленных
+	Delivered *string `db:"delivered"`
+}
+
+// DeliveryFilter - параметры выборки доставок, от последних к первым.
+// Пустой Status означает доставки в любом состоянии.
+type DeliveryFilter struct {
+	Status DeliveryStatus
+	Limit  int32
+}
+
+// PendingDelivery - доставка, взятая на отправку, вместе с событием и адресом.
+type PendingDelivery struct {
+	Id       int64  `db:"id"`
+	Attempts int32  `db:"attempts"`
+	Url      string `db:"url"`
+	Secret   string `db:"secret"`
+	Event    Event  `db:"event"`
+}
+
+// DeliveryResult - результат попытки доставки.
+// Если Delivered ложно, следующая попытка выполняется через RetryIn, а при нулевом RetryIn доставка помечается DeliveryDead.
+type DeliveryResult struct {
+	Delivered    bool
+	ResponseCode int32
+	Error        string
+	RetryIn      time.Duration
+}
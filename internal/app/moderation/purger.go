@@ -0,0 +1,45 @@
+// Package moderation содержит фоновые задачи модерации форумов.
+package moderation
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+	"github.com/storm5758/Forum-test/internal/pkg/database"
+)
+
+// PurgeInterval - период окончательного удаления сообщений
+const PurgeInterval = time.Hour
+
+// Purger окончательно удаляет сообщения, удалённые раньше, чем retention назад.
+// Удаление выполняет один экземпляр сервера за раз, см. database.RunSingleton.
+type Purger struct {
+	db             *sqlx.DB
+	postRepository repository.Post
+	retention      time.Duration
+}
+
+// NewPurger возвращает Purger, берущий блокировку на основном сервере db.
+func NewPurger(db *sqlx.DB, postRepository repository.Post, retention time.Duration) *Purger {
+	return &Purger{
+		db:             db,
+		postRepository: postRepository,
+		retention:      retention,
+	}
+}
+
+// Run удаляет сообщения раз в PurgeInterval до отмены ctx.
+func (p *Purger) Run(ctx context.Context) {
+	database.RunSingleton(ctx, p.db, database.LockKindPurgePosts, PurgeInterval, p.purge)
+}
+
+func (p *Purger) purge(ctx context.Context) error {
+	n, err := p.postRepository.PurgeDeletedPosts(ctx, time.Now().Add(-p.retention))
+	if n > 0 {
+		log.Printf("moderation: purged %d deleted posts", n)
+	}
+	return err
+}
@@ -0,0 +1,69 @@
+// Package notification создаёт уведомления пользователей об упоминаниях и ответах.
+package notification
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/outbox"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+)
+
+// Notify возвращает обработчик шины outbox, который создаёт уведомления
+// по событиям EventThreadCreated и EventPostsCreated.
+// Обработчик выполняется в транзакции, отмечающей событие опубликованным: при ошибке пачка событий
+// публикуется повторно, а уже созданные уведомления не повторяются благодаря уникальному индексу
+// (nickname, thread, post). По той же причине у пользователя не больше одного уведомления о сообщении.
+// Сообщения, скрытые теневой блокировкой, никого не уведомляют.
+func Notify(notificationRepository repository.Notification) outbox.Handler {
+	return func(ctx context.Context, event models.Event) error {
+		switch event.Type {
+		case models.EventThreadCreated:
+			var thread models.Thread
+			if err := json.Unmarshal(event.Payload, &thread); err != nil {
+				return errors.Wrap(err, "notification: thread payload")
+			}
+			if thread.Shadow {
+				return nil
+			}
+			return notificationRepository.AddMentionNotifications(ctx, thread.Forum, mentions(thread.Author, thread.Id, 0, thread.Message))
+
+		case models.EventPostsCreated:
+			var posts []models.Post
+			if err := json.Unmarshal(event.Payload, &posts); err != nil {
+				return errors.Wrap(err, "notification: posts payload")
+			}
+			visible := make([]models.Post, 0, len(posts))
+			var found []models.Mention
+			for _, post := range posts {
+				if post.Shadow {
+					continue
+				}
+				visible = append(visible, post)
+				found = append(found, mentions(post.Author, post.Thread, post.Id, post.Message)...)
+			}
+			// ответы добавляются первыми: упоминание в ответе не дублирует уведомление об ответе
+			if err := notificationRepository.AddReplyNotifications(ctx, visible); err != nil {
+				return err
+			}
+			return notificationRepository.AddMentionNotifications(ctx, event.Forum, found)
+		}
+		return nil
+	}
+}
+
+func mentions(actor string, thread int32, post int64, message string) []models.Mention {
+	nicknames := Mentions(message)
+	found := make([]models.Mention, 0, len(nicknames))
+	for _, nickname := range nicknames {
+		found = append(found, models.Mention{
+			Nickname: nickname,
+			Actor:    actor,
+			Thread:   thread,
+			Post:     post,
+		})
+	}
+	return found
+}
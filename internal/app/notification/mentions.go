@@ -0,0 +1,37 @@
+package notification
+
+import (
+	"regexp"
+	"strings"
+)
+
+// MaxMentions - упоминаний в одном сообщении, о которых уведомляются пользователи
+const MaxMentions = 20
+
+// mentionPattern находит @nickname, не являющиеся частью слова или адреса почты.
+var mentionPattern = regexp.MustCompile(`(?:^|[^A-Za-z0-9_.@])@([A-Za-z0-9_.]+)`)
+
+// Mentions возвращает ники, упомянутые в message, без повторов с точностью до регистра.
+// Точки в конце ника считаются концом предложения.
+func Mentions(message string) []string {
+	var (
+		nicknames []string
+		seen      = make(map[string]struct{})
+	)
+	for _, match := range mentionPattern.FindAllStringSubmatch(message, -1) {
+		nickname := strings.TrimRight(match[1], ".")
+		if len(nickname) == 0 {
+			continue
+		}
+		key := strings.ToLower(nickname)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		nicknames = append(nicknames, nickname)
+		if len(nicknames) == MaxMentions {
+			break
+		}
+	}
+	return nicknames
+}
@@ -0,0 +1,58 @@
+package outbox
+
+import (
+	"context"
+	"sync"
+
+	"github.com/storm5758/Forum-test/internal/app/models"
+)
+
+// Handler обрабатывает событие шины.
+// ctx содержит транзакцию публикации: изменения обработчика в репозитории
+// фиксируются вместе с отметкой о публикации события.
+type Handler func(ctx context.Context, event models.Event) error
+
+// Bus - шина событий внутри процесса.
+// Обработчики вызываются по порядку событий, ошибка любого из них повторяет публикацию, см. Relay.
+type Bus struct {
+	mu       sync.RWMutex
+	handlers map[models.EventType][]Handler
+	all      []Handler
+}
+
+func NewBus() *Bus {
+	return &Bus{handlers: make(map[models.EventType][]Handler)}
+}
+
+// Subscribe подписывает handler на события перечисленных видов, без видов - на все события.
+func (b *Bus) Subscribe(handler Handler, types ...models.EventType) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(types) == 0 {
+		b.all = append(b.all, handler)
+		return
+	}
+	for _, t := range types {
+		b.handlers[t] = append(b.handlers[t], handler)
+	}
+}
+
+func (b *Bus) Publish(ctx context.Context, events []models.Event) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, event := range events {
+		for _, handler := range b.all {
+			if err := handler(ctx, event); err != nil {
+				return err
+			}
+		}
+		for _, handler := range b.handlers[event.Type] {
+			if err := handler(ctx, event); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+// Package outbox публикует доменные события, записанные в outbox в транзакциях изменений.
+package outbox
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+)
+
+const (
+	// DefaultBatchSize - сколько событий публикуется за одну транзакцию
+	DefaultBatchSize = 100
+	// DefaultInterval - пауза между проверками outbox, когда событий нет
+	DefaultInterval = time.Second
+	// DefaultMaxAttempts - после скольких неудачных попыток событие перестаёт публиковаться
+	DefaultMaxAttempts = 5
+	// DefaultRetention - сколько опубликованные события хранятся в outbox
+	DefaultRetention = 7 * 24 * time.Hour
+	// PurgeInterval - период удаления опубликованных событий
+	PurgeInterval = time.Hour
+)
+
+// Sink получает опубликованные события.
+// После ошибки Sink события пачки публикуются заново по одному, поэтому Sink должен переносить повторы.
+type Sink interface {
+	Publish(ctx context.Context, events []models.Event) error
+}
+
+// Relay переносит события из outbox в Sink в порядке id, не реже раза в interval,
+// и удаляет опубликованные события старше retention.
+//
+// Событие, публикация которого не удалась maxAttempts раз, больше не публикуется и остаётся
+// в outbox с последней ошибкой, чтобы не задерживать следующие события.
+//
+// id выдаётся при записи события, а не при фиксации транзакции, поэтому событие транзакции,
+// зафиксированной позже, может быть опубликовано после событий с большим id.
+// Sink не должен полагаться на порядок событий разных транзакций.
+type Relay struct {
+	outbox      repository.Outbox
+	sinks       []Sink
+	batchSize   int
+	interval    time.Duration
+	retention   time.Duration
+	maxAttempts int
+}
+
+// RelayOption - дополнительная настройка Relay.
+type RelayOption func(*Relay)
+
+// WithBatchSize задаёт, сколько событий публикуется за одну транзакцию.
+func WithBatchSize(n int) RelayOption {
+	return func(r *Relay) {
+		r.batchSize = n
+	}
+}
+
+// WithInterval задаёт паузу между проверками outbox.
+func WithInterval(interval time.Duration) RelayOption {
+	return func(r *Relay) {
+		r.interval = interval
+	}
+}
+
+// WithMaxAttempts задаёт, после скольких неудачных попыток событие перестаёт публиковаться.
+func WithMaxAttempts(n int) RelayOption {
+	return func(r *Relay) {
+		r.maxAttempts = n
+	}
+}
+
+// WithRetention задаёт, сколько опубликованные события хранятся в outbox.
+func WithRetention(retention time.Duration) RelayOption {
+	return func(r *Relay) {
+		r.retention = retention
+	}
+}
+
+func NewRelay(outbox repository.Outbox, sinks []Sink, opts ...RelayOption) *Relay {
+	r := &Relay{
+		outbox:      outbox,
+		sinks:       sinks,
+		batchSize:   DefaultBatchSize,
+		interval:    DefaultInterval,
+		retention:   DefaultRetention,
+		maxAttempts: DefaultMaxAttempts,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Run публикует события до отмены ctx.
+func (r *Relay) Run(ctx context.Context) {
+	var purged time.Time
+	for {
+		if time.Since(purged) >= PurgeInterval {
+			r.purge(ctx)
+			purged = time.Now()
+		}
+
+		n, err := r.outbox.PublishEvents(ctx, r.batchSize, r.maxAttempts, r.publish)
+		if err != nil && ctx.Err() == nil {
+			log.Println("outbox:", err)
+		}
+		// полная пачка - вероятно, есть ещё события
+		if err == nil && n == r.batchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(r.interval):
+		}
+	}
+}
+
+// purge удаляет опубликованные события. Удаление повторяемо, поэтому его выполняют все экземпляры сервера.
+func (r *Relay) purge(ctx context.Context) {
+	n, err := r.outbox.PurgeEvents(ctx, time.Now().Add(-r.retention))
+	if err != nil && ctx.Err() == nil {
+		log.Println("outbox:", err)
+	}
+	if n > 0 {
+		log.Printf("outbox: purged %d published events", n)
+	}
+}
+
+func (r *Relay) publish(ctx context.Context, events []models.Event) error {
+	for _, sink := range r.sinks {
+		if err := sink.Publish(ctx, events); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/storm5758/Forum-test/internal/app/models"
+)
+
+// LogSink пишет события в стандартный журнал.
+type LogSink struct{}
+
+func (LogSink) Publish(_ context.Context, events []models.Event) error {
+	for _, event := range events {
+		log.Printf("event %d %s forum=%q %s", event.Id, event.Type, event.Forum, event.Payload)
+	}
+	return nil
+}
+
+// FileSink дописывает события в файл по одному JSON на строку.
+// При повторной публикации пачки события в файле могут повторяться, их различает id.
+type FileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFileSink открывает файл path для дописывания.
+func NewFileSink(path string) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, errors.Wrap(err, "open outbox file")
+	}
+	return &FileSink{file: file}, nil
+}
+
+func (s *FileSink) Publish(_ context.Context, events []models.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	encoder := json.NewEncoder(s.file)
+	for _, event := range events {
+		if err := encoder.Encode(event); err != nil {
+			return errors.Wrap(err, "FileSink")
+		}
+	}
+	// события отмечаются опубликованными только после записи на диск
+	return errors.Wrap(s.file.Sync(), "FileSink")
+}
+
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
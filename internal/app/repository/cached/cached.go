@@ -0,0 +1,68 @@
+// Package cached содержит репозитории, читающие данные через кэш.
+// Записи в кэше сбрасываются при изменении соответствующих данных.
+package cached
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/storm5758/Forum-test/internal/pkg/cache"
+)
+
+type readThrough struct {
+	cache cache.Cache
+	ttl   time.Duration
+}
+
+// get читает значение из кэша, а при промахе загружает его через load и сохраняет.
+// Ошибки кэша не прерывают запрос: данные читаются из источника.
+func (r readThrough) get(ctx context.Context, key string, dst interface{}, load func() error) error {
+	value, ok, err := r.cache.Get(ctx, key)
+	if err != nil {
+		log.Println("cache get:", err)
+	}
+	if ok {
+		if err := json.Unmarshal(value, dst); err == nil {
+			return nil
+		}
+	}
+
+	if err := load(); err != nil {
+		return err
+	}
+
+	value, err = json.Marshal(dst)
+	if err != nil {
+		return fmt.Errorf("cached: marshal %s: %w", key, err)
+	}
+	if err := r.cache.Set(ctx, key, value, r.ttl); err != nil {
+		log.Println("cache set:", err)
+	}
+	return nil
+}
+
+func (r readThrough) invalidate(ctx context.Context, keys ...string) {
+	if err := r.cache.Delete(ctx, keys...); err != nil {
+		log.Println("cache delete:", err)
+	}
+}
+
+func userKey(nickname string) string {
+	return "user:" + strings.ToLower(nickname)
+}
+
+func forumKey(slug string) string {
+	return "forum:" + strings.ToLower(slug)
+}
+
+func threadIDKey(id int32) string {
+	return fmt.Sprintf("thread:id:%d", id)
+}
+
+func threadSlugKey(slug string) string {
+	return "thread:slug:" + strings.ToLower(slug)
+}
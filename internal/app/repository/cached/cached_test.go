@@ -0,0 +1,242 @@
+package cached
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/golang/mock/gomock"
+	"github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+	mock_repository "github.com/storm5758/Forum-test/internal/app/repository/mocks"
+	"github.com/storm5758/Forum-test/internal/pkg/cache"
+)
+
+const testTTL = time.Minute
+
+// caches - кэш в памяти процесса и в Redis: кэшированные репозитории должны вести себя одинаково.
+var caches = map[string]func(t *testing.T) cache.Cache{
+	"lru": func(t *testing.T) cache.Cache {
+		return cache.NewLRU(100)
+	},
+	"redis": func(t *testing.T) cache.Cache {
+		mr := miniredis.RunT(t)
+		client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+		t.Cleanup(func() { client.Close() })
+		return cache.NewRedis(client, "forum:")
+	},
+}
+
+func forEachCache(t *testing.T, test func(t *testing.T, ctrl *gomock.Controller, c cache.Cache)) {
+	for name, newCache := range caches {
+		t.Run(name, func(t *testing.T) {
+			test(t, gomock.NewController(t), newCache(t))
+		})
+	}
+}
+
+func TestForumInvalidation(t *testing.T) {
+	ctx := context.Background()
+	forEachCache(t, func(t *testing.T, ctrl *gomock.Controller, c cache.Cache) {
+		forums := mock_repository.NewMockForum(ctrl)
+		threads := mock_repository.NewMockThread(ctrl)
+		posts := mock_repository.NewMockPost(ctrl)
+		forumRepo := NewForumRepository(forums, c, testTTL)
+		threadRepo := NewThreadRepository(threads, c, testTTL)
+		postRepo := NewPostRepository(posts, c, testTTL)
+
+		gomock.InOrder(
+			forums.EXPECT().GetForumBySlug(ctx, "pirate").Return(models.Forum{Slug: "pirate"}, nil),
+			forums.EXPECT().GetForumBySlug(ctx, "pirate").Return(models.Forum{Slug: "pirate", Threads: 1}, nil),
+			forums.EXPECT().GetForumBySlug(ctx, "pirate").Return(models.Forum{Slug: "pirate", Threads: 1, Posts: 2}, nil),
+		)
+		threads.EXPECT().CreateThread(ctx, gomock.Any()).Return(models.Thread{Id: 1, Forum: "pirate"}, nil)
+		posts.EXPECT().CreatePosts(ctx, gomock.Any(), gomock.Any()).Return(make([]models.Post, 2), nil)
+
+		// второе чтение, в том числе без учёта регистра, приходит из кэша
+		mustForum(t, forumRepo, "pirate", 0, 0)
+		mustForum(t, forumRepo, "Pirate", 0, 0)
+
+		if _, err := threadRepo.CreateThread(ctx, models.Thread{Forum: "pirate"}); err != nil {
+			t.Fatal(err)
+		}
+		mustForum(t, forumRepo, "pirate", 1, 0)
+		mustForum(t, forumRepo, "pirate", 1, 0)
+
+		if _, err := postRepo.CreatePosts(ctx, models.Thread{Id: 1, Forum: "pirate"}, make([]models.Post, 2)); err != nil {
+			t.Fatal(err)
+		}
+		mustForum(t, forumRepo, "pirate", 1, 2)
+	})
+}
+
+func TestThreadInvalidation(t *testing.T) {
+	ctx := context.Background()
+	forEachCache(t, func(t *testing.T, ctrl *gomock.Controller, c cache.Cache) {
+		threads := mock_repository.NewMockThread(ctrl)
+		threadRepo := NewThreadRepository(threads, c, testTTL)
+
+		thread := models.Thread{Id: 42, Slug: "jones", Forum: "pirate", Title: "a"}
+		gomock.InOrder(
+			threads.EXPECT().GetThreadBySlugOrID(ctx, "42").Return(thread, nil),
+			threads.EXPECT().GetThreadBySlugOrID(ctx, "jones").Return(thread, nil),
+		)
+		mustThread(t, threadRepo, "42", "a", 0)
+		mustThread(t, threadRepo, "42", "a", 0)
+		mustThread(t, threadRepo, "jones", "a", 0)
+		mustThread(t, threadRepo, "JONES", "a", 0)
+
+		// изменение сбрасывает ветку по id и по slug
+		updated := thread
+		updated.Title = "b"
+		threads.EXPECT().UpdateThread(ctx, int32(42), gomock.Any()).Return(updated, nil)
+		if _, err := threadRepo.UpdateThread(ctx, 42, models.ThreadUpdate{Title: "b"}); err != nil {
+			t.Fatal(err)
+		}
+		gomock.InOrder(
+			threads.EXPECT().GetThreadBySlugOrID(ctx, "42").Return(updated, nil),
+			threads.EXPECT().GetThreadBySlugOrID(ctx, "jones").Return(updated, nil),
+		)
+		mustThread(t, threadRepo, "42", "b", 0)
+		mustThread(t, threadRepo, "jones", "b", 0)
+
+		voted := updated
+		voted.Votes = 1
+		threads.EXPECT().VoteThread(ctx, int32(42), gomock.Any()).Return(voted, nil)
+		if _, err := threadRepo.VoteThread(ctx, 42, models.Vote{Nickname: "user", Voice: 1}); err != nil {
+			t.Fatal(err)
+		}
+		gomock.InOrder(
+			threads.EXPECT().GetThreadBySlugOrID(ctx, "42").Return(voted, nil),
+			threads.EXPECT().GetThreadBySlugOrID(ctx, "jones").Return(voted, nil),
+		)
+		mustThread(t, threadRepo, "42", "b", 1)
+		mustThread(t, threadRepo, "jones", "b", 1)
+		mustThread(t, threadRepo, "42", "b", 1)
+	})
+}
+
+func TestUserInvalidation(t *testing.T) {
+	ctx := context.Background()
+	forEachCache(t, func(t *testing.T, ctrl *gomock.Controller, c cache.Cache) {
+		users := mock_repository.NewMockUser(ctrl)
+		userRepo := NewUserRepository(users, c, testTTL)
+
+		gomock.InOrder(
+			users.EXPECT().GetUserByNickname(ctx, "sparrow").Return(models.User{Nickname: "sparrow", About: "a"}, nil),
+			users.EXPECT().UpdateUser(ctx, "sparrow", gomock.Any()).Return(models.User{Nickname: "sparrow", About: "b"}, nil),
+			users.EXPECT().GetUserByNickname(ctx, "sparrow").Return(models.User{Nickname: "sparrow", About: "b"}, nil),
+		)
+		mustUser(t, userRepo, "sparrow", "a")
+		mustUser(t, userRepo, "Sparrow", "a")
+		if _, err := userRepo.UpdateUser(ctx, "sparrow", models.UserUpdate{About: "b"}); err != nil {
+			t.Fatal(err)
+		}
+		mustUser(t, userRepo, "sparrow", "b")
+		mustUser(t, userRepo, "sparrow", "b")
+	})
+}
+
+func TestErrorsAreNotCached(t *testing.T) {
+	ctx := context.Background()
+	forEachCache(t, func(t *testing.T, ctrl *gomock.Controller, c cache.Cache) {
+		forums := mock_repository.NewMockForum(ctrl)
+		forumRepo := NewForumRepository(forums, c, testTTL)
+
+		gomock.InOrder(
+			forums.EXPECT().GetForumBySlug(ctx, "pirate").Return(models.Forum{}, repository.ErrNotFound),
+			forums.EXPECT().GetForumBySlug(ctx, "pirate").Return(models.Forum{Slug: "pirate"}, nil),
+		)
+		if _, err := forumRepo.GetForumBySlug(ctx, "pirate"); err != repository.ErrNotFound {
+			t.Fatalf("GetForumBySlug() error = %v, want ErrNotFound", err)
+		}
+		mustForum(t, forumRepo, "pirate", 0, 0)
+	})
+}
+
+func TestFailedWriteKeepsCache(t *testing.T) {
+	ctx := context.Background()
+	forEachCache(t, func(t *testing.T, ctrl *gomock.Controller, c cache.Cache) {
+		threads := mock_repository.NewMockThread(ctrl)
+		threadRepo := NewThreadRepository(threads, c, testTTL)
+
+		threads.EXPECT().GetThreadBySlugOrID(ctx, "42").Return(models.Thread{Id: 42, Title: "a"}, nil)
+		threads.EXPECT().VoteThread(ctx, int32(42), gomock.Any()).Return(models.Thread{}, repository.ErrNotFound)
+
+		mustThread(t, threadRepo, "42", "a", 0)
+		if _, err := threadRepo.VoteThread(ctx, 42, models.Vote{Nickname: "user", Voice: 1}); err != repository.ErrNotFound {
+			t.Fatalf("VoteThread() error = %v, want ErrNotFound", err)
+		}
+		mustThread(t, threadRepo, "42", "a", 0)
+	})
+}
+
+func mustForum(t *testing.T, r repository.Forum, slug string, threads int32, posts int64) {
+	t.Helper()
+	forum, err := r.GetForumBySlug(context.Background(), slug)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if forum.Threads != threads || forum.Posts != posts {
+		t.Fatalf("forum %s: threads = %d, posts = %d, want %d, %d", slug, forum.Threads, forum.Posts, threads, posts)
+	}
+}
+
+func mustThread(t *testing.T, r repository.Thread, slugOrID, title string, votes int32) {
+	t.Helper()
+	thread, err := r.GetThreadBySlugOrID(context.Background(), slugOrID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if thread.Title != title || thread.Votes != votes {
+		t.Fatalf("thread %s: title = %q, votes = %d, want %q, %d", slugOrID, thread.Title, thread.Votes, title, votes)
+	}
+}
+
+func mustUser(t *testing.T, r repository.User, nickname, about string) {
+	t.Helper()
+	user, err := r.GetUserByNickname(context.Background(), nickname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.About != about {
+		t.Fatalf("user %s: about = %q, want %q", nickname, user.About, about)
+	}
+}
+
+func TestReportDeleteInvalidation(t *testing.T) {
+	ctx := context.Background()
+	forEachCache(t, func(t *testing.T, ctrl *gomock.Controller, c cache.Cache) {
+		threads := mock_repository.NewMockThread(ctrl)
+		reports := mock_repository.NewMockReport(ctrl)
+		threadRepo := NewThreadRepository(threads, c, testTTL)
+		reportRepo := NewReportRepository(reports, threads, c, testTTL)
+
+		thread := models.Thread{Id: 42, Slug: "jones", Forum: "pirate", Title: "a"}
+		threads.EXPECT().GetThreadBySlugOrID(ctx, "42").Return(thread, nil)
+		threads.EXPECT().GetThreadBySlugOrID(ctx, "jones").Return(thread, nil)
+		mustThread(t, threadRepo, "42", "a", 0)
+		mustThread(t, threadRepo, "jones", "a", 0)
+
+		// slug читается до удаления, после удаления ветка не находится ни по id, ни по slug
+		gomock.InOrder(
+			reports.EXPECT().GetReport(ctx, "pirate", int32(5)).Return(models.Report{Id: 5, Forum: "pirate", Thread: 42}, nil),
+			threads.EXPECT().GetThreadBySlugOrID(ctx, "42").Return(thread, nil),
+			reports.EXPECT().ResolveReport(ctx, "pirate", int32(5), gomock.Any()).
+				Return(models.ReportResolution{Forum: "pirate", Action: models.ReportDelete, Thread: 42}, nil),
+		)
+		if _, err := reportRepo.ResolveReport(ctx, "pirate", 5, models.ReportResolve{Action: models.ReportDelete}); err != nil {
+			t.Fatal(err)
+		}
+
+		threads.EXPECT().GetThreadBySlugOrID(ctx, "42").Return(models.Thread{}, repository.ErrNotFound)
+		threads.EXPECT().GetThreadBySlugOrID(ctx, "jones").Return(models.Thread{}, repository.ErrNotFound)
+		for _, slugOrID := range []string{"42", "jones"} {
+			if _, err := threadRepo.GetThreadBySlugOrID(ctx, slugOrID); err != repository.ErrNotFound {
+				t.Fatalf("GetThreadBySlugOrID(%s) error = %v, want ErrNotFound", slugOrID, err)
+			}
+		}
+	})
+}
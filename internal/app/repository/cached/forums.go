@@ -0,0 +1,41 @@
+package cached
+
+import (
+	"context"
+	"time"
+
+	"github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+	"github.com/storm5758/Forum-test/internal/pkg/cache"
+)
+
+type forumRepository struct {
+	repository.Forum
+	readThrough
+}
+
+// NewForumRepository возвращает репозиторий форумов, кэширующий форумы по slug.
+func NewForumRepository(forums repository.Forum, c cache.Cache, ttl time.Duration) repository.Forum {
+	return &forumRepository{
+		Forum:       forums,
+		readThrough: readThrough{cache: c, ttl: ttl},
+	}
+}
+
+func (r *forumRepository) GetForumBySlug(ctx context.Context, slug string) (models.Forum, error) {
+	var forum models.Forum
+	err := r.get(ctx, forumKey(slug), &forum, func() (err error) {
+		forum, err = r.Forum.GetForumBySlug(ctx, slug)
+		return err
+	})
+	return forum, err
+}
+
+func (r *forumRepository) SetForumTags(ctx context.Context, slug string, tags models.Tags) (models.Forum, error) {
+	updated, err := r.Forum.SetForumTags(ctx, slug, tags)
+	if err != nil {
+		return updated, err
+	}
+	r.invalidate(ctx, forumKey(updated.Slug))
+	return updated, nil
+}
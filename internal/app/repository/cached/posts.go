@@ -0,0 +1,51 @@
+package cached
+
+import (
+	"context"
+	"time"
+
+	"github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+	"github.com/storm5758/Forum-test/internal/pkg/cache"
+)
+
+type postRepository struct {
+	repository.Post
+	readThrough
+}
+
+// NewPostRepository возвращает репозиторий сообщений, сбрасывающий кэш форума
+// при создании, удалении и восстановлении сообщений, так как меняется его счётчик.
+func NewPostRepository(posts repository.Post, c cache.Cache, ttl time.Duration) repository.Post {
+	return &postRepository{
+		Post:        posts,
+		readThrough: readThrough{cache: c, ttl: ttl},
+	}
+}
+
+func (r *postRepository) CreatePosts(ctx context.Context, thread models.Thread, posts []models.Post) ([]models.Post, error) {
+	created, err := r.Post.CreatePosts(ctx, thread, posts)
+	if err != nil {
+		return created, err
+	}
+	r.invalidate(ctx, forumKey(thread.Forum))
+	return created, nil
+}
+
+func (r *postRepository) DeletePost(ctx context.Context, id int64, moderator, reason string) (models.Post, error) {
+	post, err := r.Post.DeletePost(ctx, id, moderator, reason)
+	if err != nil {
+		return post, err
+	}
+	r.invalidate(ctx, forumKey(post.Forum))
+	return post, nil
+}
+
+func (r *postRepository) RestorePost(ctx context.Context, id int64, moderator string) (models.Post, error) {
+	post, err := r.Post.RestorePost(ctx, id, moderator)
+	if err != nil {
+		return post, err
+	}
+	r.invalidate(ctx, forumKey(post.Forum))
+	return post, nil
+}
@@ -0,0 +1,59 @@
+package cached
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+	"github.com/storm5758/Forum-test/internal/pkg/cache"
+)
+
+type reportRepository struct {
+	repository.Report
+	threads repository.Thread
+	readThrough
+}
+
+// NewReportRepository возвращает репозиторий жалоб, сбрасывающий кэш форума при удалении
+// сообщения или ветки и кэш ветки при её удалении по решению модератора.
+// threads используется только для чтения slug ветки и не должен её кэшировать.
+func NewReportRepository(reports repository.Report, threads repository.Thread, c cache.Cache, ttl time.Duration) repository.Report {
+	return &reportRepository{
+		Report:      reports,
+		threads:     threads,
+		readThrough: readThrough{cache: c, ttl: ttl},
+	}
+}
+
+func (r *reportRepository) ResolveReport(ctx context.Context, forum string, id int32, resolve models.ReportResolve) (models.ReportResolution, error) {
+	// ветка кэшируется и по slug, которого нет в решении, а удалённую ветку уже не прочитать
+	var slug string
+	if resolve.Action == models.ReportDelete {
+		report, err := r.Report.GetReport(ctx, forum, id)
+		if err == nil && report.Post == 0 {
+			thread, err := r.threads.GetThreadBySlugOrID(ctx, strconv.FormatInt(int64(report.Thread), 10))
+			if err == nil {
+				slug = thread.Slug
+			}
+		}
+	}
+
+	resolution, err := r.Report.ResolveReport(ctx, forum, id, resolve)
+	if err != nil {
+		return resolution, err
+	}
+
+	if resolution.Action == models.ReportDelete {
+		keys := []string{forumKey(resolution.Forum)}
+		if resolution.Post == 0 {
+			keys = append(keys, threadIDKey(resolution.Thread))
+			if len(slug) > 0 {
+				keys = append(keys, threadSlugKey(slug))
+			}
+		}
+		r.invalidate(ctx, keys...)
+	}
+	return resolution, nil
+}
@@ -0,0 +1,92 @@
+package cached
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+	"github.com/storm5758/Forum-test/internal/pkg/cache"
+)
+
+type threadRepository struct {
+	repository.Thread
+	readThrough
+}
+
+// NewThreadRepository возвращает репозиторий веток, кэширующий ветки по id и slug.
+// Создание ветки сбрасывает кэш форума, так как меняет его счётчики.
+func NewThreadRepository(threads repository.Thread, c cache.Cache, ttl time.Duration) repository.Thread {
+	return &threadRepository{
+		Thread:      threads,
+		readThrough: readThrough{cache: c, ttl: ttl},
+	}
+}
+
+func (r *threadRepository) GetThreadBySlugOrID(ctx context.Context, slugOrID string) (models.Thread, error) {
+	key := threadSlugKey(slugOrID)
+	if id, err := strconv.ParseInt(slugOrID, 10, 32); err == nil {
+		key = threadIDKey(int32(id))
+	}
+
+	var thread models.Thread
+	err := r.get(ctx, key, &thread, func() (err error) {
+		thread, err = r.Thread.GetThreadBySlugOrID(ctx, slugOrID)
+		return err
+	})
+	return thread, err
+}
+
+func (r *threadRepository) CreateThread(ctx context.Context, t models.Thread) (models.Thread, error) {
+	created, err := r.Thread.CreateThread(ctx, t)
+	if err != nil {
+		return created, err
+	}
+	r.invalidate(ctx, forumKey(created.Forum))
+	return created, nil
+}
+
+func (r *threadRepository) UpdateThread(ctx context.Context, id int32, u models.ThreadUpdate) (models.Thread, error) {
+	updated, err := r.Thread.UpdateThread(ctx, id, u)
+	if err != nil {
+		return updated, err
+	}
+	r.invalidate(ctx, r.threadKeys(updated)...)
+	return updated, nil
+}
+
+func (r *threadRepository) VoteThread(ctx context.Context, id int32, v models.Vote) (models.Thread, error) {
+	voted, err := r.Thread.VoteThread(ctx, id, v)
+	if err != nil {
+		return voted, err
+	}
+	r.invalidate(ctx, r.threadKeys(voted)...)
+	return voted, nil
+}
+
+func (r *threadRepository) threadKeys(t models.Thread) []string {
+	keys := []string{threadIDKey(t.Id)}
+	if len(t.Slug) > 0 {
+		keys = append(keys, threadSlugKey(t.Slug))
+	}
+	return keys
+}
+
+func (r *threadRepository) SetThreadStatus(ctx context.Context, id int32, status models.ThreadStatus) (models.Thread, error) {
+	updated, err := r.Thread.SetThreadStatus(ctx, id, status)
+	if err != nil {
+		return updated, err
+	}
+	r.invalidate(ctx, r.threadKeys(updated)...)
+	return updated, nil
+}
+
+func (r *threadRepository) PinThread(ctx context.Context, id int32, pinned bool) (models.Thread, error) {
+	updated, err := r.Thread.PinThread(ctx, id, pinned)
+	if err != nil {
+		return updated, err
+	}
+	r.invalidate(ctx, r.threadKeys(updated)...)
+	return updated, nil
+}
@@ -0,0 +1,50 @@
+package cached
+
+import (
+	"context"
+	"time"
+
+	"github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+	"github.com/storm5758/Forum-test/internal/pkg/cache"
+)
+
+type userRepository struct {
+	repository.User
+	readThrough
+}
+
+// NewUserRepository возвращает репозиторий пользователей, кэширующий профили.
+func NewUserRepository(users repository.User, c cache.Cache, ttl time.Duration) repository.User {
+	return &userRepository{
+		User:        users,
+		readThrough: readThrough{cache: c, ttl: ttl},
+	}
+}
+
+func (r *userRepository) GetUserByNickname(ctx context.Context, nickname string) (models.User, error) {
+	var user models.User
+	err := r.get(ctx, userKey(nickname), &user, func() (err error) {
+		user, err = r.User.GetUserByNickname(ctx, nickname)
+		return err
+	})
+	return user, err
+}
+
+func (r *userRepository) UpdateUser(ctx context.Context, nickname string, u models.UserUpdate) (models.User, error) {
+	updated, err := r.User.UpdateUser(ctx, nickname, u)
+	if err != nil {
+		return updated, err
+	}
+	r.invalidate(ctx, userKey(nickname))
+	return updated, nil
+}
+
+func (r *userRepository) SetUserAvatar(ctx context.Context, nickname, avatar string) (models.User, error) {
+	updated, err := r.User.SetUserAvatar(ctx, nickname, avatar)
+	if err != nil {
+		return updated, err
+	}
+	r.invalidate(ctx, userKey(nickname))
+	return updated, nil
+}
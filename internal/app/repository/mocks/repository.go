@@ -7,6 +7,7 @@ package mock_repository
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	models "github.com/storm5758/Forum-test/internal/app/models"
@@ -50,6 +51,21 @@ func (mr *MockUserMockRecorder) CreateUser(ctx, u interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUser)(nil).CreateUser), ctx, u)
 }
 
+// GetUserByNickname mocks base method.
+func (m *MockUser) GetUserByNickname(ctx context.Context, nickname string) (models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByNickname", ctx, nickname)
+	ret0, _ := ret[0].(models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByNickname indicates an expected call of GetUserByNickname.
+func (mr *MockUserMockRecorder) GetUserByNickname(ctx, nickname interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByNickname", reflect.TypeOf((*MockUser)(nil).GetUserByNickname), ctx, nickname)
+}
+
 // GetUsersByNicknameOrEmail mocks base method.
 func (m *MockUser) GetUsersByNicknameOrEmail(ctx context.Context, nickname, email string) ([]models.User, error) {
 	m.ctrl.T.Helper()
@@ -64,3 +80,1046 @@ func (mr *MockUserMockRecorder) GetUsersByNicknameOrEmail(ctx, nickname, email i
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByNicknameOrEmail", reflect.TypeOf((*MockUser)(nil).GetUsersByNicknameOrEmail), ctx, nickname, email)
 }
+
+// SetUserAvatar mocks base method.
+func (m *MockUser) SetUserAvatar(ctx context.Context, nickname, avatar string) (models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserAvatar", ctx, nickname, avatar)
+	ret0, _ := ret[0].(models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetUserAvatar indicates an expected call of SetUserAvatar.
+func (mr *MockUserMockRecorder) SetUserAvatar(ctx, nickname, avatar interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserAvatar", reflect.TypeOf((*MockUser)(nil).SetUserAvatar), ctx, nickname, avatar)
+}
+
+// UpdateUser mocks base method.
+func (m *MockUser) UpdateUser(ctx context.Context, nickname string, u models.UserUpdate) (models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUser", ctx, nickname, u)
+	ret0, _ := ret[0].(models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUser indicates an expected call of UpdateUser.
+func (mr *MockUserMockRecorder) UpdateUser(ctx, nickname, u interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUser)(nil).UpdateUser), ctx, nickname, u)
+}
+
+// MockForum is a mock of Forum interface.
+type MockForum struct {
+	ctrl     *gomock.Controller
+	recorder *MockForumMockRecorder
+}
+
+// MockForumMockRecorder is the mock recorder for MockForum.
+type MockForumMockRecorder struct {
+	mock *MockForum
+}
+
+// NewMockForum creates a new mock instance.
+func NewMockForum(ctrl *gomock.Controller) *MockForum {
+	mock := &MockForum{ctrl: ctrl}
+	mock.recorder = &MockForumMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForum) EXPECT() *MockForumMockRecorder {
+	return m.recorder
+}
+
+// CreateForum mocks base method.
+func (m *MockForum) CreateForum(ctx context.Context, f models.NewForum) (models.Forum, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateForum", ctx, f)
+	ret0, _ := ret[0].(models.Forum)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateForum indicates an expected call of CreateForum.
+func (mr *MockForumMockRecorder) CreateForum(ctx, f interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateForum", reflect.TypeOf((*MockForum)(nil).CreateForum), ctx, f)
+}
+
+// GetForumBySlug mocks base method.
+func (m *MockForum) GetForumBySlug(ctx context.Context, slug string) (models.Forum, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForumBySlug", ctx, slug)
+	ret0, _ := ret[0].(models.Forum)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForumBySlug indicates an expected call of GetForumBySlug.
+func (mr *MockForumMockRecorder) GetForumBySlug(ctx, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForumBySlug", reflect.TypeOf((*MockForum)(nil).GetForumBySlug), ctx, slug)
+}
+
+// GetForumTags mocks base method.
+func (m *MockForum) GetForumTags(ctx context.Context, slug string, limit int32) ([]models.TagCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForumTags", ctx, slug, limit)
+	ret0, _ := ret[0].([]models.TagCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForumTags indicates an expected call of GetForumTags.
+func (mr *MockForumMockRecorder) GetForumTags(ctx, slug, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForumTags", reflect.TypeOf((*MockForum)(nil).GetForumTags), ctx, slug, limit)
+}
+
+// GetForumThreads mocks base method.
+func (m *MockForum) GetForumThreads(ctx context.Context, forum string, f models.ThreadFilter) ([]models.Thread, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForumThreads", ctx, forum, f)
+	ret0, _ := ret[0].([]models.Thread)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForumThreads indicates an expected call of GetForumThreads.
+func (mr *MockForumMockRecorder) GetForumThreads(ctx, forum, f interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForumThreads", reflect.TypeOf((*MockForum)(nil).GetForumThreads), ctx, forum, f)
+}
+
+// GetForumUsers mocks base method.
+func (m *MockForum) GetForumUsers(ctx context.Context, forum string, f models.UserFilter) ([]models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForumUsers", ctx, forum, f)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForumUsers indicates an expected call of GetForumUsers.
+func (mr *MockForumMockRecorder) GetForumUsers(ctx, forum, f interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForumUsers", reflect.TypeOf((*MockForum)(nil).GetForumUsers), ctx, forum, f)
+}
+
+// SetForumTags mocks base method.
+func (m *MockForum) SetForumTags(ctx context.Context, slug string, tags models.Tags) (models.Forum, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetForumTags", ctx, slug, tags)
+	ret0, _ := ret[0].(models.Forum)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetForumTags indicates an expected call of SetForumTags.
+func (mr *MockForumMockRecorder) SetForumTags(ctx, slug, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetForumTags", reflect.TypeOf((*MockForum)(nil).SetForumTags), ctx, slug, tags)
+}
+
+// MockThread is a mock of Thread interface.
+type MockThread struct {
+	ctrl     *gomock.Controller
+	recorder *MockThreadMockRecorder
+}
+
+// MockThreadMockRecorder is the mock recorder for MockThread.
+type MockThreadMockRecorder struct {
+	mock *MockThread
+}
+
+// NewMockThread creates a new mock instance.
+func NewMockThread(ctrl *gomock.Controller) *MockThread {
+	mock := &MockThread{ctrl: ctrl}
+	mock.recorder = &MockThreadMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockThread) EXPECT() *MockThreadMockRecorder {
+	return m.recorder
+}
+
+// CreateThread mocks base method.
+func (m *MockThread) CreateThread(ctx context.Context, t models.Thread) (models.Thread, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateThread", ctx, t)
+	ret0, _ := ret[0].(models.Thread)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateThread indicates an expected call of CreateThread.
+func (mr *MockThreadMockRecorder) CreateThread(ctx, t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateThread", reflect.TypeOf((*MockThread)(nil).CreateThread), ctx, t)
+}
+
+// GetThreadBySlugOrID mocks base method.
+func (m *MockThread) GetThreadBySlugOrID(ctx context.Context, slugOrID string) (models.Thread, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetThreadBySlugOrID", ctx, slugOrID)
+	ret0, _ := ret[0].(models.Thread)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetThreadBySlugOrID indicates an expected call of GetThreadBySlugOrID.
+func (mr *MockThreadMockRecorder) GetThreadBySlugOrID(ctx, slugOrID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThreadBySlugOrID", reflect.TypeOf((*MockThread)(nil).GetThreadBySlugOrID), ctx, slugOrID)
+}
+
+// GetThreadPosts mocks base method.
+func (m *MockThread) GetThreadPosts(ctx context.Context, thread int32, f models.PostFilter) ([]models.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetThreadPosts", ctx, thread, f)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetThreadPosts indicates an expected call of GetThreadPosts.
+func (mr *MockThreadMockRecorder) GetThreadPosts(ctx, thread, f interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThreadPosts", reflect.TypeOf((*MockThread)(nil).GetThreadPosts), ctx, thread, f)
+}
+
+// PinThread mocks base method.
+func (m *MockThread) PinThread(ctx context.Context, id int32, pinned bool) (models.Thread, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PinThread", ctx, id, pinned)
+	ret0, _ := ret[0].(models.Thread)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PinThread indicates an expected call of PinThread.
+func (mr *MockThreadMockRecorder) PinThread(ctx, id, pinned interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinThread", reflect.TypeOf((*MockThread)(nil).PinThread), ctx, id, pinned)
+}
+
+// SetThreadStatus mocks base method.
+func (m *MockThread) SetThreadStatus(ctx context.Context, id int32, status models.ThreadStatus) (models.Thread, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetThreadStatus", ctx, id, status)
+	ret0, _ := ret[0].(models.Thread)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetThreadStatus indicates an expected call of SetThreadStatus.
+func (mr *MockThreadMockRecorder) SetThreadStatus(ctx, id, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetThreadStatus", reflect.TypeOf((*MockThread)(nil).SetThreadStatus), ctx, id, status)
+}
+
+// UpdateThread mocks base method.
+func (m *MockThread) UpdateThread(ctx context.Context, id int32, u models.ThreadUpdate) (models.Thread, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateThread", ctx, id, u)
+	ret0, _ := ret[0].(models.Thread)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateThread indicates an expected call of UpdateThread.
+func (mr *MockThreadMockRecorder) UpdateThread(ctx, id, u interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateThread", reflect.TypeOf((*MockThread)(nil).UpdateThread), ctx, id, u)
+}
+
+// VoteThread mocks base method.
+func (m *MockThread) VoteThread(ctx context.Context, id int32, v models.Vote) (models.Thread, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoteThread", ctx, id, v)
+	ret0, _ := ret[0].(models.Thread)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VoteThread indicates an expected call of VoteThread.
+func (mr *MockThreadMockRecorder) VoteThread(ctx, id, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoteThread", reflect.TypeOf((*MockThread)(nil).VoteThread), ctx, id, v)
+}
+
+// MockPost is a mock of Post interface.
+type MockPost struct {
+	ctrl     *gomock.Controller
+	recorder *MockPostMockRecorder
+}
+
+// MockPostMockRecorder is the mock recorder for MockPost.
+type MockPostMockRecorder struct {
+	mock *MockPost
+}
+
+// NewMockPost creates a new mock instance.
+func NewMockPost(ctrl *gomock.Controller) *MockPost {
+	mock := &MockPost{ctrl: ctrl}
+	mock.recorder = &MockPostMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPost) EXPECT() *MockPostMockRecorder {
+	return m.recorder
+}
+
+// AddPostReaction mocks base method.
+func (m *MockPost) AddPostReaction(ctx context.Context, id int64, nickname, emoji string) (models.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPostReaction", ctx, id, nickname, emoji)
+	ret0, _ := ret[0].(models.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPostReaction indicates an expected call of AddPostReaction.
+func (mr *MockPostMockRecorder) AddPostReaction(ctx, id, nickname, emoji interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPostReaction", reflect.TypeOf((*MockPost)(nil).AddPostReaction), ctx, id, nickname, emoji)
+}
+
+// CreatePosts mocks base method.
+func (m *MockPost) CreatePosts(ctx context.Context, thread models.Thread, posts []models.Post) ([]models.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePosts", ctx, thread, posts)
+	ret0, _ := ret[0].([]models.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePosts indicates an expected call of CreatePosts.
+func (mr *MockPostMockRecorder) CreatePosts(ctx, thread, posts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePosts", reflect.TypeOf((*MockPost)(nil).CreatePosts), ctx, thread, posts)
+}
+
+// DeletePost mocks base method.
+func (m *MockPost) DeletePost(ctx context.Context, id int64, moderator, reason string) (models.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePost", ctx, id, moderator, reason)
+	ret0, _ := ret[0].(models.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePost indicates an expected call of DeletePost.
+func (mr *MockPostMockRecorder) DeletePost(ctx, id, moderator, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePost", reflect.TypeOf((*MockPost)(nil).DeletePost), ctx, id, moderator, reason)
+}
+
+// GetPostByID mocks base method.
+func (m *MockPost) GetPostByID(ctx context.Context, id int64) (models.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostByID", ctx, id)
+	ret0, _ := ret[0].(models.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPostByID indicates an expected call of GetPostByID.
+func (mr *MockPostMockRecorder) GetPostByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostByID", reflect.TypeOf((*MockPost)(nil).GetPostByID), ctx, id)
+}
+
+// PurgeDeletedPosts mocks base method.
+func (m *MockPost) PurgeDeletedPosts(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedPosts", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedPosts indicates an expected call of PurgeDeletedPosts.
+func (mr *MockPostMockRecorder) PurgeDeletedPosts(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedPosts", reflect.TypeOf((*MockPost)(nil).PurgeDeletedPosts), ctx, before)
+}
+
+// RemovePostReaction mocks base method.
+func (m *MockPost) RemovePostReaction(ctx context.Context, id int64, nickname, emoji string) (models.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePostReaction", ctx, id, nickname, emoji)
+	ret0, _ := ret[0].(models.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemovePostReaction indicates an expected call of RemovePostReaction.
+func (mr *MockPostMockRecorder) RemovePostReaction(ctx, id, nickname, emoji interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePostReaction", reflect.TypeOf((*MockPost)(nil).RemovePostReaction), ctx, id, nickname, emoji)
+}
+
+// RestorePost mocks base method.
+func (m *MockPost) RestorePost(ctx context.Context, id int64, moderator string) (models.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestorePost", ctx, id, moderator)
+	ret0, _ := ret[0].(models.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestorePost indicates an expected call of RestorePost.
+func (mr *MockPostMockRecorder) RestorePost(ctx, id, moderator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestorePost", reflect.TypeOf((*MockPost)(nil).RestorePost), ctx, id, moderator)
+}
+
+// VotePost mocks base method.
+func (m *MockPost) VotePost(ctx context.Context, id int64, v models.Vote) (models.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VotePost", ctx, id, v)
+	ret0, _ := ret[0].(models.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VotePost indicates an expected call of VotePost.
+func (mr *MockPostMockRecorder) VotePost(ctx, id, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VotePost", reflect.TypeOf((*MockPost)(nil).VotePost), ctx, id, v)
+}
+
+// MockSearch is a mock of Search interface.
+type MockSearch struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchMockRecorder
+}
+
+// MockSearchMockRecorder is the mock recorder for MockSearch.
+type MockSearchMockRecorder struct {
+	mock *MockSearch
+}
+
+// NewMockSearch creates a new mock instance.
+func NewMockSearch(ctrl *gomock.Controller) *MockSearch {
+	mock := &MockSearch{ctrl: ctrl}
+	mock.recorder = &MockSearchMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSearch) EXPECT() *MockSearchMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method.
+func (m *MockSearch) Search(ctx context.Context, q models.SearchQuery) ([]models.SearchHit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, q)
+	ret0, _ := ret[0].([]models.SearchHit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockSearchMockRecorder) Search(ctx, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSearch)(nil).Search), ctx, q)
+}
+
+// MockOutbox is a mock of Outbox interface.
+type MockOutbox struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxMockRecorder
+}
+
+// MockOutboxMockRecorder is the mock recorder for MockOutbox.
+type MockOutboxMockRecorder struct {
+	mock *MockOutbox
+}
+
+// NewMockOutbox creates a new mock instance.
+func NewMockOutbox(ctrl *gomock.Controller) *MockOutbox {
+	mock := &MockOutbox{ctrl: ctrl}
+	mock.recorder = &MockOutboxMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutbox) EXPECT() *MockOutboxMockRecorder {
+	return m.recorder
+}
+
+// PublishEvents mocks base method.
+func (m *MockOutbox) PublishEvents(ctx context.Context, limit, maxAttempts int, publish func(context.Context, []models.Event) error) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishEvents", ctx, limit, maxAttempts, publish)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishEvents indicates an expected call of PublishEvents.
+func (mr *MockOutboxMockRecorder) PublishEvents(ctx, limit, maxAttempts, publish interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishEvents", reflect.TypeOf((*MockOutbox)(nil).PublishEvents), ctx, limit, maxAttempts, publish)
+}
+
+// PurgeEvents mocks base method.
+func (m *MockOutbox) PurgeEvents(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeEvents", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeEvents indicates an expected call of PurgeEvents.
+func (mr *MockOutboxMockRecorder) PurgeEvents(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeEvents", reflect.TypeOf((*MockOutbox)(nil).PurgeEvents), ctx, before)
+}
+
+// MockWebhook is a mock of Webhook interface.
+type MockWebhook struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookMockRecorder
+}
+
+// MockWebhookMockRecorder is the mock recorder for MockWebhook.
+type MockWebhookMockRecorder struct {
+	mock *MockWebhook
+}
+
+// NewMockWebhook creates a new mock instance.
+func NewMockWebhook(ctrl *gomock.Controller) *MockWebhook {
+	mock := &MockWebhook{ctrl: ctrl}
+	mock.recorder = &MockWebhookMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhook) EXPECT() *MockWebhookMockRecorder {
+	return m.recorder
+}
+
+// AddWebhookDeliveries mocks base method.
+func (m *MockWebhook) AddWebhookDeliveries(ctx context.Context, event models.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWebhookDeliveries", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddWebhookDeliveries indicates an expected call of AddWebhookDeliveries.
+func (mr *MockWebhookMockRecorder) AddWebhookDeliveries(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWebhookDeliveries", reflect.TypeOf((*MockWebhook)(nil).AddWebhookDeliveries), ctx, event)
+}
+
+// ClaimWebhookDeliveries mocks base method.
+func (m *MockWebhook) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]models.PendingDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimWebhookDeliveries", ctx, limit, lease)
+	ret0, _ := ret[0].([]models.PendingDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimWebhookDeliveries indicates an expected call of ClaimWebhookDeliveries.
+func (mr *MockWebhookMockRecorder) ClaimWebhookDeliveries(ctx, limit, lease interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimWebhookDeliveries", reflect.TypeOf((*MockWebhook)(nil).ClaimWebhookDeliveries), ctx, limit, lease)
+}
+
+// CompleteWebhookDelivery mocks base method.
+func (m *MockWebhook) CompleteWebhookDelivery(ctx context.Context, id int64, result models.DeliveryResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteWebhookDelivery", ctx, id, result)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteWebhookDelivery indicates an expected call of CompleteWebhookDelivery.
+func (mr *MockWebhookMockRecorder) CompleteWebhookDelivery(ctx, id, result interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteWebhookDelivery", reflect.TypeOf((*MockWebhook)(nil).CompleteWebhookDelivery), ctx, id, result)
+}
+
+// CreateWebhook mocks base method.
+func (m *MockWebhook) CreateWebhook(ctx context.Context, w models.Webhook) (models.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", ctx, w)
+	ret0, _ := ret[0].(models.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockWebhookMockRecorder) CreateWebhook(ctx, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockWebhook)(nil).CreateWebhook), ctx, w)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockWebhook) DeleteWebhook(ctx context.Context, forum string, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", ctx, forum, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockWebhookMockRecorder) DeleteWebhook(ctx, forum, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockWebhook)(nil).DeleteWebhook), ctx, forum, id)
+}
+
+// GetForumWebhooks mocks base method.
+func (m *MockWebhook) GetForumWebhooks(ctx context.Context, forum string) ([]models.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForumWebhooks", ctx, forum)
+	ret0, _ := ret[0].([]models.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForumWebhooks indicates an expected call of GetForumWebhooks.
+func (mr *MockWebhookMockRecorder) GetForumWebhooks(ctx, forum interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForumWebhooks", reflect.TypeOf((*MockWebhook)(nil).GetForumWebhooks), ctx, forum)
+}
+
+// GetWebhook mocks base method.
+func (m *MockWebhook) GetWebhook(ctx context.Context, forum string, id int32) (models.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhook", ctx, forum, id)
+	ret0, _ := ret[0].(models.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhook indicates an expected call of GetWebhook.
+func (mr *MockWebhookMockRecorder) GetWebhook(ctx, forum, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhook", reflect.TypeOf((*MockWebhook)(nil).GetWebhook), ctx, forum, id)
+}
+
+// GetWebhookDeliveries mocks base method.
+func (m *MockWebhook) GetWebhookDeliveries(ctx context.Context, webhook int32, f models.DeliveryFilter) ([]models.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookDeliveries", ctx, webhook, f)
+	ret0, _ := ret[0].([]models.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookDeliveries indicates an expected call of GetWebhookDeliveries.
+func (mr *MockWebhookMockRecorder) GetWebhookDeliveries(ctx, webhook, f interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDeliveries", reflect.TypeOf((*MockWebhook)(nil).GetWebhookDeliveries), ctx, webhook, f)
+}
+
+// RedeliverWebhookDelivery mocks base method.
+func (m *MockWebhook) RedeliverWebhookDelivery(ctx context.Context, webhook int32, id int64) (models.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedeliverWebhookDelivery", ctx, webhook, id)
+	ret0, _ := ret[0].(models.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedeliverWebhookDelivery indicates an expected call of RedeliverWebhookDelivery.
+func (mr *MockWebhookMockRecorder) RedeliverWebhookDelivery(ctx, webhook, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeliverWebhookDelivery", reflect.TypeOf((*MockWebhook)(nil).RedeliverWebhookDelivery), ctx, webhook, id)
+}
+
+// MockBan is a mock of Ban interface.
+type MockBan struct {
+	ctrl     *gomock.Controller
+	recorder *MockBanMockRecorder
+}
+
+// MockBanMockRecorder is the mock recorder for MockBan.
+type MockBanMockRecorder struct {
+	mock *MockBan
+}
+
+// NewMockBan creates a new mock instance.
+func NewMockBan(ctrl *gomock.Controller) *MockBan {
+	mock := &MockBan{ctrl: ctrl}
+	mock.recorder = &MockBanMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBan) EXPECT() *MockBanMockRecorder {
+	return m.recorder
+}
+
+// CreateBan mocks base method.
+func (m *MockBan) CreateBan(ctx context.Context, b models.Ban) (models.Ban, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBan", ctx, b)
+	ret0, _ := ret[0].(models.Ban)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBan indicates an expected call of CreateBan.
+func (mr *MockBanMockRecorder) CreateBan(ctx, b interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBan", reflect.TypeOf((*MockBan)(nil).CreateBan), ctx, b)
+}
+
+// GetActiveBan mocks base method.
+func (m *MockBan) GetActiveBan(ctx context.Context, nickname, forum string) (models.Ban, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveBan", ctx, nickname, forum)
+	ret0, _ := ret[0].(models.Ban)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveBan indicates an expected call of GetActiveBan.
+func (mr *MockBanMockRecorder) GetActiveBan(ctx, nickname, forum interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveBan", reflect.TypeOf((*MockBan)(nil).GetActiveBan), ctx, nickname, forum)
+}
+
+// GetBan mocks base method.
+func (m *MockBan) GetBan(ctx context.Context, id int32) (models.Ban, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBan", ctx, id)
+	ret0, _ := ret[0].(models.Ban)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBan indicates an expected call of GetBan.
+func (mr *MockBanMockRecorder) GetBan(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBan", reflect.TypeOf((*MockBan)(nil).GetBan), ctx, id)
+}
+
+// GetBans mocks base method.
+func (m *MockBan) GetBans(ctx context.Context, f models.BanFilter) ([]models.Ban, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBans", ctx, f)
+	ret0, _ := ret[0].([]models.Ban)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBans indicates an expected call of GetBans.
+func (mr *MockBanMockRecorder) GetBans(ctx, f interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBans", reflect.TypeOf((*MockBan)(nil).GetBans), ctx, f)
+}
+
+// LiftBan mocks base method.
+func (m *MockBan) LiftBan(ctx context.Context, id int32, moderator string) (models.Ban, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LiftBan", ctx, id, moderator)
+	ret0, _ := ret[0].(models.Ban)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LiftBan indicates an expected call of LiftBan.
+func (mr *MockBanMockRecorder) LiftBan(ctx, id, moderator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LiftBan", reflect.TypeOf((*MockBan)(nil).LiftBan), ctx, id, moderator)
+}
+
+// MockReport is a mock of Report interface.
+type MockReport struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportMockRecorder
+}
+
+// MockReportMockRecorder is the mock recorder for MockReport.
+type MockReportMockRecorder struct {
+	mock *MockReport
+}
+
+// NewMockReport creates a new mock instance.
+func NewMockReport(ctrl *gomock.Controller) *MockReport {
+	mock := &MockReport{ctrl: ctrl}
+	mock.recorder = &MockReportMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReport) EXPECT() *MockReportMockRecorder {
+	return m.recorder
+}
+
+// CreateReport mocks base method.
+func (m *MockReport) CreateReport(ctx context.Context, r models.Report) (models.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReport", ctx, r)
+	ret0, _ := ret[0].(models.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReport indicates an expected call of CreateReport.
+func (mr *MockReportMockRecorder) CreateReport(ctx, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReport", reflect.TypeOf((*MockReport)(nil).CreateReport), ctx, r)
+}
+
+// GetReport mocks base method.
+func (m *MockReport) GetReport(ctx context.Context, forum string, id int32) (models.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReport", ctx, forum, id)
+	ret0, _ := ret[0].(models.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReport indicates an expected call of GetReport.
+func (mr *MockReportMockRecorder) GetReport(ctx, forum, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockReport)(nil).GetReport), ctx, forum, id)
+}
+
+// GetReportQueue mocks base method.
+func (m *MockReport) GetReportQueue(ctx context.Context, forum string, f models.ReportQueueFilter) ([]models.ReportTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReportQueue", ctx, forum, f)
+	ret0, _ := ret[0].([]models.ReportTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReportQueue indicates an expected call of GetReportQueue.
+func (mr *MockReportMockRecorder) GetReportQueue(ctx, forum, f interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReportQueue", reflect.TypeOf((*MockReport)(nil).GetReportQueue), ctx, forum, f)
+}
+
+// ResolveReport mocks base method.
+func (m *MockReport) ResolveReport(ctx context.Context, forum string, id int32, r models.ReportResolve) (models.ReportResolution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveReport", ctx, forum, id, r)
+	ret0, _ := ret[0].(models.ReportResolution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveReport indicates an expected call of ResolveReport.
+func (mr *MockReportMockRecorder) ResolveReport(ctx, forum, id, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveReport", reflect.TypeOf((*MockReport)(nil).ResolveReport), ctx, forum, id, r)
+}
+
+// MockNotification is a mock of Notification interface.
+type MockNotification struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationMockRecorder
+}
+
+// MockNotificationMockRecorder is the mock recorder for MockNotification.
+type MockNotificationMockRecorder struct {
+	mock *MockNotification
+}
+
+// NewMockNotification creates a new mock instance.
+func NewMockNotification(ctrl *gomock.Controller) *MockNotification {
+	mock := &MockNotification{ctrl: ctrl}
+	mock.recorder = &MockNotificationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotification) EXPECT() *MockNotificationMockRecorder {
+	return m.recorder
+}
+
+// AddMentionNotifications mocks base method.
+func (m *MockNotification) AddMentionNotifications(ctx context.Context, forum string, mentions []models.Mention) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMentionNotifications", ctx, forum, mentions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMentionNotifications indicates an expected call of AddMentionNotifications.
+func (mr *MockNotificationMockRecorder) AddMentionNotifications(ctx, forum, mentions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMentionNotifications", reflect.TypeOf((*MockNotification)(nil).AddMentionNotifications), ctx, forum, mentions)
+}
+
+// AddReplyNotifications mocks base method.
+func (m *MockNotification) AddReplyNotifications(ctx context.Context, posts []models.Post) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReplyNotifications", ctx, posts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddReplyNotifications indicates an expected call of AddReplyNotifications.
+func (mr *MockNotificationMockRecorder) AddReplyNotifications(ctx, posts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReplyNotifications", reflect.TypeOf((*MockNotification)(nil).AddReplyNotifications), ctx, posts)
+}
+
+// CountUnreadNotifications mocks base method.
+func (m *MockNotification) CountUnreadNotifications(ctx context.Context, nickname string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnreadNotifications", ctx, nickname)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnreadNotifications indicates an expected call of CountUnreadNotifications.
+func (mr *MockNotificationMockRecorder) CountUnreadNotifications(ctx, nickname interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnreadNotifications", reflect.TypeOf((*MockNotification)(nil).CountUnreadNotifications), ctx, nickname)
+}
+
+// GetNotifications mocks base method.
+func (m *MockNotification) GetNotifications(ctx context.Context, nickname string, f models.NotificationFilter) ([]models.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifications", ctx, nickname, f)
+	ret0, _ := ret[0].([]models.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifications indicates an expected call of GetNotifications.
+func (mr *MockNotificationMockRecorder) GetNotifications(ctx, nickname, f interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockNotification)(nil).GetNotifications), ctx, nickname, f)
+}
+
+// MarkNotificationsRead mocks base method.
+func (m *MockNotification) MarkNotificationsRead(ctx context.Context, nickname string, ids []int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationsRead", ctx, nickname, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkNotificationsRead indicates an expected call of MarkNotificationsRead.
+func (mr *MockNotificationMockRecorder) MarkNotificationsRead(ctx, nickname, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationsRead", reflect.TypeOf((*MockNotification)(nil).MarkNotificationsRead), ctx, nickname, ids)
+}
+
+// MockAttachment is a mock of Attachment interface.
+type MockAttachment struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentMockRecorder
+}
+
+// MockAttachmentMockRecorder is the mock recorder for MockAttachment.
+type MockAttachmentMockRecorder struct {
+	mock *MockAttachment
+}
+
+// NewMockAttachment creates a new mock instance.
+func NewMockAttachment(ctrl *gomock.Controller) *MockAttachment {
+	mock := &MockAttachment{ctrl: ctrl}
+	mock.recorder = &MockAttachmentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachment) EXPECT() *MockAttachmentMockRecorder {
+	return m.recorder
+}
+
+// CreateAttachment mocks base method.
+func (m *MockAttachment) CreateAttachment(ctx context.Context, a models.Attachment, quota int64) (models.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAttachment", ctx, a, quota)
+	ret0, _ := ret[0].(models.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAttachment indicates an expected call of CreateAttachment.
+func (mr *MockAttachmentMockRecorder) CreateAttachment(ctx, a, quota interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttachment", reflect.TypeOf((*MockAttachment)(nil).CreateAttachment), ctx, a, quota)
+}
+
+// DeleteOrphanAttachments mocks base method.
+func (m *MockAttachment) DeleteOrphanAttachments(ctx context.Context, before time.Time, limit int) ([]models.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrphanAttachments", ctx, before, limit)
+	ret0, _ := ret[0].([]models.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrphanAttachments indicates an expected call of DeleteOrphanAttachments.
+func (mr *MockAttachmentMockRecorder) DeleteOrphanAttachments(ctx, before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrphanAttachments", reflect.TypeOf((*MockAttachment)(nil).DeleteOrphanAttachments), ctx, before, limit)
+}
+
+// GetAttachment mocks base method.
+func (m *MockAttachment) GetAttachment(ctx context.Context, id int64) (models.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachment", ctx, id)
+	ret0, _ := ret[0].(models.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachment indicates an expected call of GetAttachment.
+func (mr *MockAttachmentMockRecorder) GetAttachment(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachment", reflect.TypeOf((*MockAttachment)(nil).GetAttachment), ctx, id)
+}
+
+// MockRole is a mock of Role interface.
+type MockRole struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleMockRecorder
+}
+
+// MockRoleMockRecorder is the mock recorder for MockRole.
+type MockRoleMockRecorder struct {
+	mock *MockRole
+}
+
+// NewMockRole creates a new mock instance.
+func NewMockRole(ctrl *gomock.Controller) *MockRole {
+	mock := &MockRole{ctrl: ctrl}
+	mock.recorder = &MockRoleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRole) EXPECT() *MockRoleMockRecorder {
+	return m.recorder
+}
+
+// GetUserRoles mocks base method.
+func (m *MockRole) GetUserRoles(ctx context.Context, nickname string) ([]models.UserRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRoles", ctx, nickname)
+	ret0, _ := ret[0].([]models.UserRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserRoles indicates an expected call of GetUserRoles.
+func (mr *MockRoleMockRecorder) GetUserRoles(ctx, nickname interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRoles", reflect.TypeOf((*MockRole)(nil).GetUserRoles), ctx, nickname)
+}
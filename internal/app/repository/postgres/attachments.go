@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+)
+
+func (r *Repository) CreateAttachment(ctx context.Context, attachment models.Attachment, quota int64) (models.Attachment, error) {
+	var created models.Attachment
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		stmt, err := r.writer(ctx, lockAttachmentOwner)
+		if err != nil {
+			return err
+		}
+		var owner string
+		if err := stmt.GetContext(ctx, &owner, attachment.Owner); err != nil {
+			return convertError(err)
+		}
+
+		stmt, err = r.writer(ctx, insertAttachment)
+		if err != nil {
+			return err
+		}
+		err = stmt.GetContext(ctx, &created,
+			owner, attachment.Filename, attachment.ContentType, attachment.Size, attachment.Key, quota)
+		if errors.Is(convertError(err), repository.ErrNotFound) {
+			return repository.ErrQuotaExceeded
+		}
+		return convertError(err)
+	})
+	if err != nil {
+		return models.Attachment{}, errors.Wrap(err, "CreateAttachment")
+	}
+
+	return created, nil
+}
+
+func (r *Repository) GetAttachment(ctx context.Context, id int64) (models.Attachment, error) {
+	stmt, err := r.reader(ctx, selectAttachmentByID)
+	if err != nil {
+		return models.Attachment{}, errors.Wrap(err, "GetAttachment")
+	}
+
+	var attachment models.Attachment
+	if err := stmt.GetContext(ctx, &attachment, id); err != nil {
+		return models.Attachment{}, errors.Wrap(convertError(err), "GetAttachment:GetContext()")
+	}
+	return attachment, nil
+}
+
+func (r *Repository) DeleteOrphanAttachments(ctx context.Context, before time.Time, limit int) ([]models.Attachment, error) {
+	stmt, err := r.writer(ctx, deleteOrphanAttachments)
+	if err != nil {
+		return nil, errors.Wrap(err, "DeleteOrphanAttachments")
+	}
+
+	deleted := make([]models.Attachment, 0)
+	if err := stmt.SelectContext(ctx, &deleted, before, limit); err != nil {
+		return nil, errors.Wrap(err, "DeleteOrphanAttachments:SelectContext()")
+	}
+	return deleted, nil
+}
+
+// attachToPosts прикрепляет к созданным сообщениям created файлы из Attachments исходных сообщений posts
+// и заполняет сведения о них. Выполняется в транзакции создания сообщений.
+func (r *Repository) attachToPosts(ctx context.Context, posts, created []models.Post) error {
+	var attachments, ids []int64
+	var authors []string
+	for i, post := range posts {
+		for _, attachment := range post.Attachments {
+			attachments = append(attachments, attachment.Id)
+			ids = append(ids, created[i].Id)
+			authors = append(authors, created[i].Author)
+		}
+	}
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	stmt, err := r.writer(ctx, attachToPosts)
+	if err != nil {
+		return err
+	}
+	attached := make([]models.Attachment, 0, len(attachments))
+	err = stmt.SelectContext(ctx, &attached, pq.Array(attachments), pq.Array(ids), pq.Array(authors))
+	if err != nil {
+		return errors.Wrap(err, "attachToPosts:SelectContext()")
+	}
+	if len(attached) != len(attachments) {
+		return repository.ErrInvalidAttachment
+	}
+
+	byPost := make(map[int64]models.Attachments, len(created))
+	for _, attachment := range attached {
+		byPost[attachment.Post] = append(byPost[attachment.Post], attachment)
+	}
+	for i := range created {
+		if list, ok := byPost[created[i].Id]; ok {
+			sort.Slice(list, func(a, b int) bool { return list[a].Id < list[b].Id })
+			created[i].Attachments = list
+		}
+	}
+	return nil
+}
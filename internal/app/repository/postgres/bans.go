@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+	"github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+)
+
+func (r *Repository) CreateBan(ctx context.Context, ban models.Ban) (models.Ban, error) {
+	var created models.Ban
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		stmt, err := r.writer(ctx, insertBan)
+		if err != nil {
+			return err
+		}
+		var expires interface{}
+		if ban.Expires != nil {
+			expires = *ban.Expires
+		}
+		err = stmt.GetContext(ctx, &created, ban.Nickname, ban.Forum, ban.Shadow, ban.Reason, ban.Moderator, expires)
+		if err != nil {
+			return convertError(err)
+		}
+		return r.addEvent(ctx, models.EventBanCreated, created.Forum, created)
+	})
+	if err != nil {
+		return models.Ban{}, errors.Wrap(err, "CreateBan")
+	}
+
+	return created, nil
+}
+
+func (r *Repository) GetBan(ctx context.Context, id int32) (models.Ban, error) {
+	stmt, err := r.reader(ctx, selectBanByID)
+	if err != nil {
+		return models.Ban{}, errors.Wrap(err, "GetBan")
+	}
+
+	var ban models.Ban
+	if err := stmt.GetContext(ctx, &ban, id); err != nil {
+		return models.Ban{}, errors.Wrap(convertError(err), "GetBan:GetContext()")
+	}
+	return ban, nil
+}
+
+func (r *Repository) GetBans(ctx context.Context, filter models.BanFilter) ([]models.Ban, error) {
+	stmt, err := r.reader(ctx, selectBans)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetBans")
+	}
+
+	bans := make([]models.Ban, 0)
+	err = stmt.SelectContext(ctx, &bans, filter.Forum, filter.Nickname, filter.IncludeInactive, limitArg(filter.Limit))
+	if err != nil {
+		return nil, errors.Wrap(err, "GetBans:SelectContext()")
+	}
+	return bans, nil
+}
+
+func (r *Repository) GetActiveBan(ctx context.Context, nickname, forum string) (models.Ban, error) {
+	stmt, err := r.reader(ctx, selectActiveBan)
+	if err != nil {
+		return models.Ban{}, errors.Wrap(err, "GetActiveBan")
+	}
+
+	var ban models.Ban
+	if err := stmt.GetContext(ctx, &ban, nickname, forum); err != nil {
+		return models.Ban{}, errors.Wrap(convertError(err), "GetActiveBan:GetContext()")
+	}
+	return ban, nil
+}
+
+func (r *Repository) LiftBan(ctx context.Context, id int32, moderator string) (models.Ban, error) {
+	var lifted models.Ban
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		stmt, err := r.writer(ctx, liftBan)
+		if err != nil {
+			return err
+		}
+		err = stmt.GetContext(ctx, &lifted, id, moderator)
+		if errors.Is(err, sql.ErrNoRows) {
+			// блокировки нет или она уже не действует
+			if _, err := r.GetBan(ctx, id); err != nil {
+				return err
+			}
+			return repository.ErrWrongState
+		}
+		if err != nil {
+			return err
+		}
+		return r.addEvent(ctx, models.EventBanLifted, lifted.Forum, lifted)
+	})
+	if err != nil {
+		return models.Ban{}, errors.Wrap(err, "LiftBan")
+	}
+
+	return lifted, nil
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"database/sql"
+
+	"github.com/jackc/pgconn"
+	"github.com/pkg/errors"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+)
+
+const (
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+)
+
+// convertError приводит ошибки базы данных к ошибкам пакета repository.
+func convertError(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return repository.ErrNotFound
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case uniqueViolation:
+			return repository.ErrAlreadyExists
+		case foreignKeyViolation:
+			return repository.ErrNotFound
+		}
+	}
+	return err
+}
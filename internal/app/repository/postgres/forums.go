@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/storm5758/Forum-test/internal/app/models"
+)
+
+func (r *Repository) GetForumBySlug(ctx context.Context, slug string) (models.Forum, error) {
+	stmt, err := r.reader(ctx, selectForumBySlug)
+	if err != nil {
+		return models.Forum{}, errors.Wrap(err, "GetForumBySlug")
+	}
+
+	var forum models.Forum
+	err = stmt.GetContext(ctx, &forum, slug)
+	if err != nil {
+		return models.Forum{}, errors.Wrap(convertError(err), "GetForumBySlug:GetContext()")
+	}
+
+	return forum, nil
+}
+
+func (r *Repository) CreateForum(ctx context.Context, forum models.NewForum) (models.Forum, error) {
+	var created models.Forum
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		stmt, err := r.writer(ctx, insertForum)
+		if err != nil {
+			return err
+		}
+		if err := stmt.GetContext(ctx, &created, forum.Slug, forum.Title, forum.User); err != nil {
+			return convertError(err)
+		}
+		return r.addEvent(ctx, models.EventForumCreated, created.Slug, created)
+	})
+	if err != nil {
+		return models.Forum{}, errors.Wrap(err, "CreateForum")
+	}
+
+	return created, nil
+}
+
+func (r *Repository) SetForumTags(ctx context.Context, slug string, tags models.Tags) (models.Forum, error) {
+	var updated models.Forum
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		stmt, err := r.writer(ctx, updateForumTags)
+		if err != nil {
+			return err
+		}
+		if tags == nil {
+			tags = models.Tags{}
+		}
+		if err := stmt.GetContext(ctx, &updated, slug, pq.Array([]string(tags))); err != nil {
+			return convertError(err)
+		}
+		return r.addEvent(ctx, models.EventForumUpdated, updated.Slug, updated)
+	})
+	if err != nil {
+		return models.Forum{}, errors.Wrap(err, "SetForumTags")
+	}
+
+	return updated, nil
+}
+
+func (r *Repository) GetForumTags(ctx context.Context, slug string, limit int32) ([]models.TagCount, error) {
+	stmt, err := r.reader(ctx, selectForumTags)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetForumTags")
+	}
+
+	tags := make([]models.TagCount, 0)
+	if err := stmt.SelectContext(ctx, &tags, slug, limitArg(limit)); err != nil {
+		return nil, errors.Wrap(err, "GetForumTags:SelectContext()")
+	}
+	return tags, nil
+}
+
+// incForumCounters увеличивает счётчики веток и сообщений форума.
+func (r *Repository) incForumCounters(ctx context.Context, forum string, threads, posts int) error {
+	stmt, err := r.writer(ctx, incForumCounters)
+	if err != nil {
+		return errors.Wrap(err, "incForumCounters")
+	}
+
+	_, err = stmt.ExecContext(ctx, forum, threads, posts)
+	return errors.Wrap(err, "incForumCounters:ExecContext()")
+}
+
+// addForumUsers отмечает пользователей как участников форума.
+func (r *Repository) addForumUsers(ctx context.Context, forum string, nicknames ...string) error {
+	stmt, err := r.writer(ctx, insertForumUsers)
+	if err != nil {
+		return errors.Wrap(err, "addForumUsers")
+	}
+
+	_, err = stmt.ExecContext(ctx, forum, pq.Array(nicknames))
+	return errors.Wrap(err, "addForumUsers:ExecContext()")
+}
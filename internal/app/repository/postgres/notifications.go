@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/storm5758/Forum-test/internal/app/models"
+)
+
+func (r *Repository) AddReplyNotifications(ctx context.Context, posts []models.Post) error {
+	parents := make([]int64, 0, len(posts))
+	actors := make([]string, 0, len(posts))
+	replies := make([]int64, 0, len(posts))
+	for _, post := range posts {
+		if post.Parent == 0 {
+			continue
+		}
+		parents = append(parents, post.Parent)
+		actors = append(actors, post.Author)
+		replies = append(replies, post.Id)
+	}
+	if len(replies) == 0 {
+		return nil
+	}
+
+	stmt, err := r.writer(ctx, insertReplyNotifications)
+	if err != nil {
+		return errors.Wrap(err, "AddReplyNotifications")
+	}
+
+	_, err = stmt.ExecContext(ctx, pq.Array(parents), pq.Array(actors), pq.Array(replies))
+	return errors.Wrap(err, "AddReplyNotifications:ExecContext()")
+}
+
+func (r *Repository) AddMentionNotifications(ctx context.Context, forum string, mentions []models.Mention) error {
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	nicknames := make([]string, 0, len(mentions))
+	actors := make([]string, 0, len(mentions))
+	threads := make([]int32, 0, len(mentions))
+	posts := make([]int64, 0, len(mentions))
+	for _, mention := range mentions {
+		nicknames = append(nicknames, mention.Nickname)
+		actors = append(actors, mention.Actor)
+		threads = append(threads, mention.Thread)
+		posts = append(posts, mention.Post)
+	}
+
+	stmt, err := r.writer(ctx, insertMentionNotifications)
+	if err != nil {
+		return errors.Wrap(err, "AddMentionNotifications")
+	}
+
+	_, err = stmt.ExecContext(ctx, forum, pq.Array(nicknames), pq.Array(actors), pq.Array(threads), pq.Array(posts))
+	return errors.Wrap(err, "AddMentionNotifications:ExecContext()")
+}
+
+func (r *Repository) GetNotifications(ctx context.Context, nickname string, filter models.NotificationFilter) ([]models.Notification, error) {
+	stmt, err := r.reader(ctx, selectNotifications)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetNotifications")
+	}
+
+	notifications := make([]models.Notification, 0)
+	err = stmt.SelectContext(ctx, &notifications, nickname, filter.UnreadOnly, filter.After, limitArg(filter.Limit))
+	if err != nil {
+		return nil, errors.Wrap(err, "GetNotifications:SelectContext()")
+	}
+	return notifications, nil
+}
+
+func (r *Repository) MarkNotificationsRead(ctx context.Context, nickname string, ids []int64) (int64, error) {
+	stmt, err := r.writer(ctx, markNotificationsRead)
+	if err != nil {
+		return 0, errors.Wrap(err, "MarkNotificationsRead")
+	}
+
+	res, err := stmt.ExecContext(ctx, nickname, pq.Array(ids))
+	if err != nil {
+		return 0, errors.Wrap(err, "MarkNotificationsRead:ExecContext()")
+	}
+	return res.RowsAffected()
+}
+
+func (r *Repository) CountUnreadNotifications(ctx context.Context, nickname string) (int64, error) {
+	stmt, err := r.reader(ctx, countUnreadNotifications)
+	if err != nil {
+		return 0, errors.Wrap(err, "CountUnreadNotifications")
+	}
+
+	var count int64
+	if err := stmt.GetContext(ctx, &count, nickname); err != nil {
+		return 0, errors.Wrap(err, "CountUnreadNotifications:GetContext()")
+	}
+	return count, nil
+}
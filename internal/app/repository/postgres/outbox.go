@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/pkg/database"
+)
+
+func (r *Repository) PublishEvents(ctx context.Context, limit, maxAttempts int, publish func(ctx context.Context, events []models.Event) error) (int, error) {
+	var (
+		published int
+		failed    error
+	)
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		published, failed = 0, nil
+		// события публикует один экземпляр сервера за раз, иначе одну пачку выберут и опубликуют несколько
+		locked, err := database.TryLock(ctx, database.LockKindOutbox, 0)
+		if err != nil || !locked {
+			return err
+		}
+
+		stmt, err := r.writer(ctx, selectUnpublishedEvents)
+		if err != nil {
+			return err
+		}
+		var events []models.Event
+		if err := stmt.SelectContext(ctx, &events, limit); err != nil {
+			return errors.Wrap(err, "SelectContext()")
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		err = database.WithSavepoint(ctx, func(ctx context.Context) error {
+			return publish(ctx, events)
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return err
+			}
+			// пачка публикуется заново по одному событию, чтобы найти событие с ошибкой
+			// и опубликовать события до него
+			if events, failed, err = r.publishEach(ctx, events, maxAttempts, publish); err != nil {
+				return err
+			}
+			if len(events) == 0 {
+				return nil
+			}
+		}
+
+		ids := make([]int64, 0, len(events))
+		for _, event := range events {
+			ids = append(ids, event.Id)
+		}
+		stmt, err = r.writer(ctx, markEventsPublished)
+		if err != nil {
+			return err
+		}
+		if _, err := stmt.ExecContext(ctx, pq.Array(ids)); err != nil {
+			return errors.Wrap(err, "ExecContext()")
+		}
+		published = len(events)
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "PublishEvents")
+	}
+
+	return published, errors.Wrap(failed, "PublishEvents")
+}
+
+// publishEach публикует события по одному до первой ошибки и возвращает опубликованные.
+// Для события с ошибкой учитывается неудачная попытка, failed - ошибка его публикации.
+func (r *Repository) publishEach(ctx context.Context, events []models.Event, maxAttempts int, publish func(ctx context.Context, events []models.Event) error) (published []models.Event, failed error, err error) {
+	for i, event := range events {
+		errPublish := database.WithSavepoint(ctx, func(ctx context.Context) error {
+			return publish(ctx, events[i:i+1])
+		})
+		if errPublish == nil {
+			continue
+		}
+		if ctx.Err() != nil {
+			return nil, nil, errPublish
+		}
+
+		stmt, err := r.writer(ctx, failEvent)
+		if err != nil {
+			return nil, nil, err
+		}
+		var attempts int
+		if err := stmt.GetContext(ctx, &attempts, event.Id, errPublish.Error(), maxAttempts); err != nil {
+			return nil, nil, errors.Wrap(err, "publishEach:GetContext()")
+		}
+		if attempts >= maxAttempts {
+			return events[:i], errors.Wrapf(errPublish, "event %d failed %d times and will not be published", event.Id, attempts), nil
+		}
+		return events[:i], errors.Wrapf(errPublish, "event %d failed, attempt %d of %d", event.Id, attempts, maxAttempts), nil
+	}
+	return events, nil, nil
+}
+
+func (r *Repository) PurgeEvents(ctx context.Context, before time.Time) (int64, error) {
+	stmt, err := r.writer(ctx, purgeEvents)
+	if err != nil {
+		return 0, errors.Wrap(err, "PurgeEvents")
+	}
+
+	res, err := stmt.ExecContext(ctx, before)
+	if err != nil {
+		return 0, errors.Wrap(err, "PurgeEvents:ExecContext()")
+	}
+	return res.RowsAffected()
+}
+
+// addEvent записывает событие в outbox. Должна вызываться в транзакции изменения,
+// чтобы событие появлялось только вместе с зафиксированным изменением.
+func (r *Repository) addEvent(ctx context.Context, eventType models.EventType, forum string, payload interface{}) error {
+	if _, ok := database.TxFromContext(ctx); !ok {
+		return errors.New("addEvent: no transaction in context")
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "addEvent: marshal")
+	}
+
+	stmt, err := r.writer(ctx, insertEvent)
+	if err != nil {
+		return errors.Wrap(err, "addEvent")
+	}
+	_, err = stmt.ExecContext(ctx, eventType, forum, data)
+	return errors.Wrap(err, "addEvent:ExecContext()")
+}
@@ -0,0 +1,352 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+	"github.com/storm5758/Forum-test/internal/pkg/database"
+)
+
+func (r *Repository) GetPostByID(ctx context.Context, id int64) (models.Post, error) {
+	stmt, err := r.reader(ctx, selectPostByID)
+	if err != nil {
+		return models.Post{}, errors.Wrap(err, "GetPostByID")
+	}
+
+	var post models.Post
+	err = stmt.GetContext(ctx, &post, id)
+	if err != nil {
+		return models.Post{}, errors.Wrap(convertError(err), "GetPostByID:GetContext()")
+	}
+
+	return post, nil
+}
+
+func (r *Repository) CreatePosts(ctx context.Context, thread models.Thread, posts []models.Post) ([]models.Post, error) {
+	if len(posts) == 0 {
+		return nil, nil
+	}
+	// большие пакеты вставляются через COPY, если не нужно присоединяться к внешней транзакции
+	// и прикреплять файлы
+	if _, inTx := database.TxFromContext(ctx); !inTx && len(posts) >= BulkInsertThreshold && !hasAttachments(posts) {
+		return r.createPostsBulk(ctx, thread, posts)
+	}
+
+	parents := make([]int64, 0, len(posts))
+	authors := make([]string, 0, len(posts))
+	messages := make([]string, 0, len(posts))
+	shadows := make([]bool, 0, len(posts))
+	htmls := make([]string, 0, len(posts))
+	for _, post := range posts {
+		parents = append(parents, post.Parent)
+		authors = append(authors, post.Author)
+		messages = append(messages, post.Message)
+		shadows = append(shadows, post.Shadow)
+		htmls = append(htmls, post.MessageHTML)
+	}
+
+	var created []models.Post
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		// сообщения одной ветки создаются по очереди, чтобы не повторять транзакции из-за конфликтов
+		if err := database.AcquireLock(ctx, database.LockKindThread, thread.Id); err != nil {
+			return err
+		}
+		if err := r.checkThreadStatus(ctx, thread.Id, models.ThreadOpen); err != nil {
+			return err
+		}
+		if err := r.checkParents(ctx, thread.Id, posts); err != nil {
+			return err
+		}
+
+		stmt, err := r.writer(ctx, insertPosts)
+		if err != nil {
+			return err
+		}
+		created = created[:0]
+		err = stmt.SelectContext(ctx, &created,
+			thread.Id, thread.Forum, pq.Array(parents), pq.Array(authors), pq.Array(messages), pq.Array(shadows), pq.Array(htmls))
+		if err != nil {
+			return convertError(err)
+		}
+		if err := r.attachToPosts(ctx, posts, created); err != nil {
+			return err
+		}
+
+		if err := r.incForumCounters(ctx, thread.Forum, 0, len(created)); err != nil {
+			return err
+		}
+		if err := r.addForumUsers(ctx, thread.Forum, postAuthors(created)...); err != nil {
+			return err
+		}
+		// сообщения, скрытые теневой блокировкой, публикуются отдельным событием,
+		// чтобы обработчики могли пропустить его целиком
+		visible, shadow := splitShadowPosts(created)
+		for _, batch := range [][]models.Post{visible, shadow} {
+			if len(batch) == 0 {
+				continue
+			}
+			if err := r.addEvent(ctx, models.EventPostsCreated, thread.Forum, batch); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, database.WithIsolation(sql.LevelSerializable))
+	if err != nil {
+		return nil, errors.Wrap(err, "CreatePosts")
+	}
+
+	return created, nil
+}
+
+func (r *Repository) DeletePost(ctx context.Context, id int64, moderator, reason string) (models.Post, error) {
+	moderation := models.PostModeration{Moderator: moderator, Reason: reason}
+	post, err := r.moderatePost(ctx, id, -1, models.EventPostDeleted, moderation, deletePost, id, moderator, reason)
+	return post, errors.Wrap(err, "DeletePost")
+}
+
+func (r *Repository) RestorePost(ctx context.Context, id int64, moderator string) (models.Post, error) {
+	moderation := models.PostModeration{Moderator: moderator}
+	post, err := r.moderatePost(ctx, id, 1, models.EventPostRestored, moderation, restorePost, id)
+	return post, errors.Wrap(err, "RestorePost")
+}
+
+func (r *Repository) VotePost(ctx context.Context, id int64, vote models.Vote) (models.Post, error) {
+	var voted models.Post
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		post, err := r.GetPostByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := r.checkThreadStatus(ctx, post.Thread, models.ThreadOpen, models.ThreadClosed); err != nil {
+			return err
+		}
+
+		stmt, err := r.writer(ctx, upsertPostVote)
+		if err != nil {
+			return err
+		}
+		if _, err := stmt.ExecContext(ctx, id, vote.Nickname, vote.Voice); err != nil {
+			return convertError(err)
+		}
+
+		stmt, err = r.writer(ctx, updatePostScore)
+		if err != nil {
+			return err
+		}
+		if err := stmt.GetContext(ctx, &voted, id); err != nil {
+			return convertError(err)
+		}
+		return r.addEvent(ctx, models.EventPostVoted, voted.Forum, models.PostVote{
+			Post:   voted.Id,
+			Thread: voted.Thread,
+			Vote:   vote,
+			Score:  voted.Score,
+		})
+	}, database.WithIsolation(sql.LevelSerializable))
+	if err != nil {
+		return models.Post{}, errors.Wrap(err, "VotePost")
+	}
+
+	return voted, nil
+}
+
+func (r *Repository) AddPostReaction(ctx context.Context, id int64, nickname, emoji string) (models.Post, error) {
+	post, err := r.reactPost(ctx, id, nickname, emoji, models.EventPostReactionAdded, addPostReaction)
+	return post, errors.Wrap(err, "AddPostReaction")
+}
+
+func (r *Repository) RemovePostReaction(ctx context.Context, id int64, nickname, emoji string) (models.Post, error) {
+	post, err := r.reactPost(ctx, id, nickname, emoji, models.EventPostReactionRemoved, removePostReaction)
+	return post, errors.Wrap(err, "RemovePostReaction")
+}
+
+// reactPost добавляет или удаляет реакцию запросом statement и записывает событие.
+// Если реакция уже в нужном состоянии, сообщение возвращается без изменений.
+func (r *Repository) reactPost(ctx context.Context, id int64, nickname, emoji string, eventType models.EventType, statement *database.Statement) (models.Post, error) {
+	var post models.Post
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		current, err := r.GetPostByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := r.checkThreadStatus(ctx, current.Thread, models.ThreadOpen, models.ThreadClosed); err != nil {
+			return err
+		}
+
+		stmt, err := r.writer(ctx, statement)
+		if err != nil {
+			return err
+		}
+		err = stmt.GetContext(ctx, &post, id, nickname, emoji)
+		if errors.Is(err, sql.ErrNoRows) {
+			post = current
+			return nil
+		}
+		if err != nil {
+			return convertError(err)
+		}
+
+		return r.addEvent(ctx, eventType, post.Forum, models.PostReaction{
+			Post:     post.Id,
+			Thread:   post.Thread,
+			Nickname: nickname,
+			Emoji:    emoji,
+		})
+	})
+	if err != nil {
+		return models.Post{}, err
+	}
+
+	return post, nil
+}
+
+// moderatePost меняет состояние сообщения id запросом statement с аргументами args,
+// изменяет счётчик сообщений форума на posts и записывает событие.
+func (r *Repository) moderatePost(ctx context.Context, id int64, posts int, eventType models.EventType, moderation models.PostModeration, statement *database.Statement, args ...interface{}) (models.Post, error) {
+	var post models.Post
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		stmt, err := r.writer(ctx, statement)
+		if err != nil {
+			return err
+		}
+		err = stmt.GetContext(ctx, &post, args...)
+		if errors.Is(err, sql.ErrNoRows) {
+			// сообщения нет или оно уже в нужном состоянии
+			if _, err := r.GetPostByID(ctx, id); err != nil {
+				return err
+			}
+			return repository.ErrWrongState
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := r.incForumCounters(ctx, post.Forum, 0, posts); err != nil {
+			return err
+		}
+		moderation.Post, moderation.Thread = post.Id, post.Thread
+		return r.addEvent(ctx, eventType, post.Forum, moderation)
+	})
+	if err != nil {
+		return models.Post{}, err
+	}
+
+	return post, nil
+}
+
+func (r *Repository) PurgeDeletedPosts(ctx context.Context, before time.Time) (int64, error) {
+	stmt, err := r.writer(ctx, purgeDeletedPosts)
+	if err != nil {
+		return 0, errors.Wrap(err, "PurgeDeletedPosts")
+	}
+
+	res, err := stmt.ExecContext(ctx, before)
+	if err != nil {
+		return 0, errors.Wrap(err, "PurgeDeletedPosts:ExecContext()")
+	}
+	return res.RowsAffected()
+}
+
+func (r *Repository) GetThreadPosts(ctx context.Context, thread int32, filter models.PostFilter) ([]models.Post, error) {
+	var statement *database.PagedStatement
+	switch filter.Sort {
+	case models.PostSortFlat, "":
+		statement = selectThreadPostsFlat
+	case models.PostSortTree:
+		statement = selectThreadPostsTree
+	case models.PostSortParentTree:
+		statement = selectThreadPostsParentTree
+	case models.PostSortTop:
+		statement = selectThreadPostsTop
+	default:
+		return nil, fmt.Errorf("Repository.GetThreadPosts: unknown sort %q", filter.Sort)
+	}
+
+	args := []interface{}{thread, limitArg(filter.Limit), filter.Viewer}
+	page := database.Page{Desc: filter.Desc}
+	switch {
+	case filter.After != nil && filter.Sort == models.PostSortTop:
+		args = append(args, filter.After.Score, filter.After.Id)
+		page.Since = true
+		statement = selectThreadPostsTopAfter
+	case filter.Since != 0:
+		args = append(args, filter.Since)
+		page.Since = true
+	}
+
+	stmt, err := r.reader(ctx, statement.Page(page))
+	if err != nil {
+		return nil, errors.Wrap(err, "GetThreadPosts")
+	}
+
+	posts := make([]models.Post, 0)
+	err = stmt.SelectContext(ctx, &posts, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetThreadPosts:SelectContext()")
+	}
+
+	return posts, nil
+}
+
+// checkParents проверяет, что все родительские сообщения находятся в ветке.
+func (r *Repository) checkParents(ctx context.Context, thread int32, posts []models.Post) error {
+	ids := postParents(posts)
+	if len(ids) == 0 {
+		return nil
+	}
+
+	stmt, err := r.writer(ctx, countThreadPosts)
+	if err != nil {
+		return errors.Wrap(err, "checkParents")
+	}
+
+	var found int
+	if err := stmt.GetContext(ctx, &found, thread, pq.Array(ids)); err != nil {
+		return errors.Wrap(err, "checkParents:GetContext()")
+	}
+	if found != len(ids) {
+		return repository.ErrParentNotInThread
+	}
+	return nil
+}
+
+// hasAttachments проверяет, есть ли среди сообщений сообщения с файлами.
+func hasAttachments(posts []models.Post) bool {
+	for _, post := range posts {
+		if len(post.Attachments) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// postAuthors возвращает авторов сообщений без повторов.
+func postAuthors(posts []models.Post) []string {
+	seen := make(map[string]struct{}, len(posts))
+	authors := make([]string, 0, len(posts))
+	for _, post := range posts {
+		if _, ok := seen[post.Author]; !ok {
+			seen[post.Author] = struct{}{}
+			authors = append(authors, post.Author)
+		}
+	}
+	return authors
+}
+
+// splitShadowPosts разделяет сообщения на видимые всем и скрытые теневой блокировкой.
+func splitShadowPosts(posts []models.Post) (visible, shadow []models.Post) {
+	for _, post := range posts {
+		if post.Shadow {
+			shadow = append(shadow, post)
+		} else {
+			visible = append(visible, post)
+		}
+	}
+	return visible, shadow
+}
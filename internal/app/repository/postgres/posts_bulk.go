@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+	"github.com/storm5758/Forum-test/internal/pkg/database"
+)
+
+// BulkInsertThreshold - число сообщений, начиная с которого они вставляются через COPY.
+const BulkInsertThreshold = 100
+
+var postCopyColumns = []string{"id", "parent", "thread", "forum", "author", "message", "message_html", "created", "shadow"}
+
+// createPostsBulk вставляет сообщения через COPY на нативном соединении pgx.
+// Идентификаторы выделяются заранее из последовательности, а счётчики форума
+// и список его участников обновляются одним пакетом запросов.
+func (r *Repository) createPostsBulk(ctx context.Context, thread models.Thread, posts []models.Post) ([]models.Post, error) {
+	var created []models.Post
+	err := database.WithPgxTx(ctx, r.db.Writer(ctx), func(ctx context.Context, tx pgx.Tx) error {
+		if err := database.AcquirePgxLock(ctx, tx, database.LockKindThread, thread.Id); err != nil {
+			return err
+		}
+
+		parents := postParents(posts)
+
+		batch := &pgx.Batch{}
+		batch.Queue(selectThreadStatus.Query(), thread.Id)
+		batch.Queue("SELECT COUNT(*) FROM posts WHERE thread = $1 AND id = ANY($2)", thread.Id, parents)
+		batch.Queue("SELECT nextval('posts_id_seq'), now() FROM generate_series(1, $1)", len(posts))
+		results := tx.SendBatch(ctx, batch)
+
+		var status models.ThreadStatus
+		if err := results.QueryRow().Scan(&status); err != nil {
+			results.Close()
+			return errors.Wrap(convertError(err), "thread status")
+		}
+		if status != models.ThreadOpen {
+			results.Close()
+			return repository.ErrWrongState
+		}
+
+		var found int
+		if err := results.QueryRow().Scan(&found); err != nil {
+			results.Close()
+			return errors.Wrap(err, "count parents")
+		}
+		if found != len(parents) {
+			results.Close()
+			return repository.ErrParentNotInThread
+		}
+
+		rows, err := results.Query()
+		if err != nil {
+			results.Close()
+			return errors.Wrap(err, "allocate ids")
+		}
+		created = make([]models.Post, 0, len(posts))
+		copyRows := make([][]interface{}, 0, len(posts))
+		for i := 0; rows.Next(); i++ {
+			var (
+				id  int64
+				now time.Time
+			)
+			if err := rows.Scan(&id, &now); err != nil {
+				rows.Close()
+				results.Close()
+				return errors.Wrap(err, "scan id")
+			}
+			post := posts[i]
+			post.Id, post.Thread, post.Forum = id, thread.Id, thread.Forum
+			post.Created = now.Format(time.RFC3339Nano)
+			created = append(created, post)
+			copyRows = append(copyRows, []interface{}{id, post.Parent, thread.Id, thread.Forum, post.Author, post.Message, post.MessageHTML, now, post.Shadow})
+		}
+		rows.Close()
+		if err := results.Close(); err != nil {
+			return errors.Wrap(err, "allocate ids")
+		}
+
+		if _, err := tx.CopyFrom(ctx, pgx.Identifier{"posts"}, postCopyColumns, pgx.CopyFromRows(copyRows)); err != nil {
+			return errors.Wrap(convertError(err), "tx.CopyFrom()")
+		}
+
+		batch = &pgx.Batch{}
+		batch.Queue("UPDATE forums SET posts = posts + $1 WHERE slug = $2", len(created), thread.Forum)
+		batch.Queue(`INSERT INTO users_in_forum (forum, nickname)
+			SELECT $1, unnest($2::text[]) ON CONFLICT DO NOTHING`, thread.Forum, postAuthors(created))
+		// видимые и скрытые теневой блокировкой сообщения публикуются разными событиями, как в CreatePosts
+		visible, shadow := splitShadowPosts(created)
+		for _, posts := range [][]models.Post{visible, shadow} {
+			if len(posts) == 0 {
+				continue
+			}
+			event, err := json.Marshal(posts)
+			if err != nil {
+				return errors.Wrap(err, "marshal event")
+			}
+			batch.Queue(insertEvent.Query(), string(models.EventPostsCreated), thread.Forum, event)
+		}
+		return errors.Wrap(tx.SendBatch(ctx, batch).Close(), "update forum")
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "createPostsBulk")
+	}
+
+	return created, nil
+}
+
+// postParents возвращает идентификаторы родительских сообщений без повторов.
+func postParents(posts []models.Post) []int64 {
+	seen := make(map[int64]struct{})
+	parents := make([]int64, 0)
+	for _, post := range posts {
+		if _, ok := seen[post.Parent]; !ok && post.Parent != 0 {
+			seen[post.Parent] = struct{}{}
+			parents = append(parents, post.Parent)
+		}
+	}
+	return parents
+}
@@ -0,0 +1,631 @@
+package repository
+
+import (
+	"github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/pkg/database"
+)
+
+const (
+	userColumns   = "nickname, email, full_name, about, COALESCE(avatar, '') AS avatar"
+	forumColumns  = "posts, slug, threads, title, user_nick, to_jsonb(tags) AS tags"
+	threadColumns = "id, COALESCE(slug, '') AS slug, title, message, message_html, forum, author, created, votes, status, pinned, shadow, to_jsonb(tags) AS tags"
+	// текст удалённого сообщения не выводится
+	postColumns = `author, created, forum, id, CASE WHEN deleted_at IS NULL THEN message ELSE '' END AS message,
+		CASE WHEN deleted_at IS NULL THEN message_html ELSE '' END AS message_html, thread, isedited, parent, deleted_at IS NOT NULL AS isdeleted, shadow, reactions, score,
+		CASE WHEN deleted_at IS NULL THEN post_attachments(id) END AS attachments`
+
+	// ветки и сообщения при теневой блокировке автора видны только ему, $3 - читающий пользователь
+	visibleToViewer = `(NOT shadow OR author = $3)`
+	// ветки, не удалённые модератором
+	notDeleted = `deleted_at IS NULL`
+	// ветки с меткой $4 без учёта регистра, пустая метка не отбирает ветки
+	withTag = `($4::text = '' OR tags @> ARRAY[$4::citext])`
+)
+
+// Пользователи
+var (
+	selectUsersByNicknameOrEmail = database.RegisterStatement("users.by_nickname_or_email",
+		`SELECT `+userColumns+` FROM users WHERE nickname = $1 OR email = $2`)
+
+	selectUserByNickname = database.RegisterStatement("users.by_nickname",
+		`SELECT `+userColumns+` FROM users WHERE nickname = $1`)
+
+	insertUser = database.RegisterStatement("users.insert",
+		`INSERT INTO users (nickname, email, full_name, about) VALUES ($1, $2, $3, $4)`)
+
+	updateUser = database.RegisterStatement("users.update",
+		`UPDATE users SET
+			email = COALESCE(NULLIF($2, ''), email),
+			full_name = COALESCE(NULLIF($3, ''), full_name),
+			about = COALESCE(NULLIF($4, ''), about)
+		WHERE nickname = $1
+		RETURNING `+userColumns)
+
+	// $2 - ключ аватара или NULL, чтобы удалить аватар
+	updateUserAvatar = database.RegisterStatement("users.update_avatar",
+		`UPDATE users SET avatar = $2 WHERE nickname = $1
+		RETURNING `+userColumns)
+
+	// $1 - форум, $2 - лимит, $3 - nickname, после которого выводятся пользователи
+	selectForumUsers = database.RegisterPagedStatement("users.by_forum", func(p database.Page) string {
+		query := `SELECT u.nickname, u.email, u.full_name, u.about, COALESCE(u.avatar, '') AS avatar
+			FROM users_in_forum f
+			JOIN users u ON u.nickname = f.nickname
+			WHERE f.forum = $1`
+		if p.Since {
+			query += ` AND f.nickname ` + p.After() + ` $3`
+		}
+		return query + ` ORDER BY f.nickname ` + p.Order() + ` LIMIT $2`
+	})
+
+	selectUserRoles = database.RegisterStatement("user_roles.by_nickname",
+		`SELECT nickname, role, forum FROM user_roles WHERE nickname = $1`)
+)
+
+// Форумы
+var (
+	selectForumBySlug = database.RegisterStatement("forums.by_slug",
+		`SELECT `+forumColumns+` FROM forums WHERE slug = $1`)
+
+	insertForum = database.RegisterStatement("forums.insert",
+		`INSERT INTO forums (slug, title, user_nick) VALUES ($1, $2, $3)
+		RETURNING `+forumColumns)
+
+	updateForumTags = database.RegisterStatement("forums.update_tags",
+		`UPDATE forums SET tags = $2::citext[] WHERE slug = $1
+		RETURNING `+forumColumns)
+
+	// метки без учёта регистра, в написании одной из веток, ветки теневой блокировки не учитываются.
+	// $1 - форум, $2 - лимит
+	selectForumTags = database.RegisterStatement("forums.tags",
+		`SELECT min(tag::text) AS tag, COUNT(*) AS count
+		FROM threads, unnest(tags) AS tag
+		WHERE forum = $1 AND NOT shadow AND deleted_at IS NULL
+		GROUP BY tag
+		ORDER BY count DESC, tag
+		LIMIT $2`)
+
+	incForumCounters = database.RegisterStatement("forums.inc_counters",
+		`UPDATE forums SET threads = threads + $2, posts = posts + $3 WHERE slug = $1`)
+
+	insertForumUsers = database.RegisterStatement("users_in_forum.insert",
+		`INSERT INTO users_in_forum (forum, nickname)
+		SELECT $1, unnest($2::text[])
+		ON CONFLICT DO NOTHING`)
+)
+
+// Ветки обсуждения
+var (
+	selectThreadByID = database.RegisterStatement("threads.by_id",
+		`SELECT `+threadColumns+` FROM threads WHERE id = $1 AND `+notDeleted)
+
+	selectThreadBySlug = database.RegisterStatement("threads.by_slug",
+		`SELECT `+threadColumns+` FROM threads WHERE slug = $1 AND `+notDeleted)
+
+	insertThread = database.RegisterStatement("threads.insert",
+		`INSERT INTO threads (slug, title, message, message_html, forum, author, created, shadow, tags)
+		VALUES (NULLIF($1, ''), $2, $3, $4, $5, $6, COALESCE($7::timestamptz, now()), $8, COALESCE($9::citext[], '{}'))
+		RETURNING `+threadColumns)
+
+	// $5 - новые метки или NULL, чтобы их не менять
+	updateThread = database.RegisterStatement("threads.update",
+		`UPDATE threads SET
+			title = COALESCE(NULLIF($2, ''), title),
+			message = COALESCE(NULLIF($3, ''), message),
+			message_html = CASE WHEN $3 = '' THEN message_html ELSE $4 END,
+			tags = COALESCE($5::citext[], tags)
+		WHERE id = $1
+		RETURNING `+threadColumns)
+
+	upsertVote = database.RegisterStatement("votes.upsert",
+		`INSERT INTO votes (thread, author, vote) VALUES ($1, $2, $3)
+		ON CONFLICT ON CONSTRAINT votes_thread_author_key DO UPDATE SET vote = EXCLUDED.vote`)
+
+	updateThreadVotes = database.RegisterStatement("threads.update_votes",
+		`UPDATE threads SET votes = (SELECT COALESCE(SUM(vote), 0) FROM votes WHERE thread = $1)
+		WHERE id = $1
+		RETURNING `+threadColumns)
+
+	// закреплённые ветки выводятся первыми при любом направлении сортировки
+	// $1 - форум, $2 - лимит, $3 - читающий пользователь, $4 - метка или пустая строка,
+	// $5 - дата создания, начиная с которой выводятся ветки
+	selectForumThreads = database.RegisterPagedStatement("threads.by_forum", func(p database.Page) string {
+		query := `SELECT ` + threadColumns + ` FROM threads WHERE forum = $1 AND ` + notDeleted + ` AND ` + visibleToViewer + ` AND ` + withTag
+		if p.Since {
+			query += ` AND created ` + p.From() + ` $5::timestamptz`
+		}
+		return query + ` ORDER BY pinned DESC, created ` + p.Order() + `, id ` + p.Order() + ` LIMIT $2`
+	})
+
+	// $1 - форум, $2 - лимит, $3 - читающий пользователь, $4 - метка или пустая строка,
+	// $5, $6 и $7 - дата создания, id и закреплённость ветки, после которой выводятся ветки
+	selectForumThreadsAfter = database.RegisterPagedStatement("threads.by_forum_after", func(p database.Page) string {
+		query := `SELECT ` + threadColumns + ` FROM threads WHERE forum = $1 AND ` + notDeleted + ` AND ` + visibleToViewer + ` AND ` + withTag
+		if p.Since {
+			query += ` AND (pinned < $7 OR pinned = $7 AND (created, id) ` + p.After() + ` ($5::timestamptz, $6))`
+		}
+		return query + ` ORDER BY pinned DESC, created ` + p.Order() + `, id ` + p.Order() + ` LIMIT $2`
+	})
+
+	// $1 - ветка, блокирует её до конца транзакции, чтобы состояние не изменилось.
+	// Блокировка сразу на изменение, так как голосование затем меняет строку ветки.
+	selectThreadStatus = database.RegisterStatement("threads.status",
+		`SELECT status FROM threads WHERE id = $1 AND `+notDeleted+` FOR NO KEY UPDATE`)
+
+	updateThreadStatus = database.RegisterStatement("threads.update_status",
+		`UPDATE threads SET status = $2 WHERE id = $1
+		RETURNING `+threadColumns)
+
+	updateThreadPinned = database.RegisterStatement("threads.update_pinned",
+		`UPDATE threads SET pinned = $2 WHERE id = $1
+		RETURNING `+threadColumns)
+
+	deleteThread = database.RegisterStatement("threads.delete",
+		`UPDATE threads SET deleted_at = now(), deleted_by = $2, delete_reason = $3
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING `+threadColumns)
+
+	// $1 - ветка, $2 - модератор, $3 - причина
+	deleteThreadPosts = database.RegisterStatement("posts.delete_by_thread",
+		`UPDATE posts SET deleted_at = now(), deleted_by = $2, delete_reason = $3
+		WHERE thread = $1 AND deleted_at IS NULL`)
+)
+
+// Сообщения
+var (
+	selectPostByID = database.RegisterStatement("posts.by_id",
+		`SELECT `+postColumns+` FROM posts WHERE id = $1`)
+
+	deletePost = database.RegisterStatement("posts.delete",
+		`UPDATE posts SET deleted_at = now(), deleted_by = $2, delete_reason = $3
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING `+postColumns)
+
+	// сообщения удалённой ветки не восстанавливаются
+	restorePost = database.RegisterStatement("posts.restore",
+		`UPDATE posts SET deleted_at = NULL, deleted_by = NULL, delete_reason = ''
+		WHERE id = $1 AND deleted_at IS NOT NULL
+			AND NOT EXISTS (SELECT 1 FROM threads t WHERE t.id = posts.thread AND t.deleted_at IS NOT NULL)
+		RETURNING `+postColumns)
+
+	// счётчики форумов уменьшены при удалении сообщений
+	upsertPostVote = database.RegisterStatement("post_votes.upsert",
+		`INSERT INTO post_votes (post, author, vote) VALUES ($1, $2, $3)
+		ON CONFLICT ON CONSTRAINT post_votes_post_author_key DO UPDATE SET vote = EXCLUDED.vote`)
+
+	updatePostScore = database.RegisterStatement("posts.update_score",
+		`UPDATE posts SET score = (SELECT COALESCE(SUM(vote), 0) FROM post_votes WHERE post = $1)
+		WHERE id = $1
+		RETURNING `+postColumns)
+
+	// реакция учитывается в сообщении, только если её ещё не было
+	addPostReaction = database.RegisterStatement("posts.add_reaction",
+		`WITH added AS (
+			INSERT INTO post_reactions (post, author, emoji) VALUES ($1, $2, $3)
+			ON CONFLICT DO NOTHING
+			RETURNING post, emoji
+		)
+		UPDATE posts p
+		SET reactions = jsonb_set(p.reactions, ARRAY[a.emoji], to_jsonb(COALESCE((p.reactions->>a.emoji)::integer, 0) + 1))
+		FROM added a
+		WHERE p.id = a.post
+		RETURNING `+postColumns)
+
+	// эмодзи без реакций удаляется из сообщения
+	removePostReaction = database.RegisterStatement("posts.remove_reaction",
+		`WITH removed AS (
+			DELETE FROM post_reactions WHERE post = $1 AND author = $2 AND emoji = $3
+			RETURNING post, emoji
+		)
+		UPDATE posts p
+		SET reactions = CASE
+			WHEN (p.reactions->>r.emoji)::integer > 1
+				THEN jsonb_set(p.reactions, ARRAY[r.emoji], to_jsonb((p.reactions->>r.emoji)::integer - 1))
+			ELSE p.reactions - r.emoji
+		END
+		FROM removed r
+		WHERE p.id = r.post
+		RETURNING `+postColumns)
+
+	purgeDeletedPosts = database.RegisterStatement("posts.purge",
+		`DELETE FROM posts p
+		WHERE p.deleted_at < $1
+			AND NOT EXISTS (SELECT 1 FROM posts c WHERE c.parent = p.id)`)
+
+	countThreadPosts = database.RegisterStatement("posts.count_in_thread",
+		`SELECT COUNT(*) FROM posts WHERE thread = $1 AND id = ANY($2::bigint[])`)
+
+	// now() одинаков для всех строк одного запроса
+	insertPosts = database.RegisterStatement("posts.insert",
+		`INSERT INTO posts (parent, thread, forum, author, message, message_html, created, shadow)
+		SELECT p.parent, $1, $2, p.author, p.message, p.message_html, now(), p.shadow
+		FROM unnest($3::bigint[], $4::text[], $5::text[], $6::boolean[], $7::text[])
+			WITH ORDINALITY AS p(parent, author, message, shadow, message_html, n)
+		ORDER BY p.n
+		RETURNING `+postColumns)
+
+	// $1 - ветка, $2 - лимит, $3 - читающий пользователь, $4 - id сообщения, после которого выводятся сообщения
+	selectThreadPostsFlat = database.RegisterPagedStatement("posts.flat", func(p database.Page) string {
+		query := `SELECT ` + postColumns + ` FROM posts WHERE thread = $1 AND ` + visibleToViewer
+		if p.Since {
+			query += ` AND id ` + p.After() + ` $4`
+		}
+		return query + ` ORDER BY id ` + p.Order() + ` LIMIT $2`
+	})
+
+	selectThreadPostsTree = database.RegisterPagedStatement("posts.tree", func(p database.Page) string {
+		query := `SELECT ` + postColumns + ` FROM posts WHERE thread = $1 AND ` + visibleToViewer
+		if p.Since {
+			query += ` AND path ` + p.After() + ` (SELECT path FROM posts WHERE id = $4)`
+		}
+		return query + ` ORDER BY path ` + p.Order() + ` LIMIT $2`
+	})
+
+	// лимит применяется к корневым сообщениям, ответы выводятся вместе с ними
+	selectThreadPostsParentTree = database.RegisterPagedStatement("posts.parent_tree", func(p database.Page) string {
+		roots := `SELECT id FROM posts WHERE thread = $1 AND parent = 0 AND ` + visibleToViewer
+		if p.Since {
+			roots += ` AND id ` + p.After() + ` (SELECT path[1] FROM posts WHERE id = $4)`
+		}
+		roots += ` ORDER BY id ` + p.Order() + ` LIMIT $2`
+		return `SELECT ` + postColumns + ` FROM posts WHERE path[1] IN (` + roots + `) AND ` + visibleToViewer + `
+			ORDER BY path[1] ` + p.Order() + `, path`
+	})
+
+	// как parent_tree, но корневые сообщения по убыванию рейтинга, при равном рейтинге - по id;
+	// $4 - id сообщения, после корневого сообщения которого выводятся сообщения
+	selectThreadPostsTop = database.RegisterPagedStatement("posts.top", func(p database.Page) string {
+		var since string
+		if p.Since {
+			since = ` AND (-score, id) ` + p.After() + ` (SELECT -r.score, r.id FROM posts r WHERE r.id = (SELECT path[1] FROM posts WHERE id = $4))`
+		}
+		return threadPostsTop(p, since)
+	})
+
+	// $4 и $5 - рейтинг и id корневого сообщения из ключа страницы
+	selectThreadPostsTopAfter = database.RegisterPagedStatement("posts.top_after", func(p database.Page) string {
+		var after string
+		if p.Since {
+			after = ` AND (-score, id) ` + p.After() + ` (-$4::integer, $5::bigint)`
+		}
+		return threadPostsTop(p, after)
+	})
+)
+
+// Поиск, по запросу на каждую конфигурацию
+var searchStatements = map[models.SearchLanguage]*database.Statement{
+	models.SearchRussian: registerSearchStatement(models.SearchRussian, "search_ru"),
+	models.SearchEnglish: registerSearchStatement(models.SearchEnglish, "search_en"),
+}
+
+// registerSearchStatement регистрирует поиск по колонке column с конфигурацией language.
+//
+// $1 - запрос, $2 и $3 - искать ли в ветках и в сообщениях, $4 - форум, $5 - автор,
+// $6 и $7 - границы даты создания, $8 - лимит, $9, $10 и $11 - ключ записи,
+// после которой выводятся результаты. Пустые фильтры передаются как NULL.
+func registerSearchStatement(language models.SearchLanguage, column string) *database.Statement {
+	config := `'` + string(language) + `'`
+	filters := `
+			AND ($4::citext IS NULL OR forum = $4)
+			AND ($5::text IS NULL OR author = $5)
+			AND ($6::timestamptz IS NULL OR created >= $6)
+			AND ($7::timestamptz IS NULL OR created < $7)`
+
+	return database.RegisterStatement("search:"+string(language), `
+		WITH q AS (SELECT websearch_to_tsquery(`+config+`, $1) AS q),
+		hits AS (
+			SELECT 'thread' AS kind, t.id::bigint AS id, t.id AS thread, t.forum, t.author, t.created,
+				t.title, t.message, ts_rank(t.`+column+`, q.q)::float8 AS rank
+			FROM threads t, q
+			WHERE $2 AND NOT t.shadow AND t.deleted_at IS NULL AND t.`+column+` @@ q.q`+filters+`
+			UNION ALL
+			SELECT 'post', p.id, p.thread, p.forum, p.author, p.created,
+				'', p.message, ts_rank(p.`+column+`, q.q)::float8
+			FROM posts p, q
+			WHERE $3 AND NOT p.shadow AND p.deleted_at IS NULL AND p.`+column+` @@ q.q`+filters+`
+		),
+		page AS (
+			SELECT * FROM hits
+			WHERE $9::float8 IS NULL OR rank < $9 OR (rank = $9 AND (kind, id) > ($10::text, $11::bigint))
+			ORDER BY rank DESC, kind, id
+			LIMIT $8
+		)
+		SELECT kind, id, thread, forum, author, created, title, rank,
+			ts_headline(`+config+`, `+htmlEscape("message")+`, q.q, 'StartSel=<b>, StopSel=</b>, MaxFragments=2') AS snippet
+		FROM page, q
+		ORDER BY rank DESC, kind, id`)
+}
+
+// htmlEscape возвращает выражение, экранирующее текст колонки column для вставки в HTML.
+// Найденные слова выделяются тегами уже в экранированном тексте, поэтому разметка
+// из сообщений не попадает в ответ. Сущности вроде &lt; парсер поиска не считает словами.
+func htmlEscape(column string) string {
+	return `replace(replace(replace(replace(replace(` + column + `,
+		'&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&#34;'), '''', '&#39;')`
+}
+
+// Outbox
+var (
+	insertEvent = database.RegisterStatement("outbox.insert",
+		`INSERT INTO outbox (type, forum, payload) VALUES ($1, $2, $3)`)
+
+	selectUnpublishedEvents = database.RegisterStatement("outbox.unpublished",
+		`SELECT id, type, forum, payload, created FROM outbox
+		WHERE published_at IS NULL AND failed_at IS NULL
+		ORDER BY id
+		LIMIT $1`)
+
+	markEventsPublished = database.RegisterStatement("outbox.mark_published",
+		`UPDATE outbox SET published_at = now() WHERE id = ANY($1::bigint[])`)
+
+	// после $3 неудачных попыток событие больше не публикуется и остаётся в outbox для разбора
+	failEvent = database.RegisterStatement("outbox.fail",
+		`UPDATE outbox SET attempts = attempts + 1, last_error = $2,
+			failed_at = CASE WHEN attempts + 1 >= $3 THEN now() END
+		WHERE id = $1
+		RETURNING attempts`)
+
+	// доставки удаляются вместе с событием, ожидающие доставки держат событие в outbox
+	purgeEvents = database.RegisterStatement("outbox.purge",
+		`DELETE FROM outbox o
+		WHERE o.published_at < $1
+			AND NOT EXISTS (SELECT 1 FROM webhook_deliveries d WHERE d.event = o.id AND d.status = 'pending')`)
+)
+
+// Веб-хуки
+var (
+	webhookColumns  = "id, forum, url, secret, events, created"
+	deliveryColumns = `d.id, d.webhook, d.event, o.type AS event_type, d.status, d.attempts, d.next_attempt,
+		d.response_code, d.last_error, d.created, d.delivered`
+
+	insertWebhook = database.RegisterStatement("webhooks.insert",
+		`INSERT INTO webhooks (forum, url, secret, events) VALUES ($1, $2, $3, $4)
+		RETURNING `+webhookColumns)
+
+	selectWebhook = database.RegisterStatement("webhooks.by_id",
+		`SELECT `+webhookColumns+` FROM webhooks WHERE forum = $1 AND id = $2`)
+
+	selectForumWebhooks = database.RegisterStatement("webhooks.by_forum",
+		`SELECT `+webhookColumns+` FROM webhooks WHERE forum = $1 ORDER BY id`)
+
+	deleteWebhook = database.RegisterStatement("webhooks.delete",
+		`DELETE FROM webhooks WHERE forum = $1 AND id = $2`)
+
+	// $1 - событие outbox, $2 - форум, $3 - вид события
+	insertDeliveries = database.RegisterStatement("webhook_deliveries.insert",
+		`INSERT INTO webhook_deliveries (webhook, event)
+		SELECT id, $1::bigint FROM webhooks
+		WHERE forum = $2 AND (cardinality(events) = 0 OR $3 = ANY(events))
+		ON CONFLICT DO NOTHING`)
+
+	// $1 - веб-хук, $2 - статус или пустая строка, $3 - лимит
+	selectDeliveries = database.RegisterStatement("webhook_deliveries.by_webhook",
+		`SELECT `+deliveryColumns+`
+		FROM webhook_deliveries d
+		JOIN outbox o ON o.id = d.event
+		WHERE d.webhook = $1 AND ($2 = '' OR d.status = $2)
+		ORDER BY d.id DESC
+		LIMIT $3`)
+
+	// Доставки берутся на $2 секунд: если экземпляр сервера не сообщит результат,
+	// по истечении этого времени доставку возьмёт другой.
+	claimDeliveries = database.RegisterStatement("webhook_deliveries.claim",
+		`WITH claimed AS (
+			SELECT id FROM webhook_deliveries
+			WHERE status = 'pending' AND next_attempt <= now()
+			ORDER BY next_attempt
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE webhook_deliveries d SET
+			attempts = d.attempts + 1,
+			next_attempt = now() + $2::float8 * interval '1 second'
+		FROM claimed, webhooks w, outbox o
+		WHERE d.id = claimed.id AND w.id = d.webhook AND o.id = d.event
+		RETURNING d.id, d.attempts, w.url, w.secret,
+			o.id AS "event.id", o.type AS "event.type", o.forum AS "event.forum",
+			o.payload AS "event.payload", o.created AS "event.created"`)
+
+	// $2 - доставлено, $3 - код ответа, $4 - ошибка, $5 - секунд до следующей попытки, 0 - попытки исчерпаны
+	completeDelivery = database.RegisterStatement("webhook_deliveries.complete",
+		`UPDATE webhook_deliveries SET
+			status = CASE WHEN $2::boolean THEN 'delivered' WHEN $5::float8 = 0 THEN 'dead' ELSE 'pending' END,
+			response_code = $3,
+			last_error = $4,
+			next_attempt = now() + $5::float8 * interval '1 second',
+			delivered = CASE WHEN $2 THEN now() END
+		WHERE id = $1`)
+
+	redeliverDelivery = database.RegisterStatement("webhook_deliveries.redeliver",
+		`UPDATE webhook_deliveries d SET
+			status = 'pending',
+			attempts = 0,
+			next_attempt = now(),
+			last_error = ''
+		FROM outbox o
+		WHERE d.webhook = $1 AND d.id = $2 AND d.status <> 'pending' AND o.id = d.event
+		RETURNING `+deliveryColumns)
+)
+
+// Блокировки
+var (
+	banColumns = `id, nickname, forum, shadow, reason, moderator, created, expires, lifted, lifted_by,
+		lifted IS NULL AND (expires IS NULL OR expires > now()) AS active`
+
+	insertBan = database.RegisterStatement("bans.insert",
+		`INSERT INTO bans (nickname, forum, shadow, reason, moderator, expires)
+		VALUES ($1, $2, $3, $4, $5, $6::timestamptz)
+		RETURNING `+banColumns)
+
+	selectBanByID = database.RegisterStatement("bans.by_id",
+		`SELECT `+banColumns+` FROM bans WHERE id = $1`)
+
+	// $1 - форум, $2 - пользователь или пустая строка, $3 - выводить недействующие, $4 - лимит
+	selectBans = database.RegisterStatement("bans.list",
+		`SELECT `+banColumns+` FROM bans
+		WHERE forum = $1
+			AND ($2 = '' OR nickname = $2)
+			AND ($3 OR lifted IS NULL AND (expires IS NULL OR expires > now()))
+		ORDER BY id DESC
+		LIMIT $4`)
+
+	// действующая блокировка в форуме $2 или во всех форумах, полная важнее теневой
+	selectActiveBan = database.RegisterStatement("bans.active",
+		`SELECT `+banColumns+` FROM bans
+		WHERE nickname = $1 AND forum IN ($2, '')
+			AND lifted IS NULL AND (expires IS NULL OR expires > now())
+		ORDER BY shadow, id DESC
+		LIMIT 1`)
+
+	liftBan = database.RegisterStatement("bans.lift",
+		`UPDATE bans SET lifted = now(), lifted_by = $2
+		WHERE id = $1 AND lifted IS NULL AND (expires IS NULL OR expires > now())
+		RETURNING `+banColumns)
+)
+
+// Жалобы
+var (
+	reportColumns     = "id, forum, thread, COALESCE(post, 0) AS post, reporter, reason, created, resolution"
+	resolutionColumns = "id, forum, action, moderator, comment, thread, COALESCE(post, 0) AS post, COALESCE(ban, 0) AS ban, created"
+
+	// $3 - сообщение или NULL для жалобы на ветку
+	insertReport = database.RegisterStatement("reports.insert",
+		`INSERT INTO reports (forum, thread, post, reporter, reason)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING `+reportColumns)
+
+	selectReport = database.RegisterStatement("reports.by_id",
+		`SELECT `+reportColumns+` FROM reports WHERE forum = $1 AND id = $2`)
+
+	// открытые жалобы по сообщениям и веткам, начиная с самых давних
+	// $1 - форум, $2 - лимит, $3 - первая жалоба последней записи предыдущей страницы
+	selectReportQueue = database.RegisterStatement("reports.queue",
+		`SELECT q.thread, q.post, COALESCE(p.author, t.author) AS author,
+			q.reports, q.first_report, q.created, q.updated, q.reasons
+		FROM (
+			SELECT thread, COALESCE(post, 0) AS post, COUNT(*) AS reports, MIN(id) AS first_report,
+				MIN(created) AS created, MAX(created) AS updated,
+				(array_agg(reason ORDER BY id DESC))[1:5] AS reasons
+			FROM reports
+			WHERE forum = $1 AND resolution IS NULL
+			GROUP BY thread, post
+			HAVING MIN(id) > $3
+			ORDER BY MIN(id)
+			LIMIT $2
+		) q
+		JOIN threads t ON t.id = q.thread
+		LEFT JOIN posts p ON p.id = NULLIF(q.post, 0)
+		ORDER BY q.first_report`)
+
+	// блокирует открытые жалобы на то же сообщение или ветку, что и жалоба $2,
+	// чтобы по ним не было принято два решения одновременно
+	lockReportTarget = database.RegisterStatement("reports.lock_target",
+		`SELECT `+reportColumns+` FROM reports
+		WHERE forum = $1 AND resolution IS NULL
+			AND (thread, COALESCE(post, 0)) = (SELECT thread, COALESCE(post, 0) FROM reports WHERE id = $2)
+		ORDER BY id
+		FOR UPDATE`)
+
+	// $6 - сообщение или NULL для жалоб на ветку, $7 - блокировка или NULL
+	insertReportResolution = database.RegisterStatement("reports.insert_resolution",
+		`INSERT INTO report_resolutions (forum, action, moderator, comment, thread, post, ban)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING `+resolutionColumns)
+
+	resolveReports = database.RegisterStatement("reports.resolve",
+		`UPDATE reports SET resolution = $1 WHERE id = ANY($2::integer[])`)
+)
+
+// Уведомления
+var (
+	notificationColumns = "id, nickname, kind, actor, forum, thread, COALESCE(post, 0) AS post, created, read"
+
+	// уведомления авторам родительских сообщений, $1 - родители, $2 - авторы ответов, $3 - ответы
+	insertReplyNotifications = database.RegisterStatement("notifications.insert_replies",
+		`INSERT INTO notifications (nickname, kind, actor, forum, thread, post)
+		SELECT p.author, 'reply', n.actor, p.forum, p.thread, n.post
+		FROM unnest($1::bigint[], $2::text[], $3::bigint[]) AS n(parent, actor, post)
+		JOIN posts p ON p.id = n.parent
+		WHERE p.author <> n.actor AND p.deleted_at IS NULL
+		ON CONFLICT DO NOTHING`)
+
+	// уведомления упомянутым пользователям форума $1, $2 - ники в написании автора,
+	// $3 - авторы, $4 - ветки, $5 - сообщения или 0 для сообщения ветки
+	insertMentionNotifications = database.RegisterStatement("notifications.insert_mentions",
+		`INSERT INTO notifications (nickname, kind, actor, forum, thread, post)
+		SELECT u.nickname, 'mention', n.actor, $1, n.thread, NULLIF(n.post, 0)
+		FROM unnest($2::text[], $3::text[], $4::integer[], $5::bigint[]) AS n(nickname, actor, thread, post)
+		JOIN users u ON lower(u.nickname) = lower(n.nickname)
+		WHERE u.nickname <> n.actor
+		ON CONFLICT DO NOTHING`)
+
+	// $2 - только непрочитанные, $3 - последнее уведомление предыдущей страницы или 0, $4 - лимит
+	selectNotifications = database.RegisterStatement("notifications.list",
+		`SELECT `+notificationColumns+` FROM notifications
+		WHERE nickname = $1 AND (NOT $2 OR read IS NULL) AND ($3 = 0 OR id < $3)
+		ORDER BY id DESC
+		LIMIT $4`)
+
+	// $2 - уведомления, пустой массив отмечает все уведомления пользователя
+	markNotificationsRead = database.RegisterStatement("notifications.mark_read",
+		`UPDATE notifications SET read = now()
+		WHERE nickname = $1 AND read IS NULL
+			AND (COALESCE(cardinality($2::bigint[]), 0) = 0 OR id = ANY($2::bigint[]))`)
+
+	countUnreadNotifications = database.RegisterStatement("notifications.count_unread",
+		`SELECT COUNT(*) FROM notifications WHERE nickname = $1 AND read IS NULL`)
+)
+
+// Файлы сообщений
+var (
+	attachmentColumns = "id, owner, COALESCE(post, 0) AS post, filename, content_type, size, storage_key, created"
+
+	// блокирует пользователя, чтобы файлы одного пользователя сохранялись по очереди и не превышали квоту
+	lockAttachmentOwner = database.RegisterStatement("attachments.lock_owner",
+		`SELECT nickname FROM users WHERE nickname = $1 FOR NO KEY UPDATE`)
+
+	// файл не сохраняется, если размер файлов владельца вместе с ним превысит квоту $6
+	insertAttachment = database.RegisterStatement("attachments.insert",
+		`INSERT INTO attachments (owner, filename, content_type, size, storage_key)
+		SELECT $1, $2, $3, $4, $5
+		WHERE (SELECT COALESCE(SUM(size), 0) FROM attachments WHERE owner = $1) + $4 <= $6
+		RETURNING `+attachmentColumns)
+
+	selectAttachmentByID = database.RegisterStatement("attachments.by_id",
+		`SELECT `+attachmentColumns+` FROM attachments WHERE id = $1`)
+
+	// прикрепляются только файлы автора сообщения, ещё не прикреплённые к другим сообщениям,
+	// $1 - файлы, $2 - сообщения, $3 - авторы сообщений
+	attachToPosts = database.RegisterStatement("attachments.attach",
+		`UPDATE attachments
+		SET post = a.post_id
+		FROM unnest($1::bigint[], $2::bigint[], $3::text[]) AS a(attachment_id, post_id, author)
+		WHERE id = a.attachment_id AND owner = a.author AND post IS NULL
+		RETURNING id, owner, post, filename, content_type, size, storage_key, created`)
+
+	// файлы, которые прикрепляются к сообщению в этот момент, пропускаются
+	deleteOrphanAttachments = database.RegisterStatement("attachments.delete_orphans",
+		`DELETE FROM attachments
+		WHERE id IN (
+			SELECT id FROM attachments
+			WHERE post IS NULL AND created < $1
+			ORDER BY created
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+attachmentColumns)
+)
+
+// threadPostsTop возвращает запрос сортировки top с условием after для корневых сообщений.
+// Рейтинг корня берётся из той же выборки, что и корни, поэтому ответы не отрываются от них.
+func threadPostsTop(p database.Page, after string) string {
+	return `WITH roots AS (
+			SELECT id AS root_id, -score AS root_rank FROM posts
+			WHERE thread = $1 AND parent = 0 AND ` + visibleToViewer + after + `
+			ORDER BY -score ` + p.Order() + `, id ` + p.Order() + `
+			LIMIT $2
+		)
+		SELECT ` + postColumns + ` FROM posts JOIN roots ON path[1] = root_id
+		WHERE ` + visibleToViewer + `
+		ORDER BY root_rank ` + p.Order() + `, root_id ` + p.Order() + `, path`
+}
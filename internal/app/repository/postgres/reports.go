@@ -0,0 +1,200 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+)
+
+// reportTargetRow - строка очереди модерации, причины сканируются через pq.StringArray.
+type reportTargetRow struct {
+	Thread      int32          `db:"thread"`
+	Post        int64          `db:"post"`
+	Author      string         `db:"author"`
+	Reports     int32          `db:"reports"`
+	FirstReport int32          `db:"first_report"`
+	Created     string         `db:"created"`
+	Updated     string         `db:"updated"`
+	Reasons     pq.StringArray `db:"reasons"`
+}
+
+func (t reportTargetRow) model() models.ReportTarget {
+	return models.ReportTarget{
+		Thread:      t.Thread,
+		Post:        t.Post,
+		Author:      t.Author,
+		Reports:     t.Reports,
+		FirstReport: t.FirstReport,
+		Created:     t.Created,
+		Updated:     t.Updated,
+		Reasons:     t.Reasons,
+	}
+}
+
+func (r *Repository) CreateReport(ctx context.Context, report models.Report) (models.Report, error) {
+	var created models.Report
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		stmt, err := r.writer(ctx, insertReport)
+		if err != nil {
+			return err
+		}
+		err = stmt.GetContext(ctx, &created, report.Forum, report.Thread, nullPost(report.Post), report.Reporter, report.Reason)
+		if err != nil {
+			return convertError(err)
+		}
+		return r.addEvent(ctx, models.EventReportCreated, created.Forum, created)
+	})
+	if err != nil {
+		return models.Report{}, errors.Wrap(err, "CreateReport")
+	}
+
+	return created, nil
+}
+
+func (r *Repository) GetReport(ctx context.Context, forum string, id int32) (models.Report, error) {
+	stmt, err := r.reader(ctx, selectReport)
+	if err != nil {
+		return models.Report{}, errors.Wrap(err, "GetReport")
+	}
+
+	var report models.Report
+	if err := stmt.GetContext(ctx, &report, forum, id); err != nil {
+		return models.Report{}, errors.Wrap(convertError(err), "GetReport:GetContext()")
+	}
+	return report, nil
+}
+
+func (r *Repository) GetReportQueue(ctx context.Context, forum string, filter models.ReportQueueFilter) ([]models.ReportTarget, error) {
+	stmt, err := r.reader(ctx, selectReportQueue)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetReportQueue")
+	}
+
+	var rows []reportTargetRow
+	if err := stmt.SelectContext(ctx, &rows, forum, limitArg(filter.Limit), filter.After); err != nil {
+		return nil, errors.Wrap(err, "GetReportQueue:SelectContext()")
+	}
+
+	targets := make([]models.ReportTarget, 0, len(rows))
+	for _, row := range rows {
+		targets = append(targets, row.model())
+	}
+	return targets, nil
+}
+
+func (r *Repository) ResolveReport(ctx context.Context, forum string, id int32, resolve models.ReportResolve) (models.ReportResolution, error) {
+	var resolution models.ReportResolution
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		stmt, err := r.writer(ctx, lockReportTarget)
+		if err != nil {
+			return err
+		}
+		var reports []models.Report
+		if err := stmt.SelectContext(ctx, &reports, forum, id); err != nil {
+			return err
+		}
+
+		ids := make([]int64, 0, len(reports))
+		var target *models.Report
+		for i := range reports {
+			ids = append(ids, int64(reports[i].Id))
+			if reports[i].Id == id {
+				target = &reports[i]
+			}
+		}
+		if target == nil {
+			// жалобы нет или по ней уже принято решение
+			if _, err := r.GetReport(ctx, forum, id); err != nil {
+				return err
+			}
+			return repository.ErrWrongState
+		}
+
+		var ban interface{}
+		switch resolve.Action {
+		case models.ReportDismiss:
+		case models.ReportDelete:
+			// сообщение или ветку могли удалить раньше, жалобы на них всё равно закрываются
+			var err error
+			if target.Post != 0 {
+				_, err = r.DeletePost(ctx, target.Post, resolve.Moderator, resolve.Comment)
+			} else {
+				err = r.deleteThread(ctx, target.Thread, resolve.Moderator, resolve.Comment)
+			}
+			if err != nil && !errors.Is(err, repository.ErrWrongState) {
+				return err
+			}
+		case models.ReportBan:
+			author, err := r.reportedAuthor(ctx, *target)
+			if err != nil {
+				return err
+			}
+			reason := resolve.Comment
+			if len(reason) == 0 {
+				reason = target.Reason
+			}
+			created, err := r.CreateBan(ctx, models.Ban{
+				Nickname:  author,
+				Forum:     target.Forum,
+				Shadow:    resolve.BanShadow,
+				Reason:    reason,
+				Moderator: resolve.Moderator,
+				Expires:   resolve.BanExpires,
+			})
+			if err != nil {
+				return err
+			}
+			ban = created.Id
+		default:
+			return errors.Errorf("unknown action %q", resolve.Action)
+		}
+
+		stmt, err = r.writer(ctx, insertReportResolution)
+		if err != nil {
+			return err
+		}
+		err = stmt.GetContext(ctx, &resolution, target.Forum, resolve.Action, resolve.Moderator, resolve.Comment,
+			target.Thread, nullPost(target.Post), ban)
+		if err != nil {
+			return err
+		}
+
+		stmt, err = r.writer(ctx, resolveReports)
+		if err != nil {
+			return err
+		}
+		if _, err := stmt.ExecContext(ctx, resolution.Id, pq.Array(ids)); err != nil {
+			return err
+		}
+		resolution.Reports = int32(len(ids))
+
+		return r.addEvent(ctx, models.EventReportResolved, resolution.Forum, resolution)
+	})
+	if err != nil {
+		return models.ReportResolution{}, errors.Wrap(err, "ResolveReport")
+	}
+
+	return resolution, nil
+}
+
+// reportedAuthor возвращает автора сообщения или ветки, на которые подана жалоба.
+func (r *Repository) reportedAuthor(ctx context.Context, report models.Report) (string, error) {
+	if report.Post != 0 {
+		post, err := r.GetPostByID(ctx, report.Post)
+		return post.Author, err
+	}
+	thread, err := r.GetThreadBySlugOrID(ctx, strconv.FormatInt(int64(report.Thread), 10))
+	return thread.Author, err
+}
+
+// nullPost возвращает NULL вместо 0 для жалоб на ветку.
+func nullPost(post int64) interface{} {
+	if post == 0 {
+		return nil
+	}
+	return post
+}
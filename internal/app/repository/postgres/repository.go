@@ -1,14 +1,45 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/jmoiron/sqlx"
-	"github.com/storm5758/Forum-test/internal/app/repository"
+	"github.com/storm5758/Forum-test/internal/pkg/database"
 )
 
 type Repository struct {
-	db *sqlx.DB
+	db    *database.Cluster
+	stmts *database.Statements
+}
+
+func NewRepository(db *database.Cluster) *Repository {
+	return &Repository{
+		db:    db,
+		stmts: database.NewStatements(),
+	}
+}
+
+// Close закрывает подготовленные запросы.
+func (r *Repository) Close() error {
+	return r.stmts.Close()
+}
+
+// WithTx выполняет fn в транзакции на основном сервере.
+// Методы репозитория, вызванные с контекстом fn, выполняются в этой транзакции.
+func (r *Repository) WithTx(ctx context.Context, fn func(ctx context.Context) error, opts ...database.TxOption) error {
+	return database.WithTx(ctx, r.db.Writer(ctx), fn, opts...)
+}
+
+// reader возвращает подготовленный запрос только на чтение,
+// который допускает отставание реплик. Внутри транзакции чтение выполняется в ней.
+func (r *Repository) reader(ctx context.Context, s *database.Statement) (*sqlx.Stmt, error) {
+	if _, ok := database.TxFromContext(ctx); ok {
+		return r.stmts.Stmt(ctx, r.db.Primary(), s)
+	}
+	return r.stmts.Stmt(ctx, r.db.Reader(ctx), s)
 }
 
-func NewRepository(db *sqlx.DB) repository.User {
-	return &Repository{db: db}
+// writer возвращает подготовленный запрос на основном сервере или в транзакции из контекста.
+func (r *Repository) writer(ctx context.Context, s *database.Statement) (*sqlx.Stmt, error) {
+	return r.stmts.Stmt(ctx, r.db.Writer(ctx), s)
 }
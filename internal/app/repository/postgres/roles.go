@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/storm5758/Forum-test/internal/app/models"
+)
+
+func (r *Repository) GetUserRoles(ctx context.Context, nickname string) ([]models.UserRole, error) {
+	stmt, err := r.reader(ctx, selectUserRoles)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetUserRoles")
+	}
+
+	var roles []models.UserRole
+	err = stmt.SelectContext(ctx, &roles, strings.ToLower(nickname))
+	if err != nil {
+		return nil, errors.Wrap(err, "GetUserRoles:SelectContext()")
+	}
+
+	return roles, nil
+}
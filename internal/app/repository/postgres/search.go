@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/storm5758/Forum-test/internal/app/models"
+)
+
+func (r *Repository) Search(ctx context.Context, q models.SearchQuery) ([]models.SearchHit, error) {
+	statement, ok := searchStatements[q.Language]
+	if !ok {
+		return nil, fmt.Errorf("Repository.Search: unknown language %q", q.Language)
+	}
+
+	var afterRank, afterKind, afterID interface{}
+	if q.After != nil {
+		afterRank, afterKind, afterID = q.After.Rank, string(q.After.Kind), q.After.Id
+	}
+
+	stmt, err := r.reader(ctx, statement)
+	if err != nil {
+		return nil, errors.Wrap(err, "Search")
+	}
+
+	hits := make([]models.SearchHit, 0)
+	err = stmt.SelectContext(ctx, &hits,
+		q.Query,
+		q.Threads,
+		q.Posts,
+		nullString(q.Forum),
+		nullString(strings.ToLower(q.Author)),
+		nullString(q.Since),
+		nullString(q.Until),
+		limitArg(q.Limit),
+		afterRank,
+		afterKind,
+		afterID,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "Search:SelectContext()")
+	}
+
+	return hits, nil
+}
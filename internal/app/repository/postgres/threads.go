@@ -0,0 +1,255 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+	"github.com/storm5758/Forum-test/internal/pkg/database"
+)
+
+func (r *Repository) GetThreadBySlugOrID(ctx context.Context, slugOrID string) (models.Thread, error) {
+	statement, arg := selectThreadBySlug, interface{}(slugOrID)
+	if id, err := strconv.ParseInt(slugOrID, 10, 32); err == nil {
+		statement, arg = selectThreadByID, id
+	}
+
+	stmt, err := r.reader(ctx, statement)
+	if err != nil {
+		return models.Thread{}, errors.Wrap(err, "GetThreadBySlugOrID")
+	}
+
+	var thread models.Thread
+	err = stmt.GetContext(ctx, &thread, arg)
+	if err != nil {
+		return models.Thread{}, errors.Wrap(convertError(err), "GetThreadBySlugOrID:GetContext()")
+	}
+
+	return thread, nil
+}
+
+func (r *Repository) CreateThread(ctx context.Context, thread models.Thread) (models.Thread, error) {
+	var created models.Thread
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		stmt, err := r.writer(ctx, insertThread)
+		if err != nil {
+			return err
+		}
+		err = stmt.GetContext(ctx, &created,
+			thread.Slug,
+			thread.Title,
+			thread.Message,
+			thread.MessageHTML,
+			thread.Forum,
+			thread.Author,
+			nullString(thread.Created),
+			thread.Shadow,
+			pq.Array([]string(thread.Tags)),
+		)
+		if err != nil {
+			return convertError(err)
+		}
+		if err := r.incForumCounters(ctx, created.Forum, 1, 0); err != nil {
+			return err
+		}
+		if err := r.addForumUsers(ctx, created.Forum, created.Author); err != nil {
+			return err
+		}
+		return r.addEvent(ctx, models.EventThreadCreated, created.Forum, created)
+	})
+	if err != nil {
+		return models.Thread{}, errors.Wrap(err, "CreateThread")
+	}
+
+	return created, nil
+}
+
+func (r *Repository) UpdateThread(ctx context.Context, id int32, thread models.ThreadUpdate) (models.Thread, error) {
+	var updated models.Thread
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		if err := r.checkThreadStatus(ctx, id, models.ThreadOpen, models.ThreadClosed); err != nil {
+			return err
+		}
+		stmt, err := r.writer(ctx, updateThread)
+		if err != nil {
+			return err
+		}
+		if err := stmt.GetContext(ctx, &updated, id, thread.Title, thread.Message, thread.MessageHTML, pq.Array([]string(thread.Tags))); err != nil {
+			return convertError(err)
+		}
+		return r.addEvent(ctx, models.EventThreadUpdated, updated.Forum, updated)
+	})
+	if err != nil {
+		return models.Thread{}, errors.Wrap(err, "UpdateThread")
+	}
+
+	return updated, nil
+}
+
+func (r *Repository) VoteThread(ctx context.Context, id int32, vote models.Vote) (models.Thread, error) {
+	var voted models.Thread
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		if err := r.checkThreadStatus(ctx, id, models.ThreadOpen, models.ThreadClosed); err != nil {
+			return err
+		}
+		stmt, err := r.writer(ctx, upsertVote)
+		if err != nil {
+			return err
+		}
+		if _, err := stmt.ExecContext(ctx, id, vote.Nickname, vote.Voice); err != nil {
+			return convertError(err)
+		}
+
+		stmt, err = r.writer(ctx, updateThreadVotes)
+		if err != nil {
+			return err
+		}
+		if err := stmt.GetContext(ctx, &voted, id); err != nil {
+			return convertError(err)
+		}
+		return r.addEvent(ctx, models.EventThreadVoted, voted.Forum, models.ThreadVote{
+			Thread: voted.Id,
+			Vote:   vote,
+			Votes:  voted.Votes,
+			Shadow: voted.Shadow,
+		})
+	}, database.WithIsolation(sql.LevelSerializable))
+	if err != nil {
+		return models.Thread{}, errors.Wrap(err, "VoteThread")
+	}
+
+	return voted, nil
+}
+
+func (r *Repository) SetThreadStatus(ctx context.Context, id int32, status models.ThreadStatus) (models.Thread, error) {
+	thread, err := r.moderateThread(ctx, id, updateThreadStatus, status)
+	return thread, errors.Wrap(err, "SetThreadStatus")
+}
+
+func (r *Repository) PinThread(ctx context.Context, id int32, pinned bool) (models.Thread, error) {
+	thread, err := r.moderateThread(ctx, id, updateThreadPinned, pinned)
+	return thread, errors.Wrap(err, "PinThread")
+}
+
+// moderateThread изменяет ветку запросом statement со значением value.
+func (r *Repository) moderateThread(ctx context.Context, id int32, statement *database.Statement, value interface{}) (models.Thread, error) {
+	var updated models.Thread
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		stmt, err := r.writer(ctx, statement)
+		if err != nil {
+			return err
+		}
+		if err := stmt.GetContext(ctx, &updated, id, value); err != nil {
+			return convertError(err)
+		}
+		return r.addEvent(ctx, models.EventThreadUpdated, updated.Forum, updated)
+	})
+	if err != nil {
+		return models.Thread{}, err
+	}
+
+	return updated, nil
+}
+
+// checkThreadStatus проверяет, что ветка в одном из состояний allowed,
+// и блокирует её изменение до конца транзакции.
+func (r *Repository) checkThreadStatus(ctx context.Context, id int32, allowed ...models.ThreadStatus) error {
+	stmt, err := r.writer(ctx, selectThreadStatus)
+	if err != nil {
+		return errors.Wrap(err, "checkThreadStatus")
+	}
+
+	var status models.ThreadStatus
+	if err := stmt.GetContext(ctx, &status, id); err != nil {
+		return errors.Wrap(convertError(err), "checkThreadStatus:GetContext()")
+	}
+	for _, s := range allowed {
+		if status == s {
+			return nil
+		}
+	}
+	return repository.ErrWrongState
+}
+
+func (r *Repository) GetForumThreads(ctx context.Context, forum string, filter models.ThreadFilter) ([]models.Thread, error) {
+	args := []interface{}{forum, limitArg(filter.Limit), filter.Viewer, filter.Tag}
+	page := database.Page{Desc: filter.Desc}
+	statement := selectForumThreads.Page(page)
+	switch {
+	case filter.After != nil:
+		args = append(args, filter.After.Created, filter.After.Id, filter.After.Pinned)
+		page.Since = true
+		statement = selectForumThreadsAfter.Page(page)
+	case filter.Since != "":
+		args = append(args, filter.Since)
+		page.Since = true
+		statement = selectForumThreads.Page(page)
+	}
+
+	stmt, err := r.reader(ctx, statement)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetForumThreads")
+	}
+
+	threads := make([]models.Thread, 0)
+	err = stmt.SelectContext(ctx, &threads, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetForumThreads:SelectContext()")
+	}
+
+	return threads, nil
+}
+
+func nullString(s string) interface{} {
+	if len(s) == 0 {
+		return nil
+	}
+	return s
+}
+
+// deleteThread помечает ветку и её сообщения удалёнными, moderator - пользователь, удаливший её.
+// Удалённая ветка не находится по id и slug, её сообщения окончательно удаляет PurgeDeletedPosts.
+// ErrWrongState - если ветка уже удалена.
+func (r *Repository) deleteThread(ctx context.Context, id int32, moderator, reason string) error {
+	return r.WithTx(ctx, func(ctx context.Context) error {
+		stmt, err := r.writer(ctx, deleteThread)
+		if err != nil {
+			return err
+		}
+		var thread models.Thread
+		err = stmt.GetContext(ctx, &thread, id, moderator, reason)
+		if errors.Is(err, sql.ErrNoRows) {
+			return repository.ErrWrongState
+		}
+		if err != nil {
+			return err
+		}
+
+		stmt, err = r.writer(ctx, deleteThreadPosts)
+		if err != nil {
+			return err
+		}
+		res, err := stmt.ExecContext(ctx, id, moderator, reason)
+		if err != nil {
+			return err
+		}
+		posts, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if err := r.incForumCounters(ctx, thread.Forum, -1, -int(posts)); err != nil {
+			return err
+		}
+		return r.addEvent(ctx, models.EventThreadDeleted, thread.Forum, models.ThreadModeration{
+			Thread:    id,
+			Moderator: moderator,
+			Reason:    reason,
+			Posts:     posts,
+		})
+	})
+}
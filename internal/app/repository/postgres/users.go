@@ -2,74 +2,129 @@ package repository
 
 import (
 	"context"
-	"fmt"
 	"strings"
 
-	"github.com/Masterminds/squirrel"
-	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
 	"github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/pkg/database"
 )
 
 func (r *Repository) GetUsersByNicknameOrEmail(ctx context.Context, nickname, email string) ([]models.User, error) {
-	query, args, err := squirrel.Select("nickname, email, full_name, about").
-		From("users").
-		Where(squirrel.Or{
-			squirrel.Eq{
-				"nickname": strings.ToLower(nickname),
-			},
-			squirrel.Eq{
-				"email": email,
-			},
-		}).PlaceholderFormat(squirrel.Dollar).ToSql()
+	stmt, err := r.reader(ctx, selectUsersByNicknameOrEmail)
 	if err != nil {
-		return nil, fmt.Errorf("Repository.GetUsersByNiknameOrEmail: to sql: %w", err)
+		return nil, errors.Wrap(err, "GetUsersByNicknameOrEmail")
 	}
 
 	var users []models.User
-
-	err = r.db.SelectContext(ctx, &users, query, args...)
-
+	err = stmt.SelectContext(ctx, &users, strings.ToLower(nickname), email)
 	if err != nil {
-		return nil, errors.Wrap(err, "db.SelectContext()")
+		return nil, errors.Wrap(err, "GetUsersByNicknameOrEmail:SelectContext()")
 	}
 
 	return users, nil
 }
 
 func (r *Repository) CreateUser(ctx context.Context, user models.User) (models.User, error) {
-	query, args, err := squirrel.Insert("users").
-		Columns("nickname, email, full_name, about").
-		Values(strings.ToLower(user.Nickname), user.Email, user.Fullname, user.About).
-		Suffix("RETURNING nickname").
-		PlaceholderFormat(squirrel.Dollar).
-		ToSql()
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		stmt, err := r.writer(ctx, insertUser)
+		if err != nil {
+			return err
+		}
+		_, err = stmt.ExecContext(ctx, strings.ToLower(user.Nickname), user.Email, user.Fullname, user.About)
+		if err != nil {
+			return convertError(err)
+		}
+		return r.addEvent(ctx, models.EventUserCreated, "", user)
+	})
 	if err != nil {
-		return models.User{}, fmt.Errorf("Repository.UserCreate: to sql: %w", err)
+		return models.User{}, errors.Wrap(err, "CreateUser")
 	}
+	return user, nil
+}
 
-	queryer := r.getQueryer(nil)
-	row := queryer.QueryRowxContext(ctx, query, args...)
-
-	var nickname string
-	err = row.Scan(&nickname)
+func (r *Repository) GetUserByNickname(ctx context.Context, nickname string) (models.User, error) {
+	stmt, err := r.reader(ctx, selectUserByNickname)
+	if err != nil {
+		return models.User{}, errors.Wrap(err, "GetUserByNickname")
+	}
 
+	var user models.User
+	err = stmt.GetContext(ctx, &user, strings.ToLower(nickname))
 	if err != nil {
-		return models.User{}, errors.Wrap(err, "CreateOffice:Scan()")
+		return models.User{}, errors.Wrap(convertError(err), "GetUserByNickname:GetContext()")
 	}
+
 	return user, nil
 }
 
-func (r *Repository) getQueryer(tx *sqlx.Tx) sqlx.QueryerContext {
-	if tx == nil {
-		return r.db
+func (r *Repository) UpdateUser(ctx context.Context, nickname string, user models.UserUpdate) (models.User, error) {
+	var updated models.User
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		stmt, err := r.writer(ctx, updateUser)
+		if err != nil {
+			return err
+		}
+		err = stmt.GetContext(ctx, &updated, strings.ToLower(nickname), user.Email, user.Fullname, user.About)
+		if err != nil {
+			return convertError(err)
+		}
+		return r.addEvent(ctx, models.EventUserUpdated, "", updated)
+	})
+	if err != nil {
+		return models.User{}, errors.Wrap(err, "UpdateUser")
+	}
+
+	return updated, nil
+}
+
+func (r *Repository) SetUserAvatar(ctx context.Context, nickname, avatar string) (models.User, error) {
+	var updated models.User
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		stmt, err := r.writer(ctx, updateUserAvatar)
+		if err != nil {
+			return err
+		}
+		var value interface{}
+		if len(avatar) != 0 {
+			value = avatar
+		}
+		if err := stmt.GetContext(ctx, &updated, nickname, value); err != nil {
+			return convertError(err)
+		}
+		return r.addEvent(ctx, models.EventUserUpdated, "", updated)
+	})
+	if err != nil {
+		return models.User{}, errors.Wrap(err, "SetUserAvatar")
 	}
-	return tx
+
+	return updated, nil
+}
+
+func (r *Repository) GetForumUsers(ctx context.Context, forum string, filter models.UserFilter) ([]models.User, error) {
+	args := []interface{}{forum, limitArg(filter.Limit)}
+	if filter.Since != "" {
+		args = append(args, strings.ToLower(filter.Since))
+	}
+
+	page := database.Page{Desc: filter.Desc, Since: filter.Since != ""}
+	stmt, err := r.reader(ctx, selectForumUsers.Page(page))
+	if err != nil {
+		return nil, errors.Wrap(err, "GetForumUsers")
+	}
+
+	users := make([]models.User, 0)
+	err = stmt.SelectContext(ctx, &users, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetForumUsers:SelectContext()")
+	}
+
+	return users, nil
 }
 
-func (r *Repository) getExecer(tx *sqlx.Tx) sqlx.ExecerContext {
-	if tx == nil {
-		return r.db
+// limitArg возвращает параметр LIMIT: NULL, если лимит не задан.
+func limitArg(limit int32) interface{} {
+	if limit <= 0 {
+		return nil
 	}
-	return tx
+	return limit
 }
@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+)
+
+// webhookRow - строка webhooks, массив events сканируется через pq.StringArray.
+type webhookRow struct {
+	Id      int32          `db:"id"`
+	Forum   string         `db:"forum"`
+	Url     string         `db:"url"`
+	Secret  string         `db:"secret"`
+	Events  pq.StringArray `db:"events"`
+	Created string         `db:"created"`
+}
+
+func (w webhookRow) model() models.Webhook {
+	events := make([]models.EventType, 0, len(w.Events))
+	for _, event := range w.Events {
+		events = append(events, models.EventType(event))
+	}
+	return models.Webhook{
+		Id:      w.Id,
+		Forum:   w.Forum,
+		Url:     w.Url,
+		Secret:  w.Secret,
+		Events:  events,
+		Created: w.Created,
+	}
+}
+
+func (r *Repository) CreateWebhook(ctx context.Context, webhook models.Webhook) (models.Webhook, error) {
+	stmt, err := r.writer(ctx, insertWebhook)
+	if err != nil {
+		return models.Webhook{}, errors.Wrap(err, "CreateWebhook")
+	}
+
+	events := make([]string, 0, len(webhook.Events))
+	for _, event := range webhook.Events {
+		events = append(events, string(event))
+	}
+
+	var row webhookRow
+	err = stmt.GetContext(ctx, &row, webhook.Forum, webhook.Url, webhook.Secret, pq.Array(events))
+	if err != nil {
+		return models.Webhook{}, errors.Wrap(convertError(err), "CreateWebhook:GetContext()")
+	}
+
+	return row.model(), nil
+}
+
+func (r *Repository) GetWebhook(ctx context.Context, forum string, id int32) (models.Webhook, error) {
+	stmt, err := r.reader(ctx, selectWebhook)
+	if err != nil {
+		return models.Webhook{}, errors.Wrap(err, "GetWebhook")
+	}
+
+	var row webhookRow
+	if err := stmt.GetContext(ctx, &row, forum, id); err != nil {
+		return models.Webhook{}, errors.Wrap(convertError(err), "GetWebhook:GetContext()")
+	}
+
+	return row.model(), nil
+}
+
+func (r *Repository) GetForumWebhooks(ctx context.Context, forum string) ([]models.Webhook, error) {
+	stmt, err := r.reader(ctx, selectForumWebhooks)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetForumWebhooks")
+	}
+
+	var rows []webhookRow
+	if err := stmt.SelectContext(ctx, &rows, forum); err != nil {
+		return nil, errors.Wrap(err, "GetForumWebhooks:SelectContext()")
+	}
+
+	webhooks := make([]models.Webhook, 0, len(rows))
+	for _, row := range rows {
+		webhooks = append(webhooks, row.model())
+	}
+	return webhooks, nil
+}
+
+func (r *Repository) DeleteWebhook(ctx context.Context, forum string, id int32) error {
+	stmt, err := r.writer(ctx, deleteWebhook)
+	if err != nil {
+		return errors.Wrap(err, "DeleteWebhook")
+	}
+
+	res, err := stmt.ExecContext(ctx, forum, id)
+	if err != nil {
+		return errors.Wrap(err, "DeleteWebhook:ExecContext()")
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return errors.Wrap(repository.ErrNotFound, "DeleteWebhook")
+	}
+	return nil
+}
+
+func (r *Repository) AddWebhookDeliveries(ctx context.Context, event models.Event) error {
+	stmt, err := r.writer(ctx, insertDeliveries)
+	if err != nil {
+		return errors.Wrap(err, "AddWebhookDeliveries")
+	}
+
+	_, err = stmt.ExecContext(ctx, event.Id, event.Forum, event.Type)
+	return errors.Wrap(err, "AddWebhookDeliveries:ExecContext()")
+}
+
+func (r *Repository) GetWebhookDeliveries(ctx context.Context, webhook int32, f models.DeliveryFilter) ([]models.WebhookDelivery, error) {
+	stmt, err := r.reader(ctx, selectDeliveries)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetWebhookDeliveries")
+	}
+
+	var deliveries []models.WebhookDelivery
+	if err := stmt.SelectContext(ctx, &deliveries, webhook, f.Status, f.Limit); err != nil {
+		return nil, errors.Wrap(err, "GetWebhookDeliveries:SelectContext()")
+	}
+	return deliveries, nil
+}
+
+func (r *Repository) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]models.PendingDelivery, error) {
+	stmt, err := r.writer(ctx, claimDeliveries)
+	if err != nil {
+		return nil, errors.Wrap(err, "ClaimWebhookDeliveries")
+	}
+
+	var deliveries []models.PendingDelivery
+	if err := stmt.SelectContext(ctx, &deliveries, limit, lease.Seconds()); err != nil {
+		return nil, errors.Wrap(err, "ClaimWebhookDeliveries:SelectContext()")
+	}
+	return deliveries, nil
+}
+
+func (r *Repository) CompleteWebhookDelivery(ctx context.Context, id int64, result models.DeliveryResult) error {
+	stmt, err := r.writer(ctx, completeDelivery)
+	if err != nil {
+		return errors.Wrap(err, "CompleteWebhookDelivery")
+	}
+
+	_, err = stmt.ExecContext(ctx, id, result.Delivered, result.ResponseCode, result.Error, result.RetryIn.Seconds())
+	return errors.Wrap(err, "CompleteWebhookDelivery:ExecContext()")
+}
+
+func (r *Repository) RedeliverWebhookDelivery(ctx context.Context, webhook int32, id int64) (models.WebhookDelivery, error) {
+	stmt, err := r.writer(ctx, redeliverDelivery)
+	if err != nil {
+		return models.WebhookDelivery{}, errors.Wrap(err, "RedeliverWebhookDelivery")
+	}
+
+	var delivery models.WebhookDelivery
+	if err := stmt.GetContext(ctx, &delivery, webhook, id); err != nil {
+		return models.WebhookDelivery{}, errors.Wrap(convertError(err), "RedeliverWebhookDelivery:GetContext()")
+	}
+	return delivery, nil
+}
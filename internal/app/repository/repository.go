@@ -3,11 +3,168 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/storm5758/Forum-test/internal/app/models"
 )
 
+var (
+	// ErrNotFound возвращается, когда запрошенная запись отсутствует в базе.
+	ErrNotFound = errors.New("not found")
+	// ErrAlreadyExists возвращается при нарушении уникальности записи.
+	ErrAlreadyExists = errors.New("already exists")
+	// ErrParentNotInThread возвращается, если родительское сообщение отсутствует в ветке.
+	ErrParentNotInThread = errors.New("parent post is not in thread")
+	// ErrWrongState возвращается, если запись не в том состоянии, которого требует изменение,
+	// например при удалении уже удалённого сообщения.
+	ErrWrongState = errors.New("wrong state")
+	// ErrQuotaExceeded возвращается, если файлы пользователя превысят его квоту.
+	ErrQuotaExceeded = errors.New("quota exceeded")
+	// ErrInvalidAttachment возвращается, если прикрепляемый к сообщению файл не найден,
+	// загружен не автором сообщения или уже прикреплён к другому сообщению.
+	ErrInvalidAttachment = errors.New("invalid attachment")
+)
+
 type User interface {
 	GetUsersByNicknameOrEmail(ctx context.Context, nickname, email string) ([]models.User, error)
+	GetUserByNickname(ctx context.Context, nickname string) (models.User, error)
 	CreateUser(ctx context.Context, u models.User) (models.User, error)
+	UpdateUser(ctx context.Context, nickname string, u models.UserUpdate) (models.User, error)
+	// SetUserAvatar сохраняет ключ аватара пользователя, пустой avatar удаляет аватар.
+	SetUserAvatar(ctx context.Context, nickname, avatar string) (models.User, error)
+}
+
+type Forum interface {
+	GetForumBySlug(ctx context.Context, slug string) (models.Forum, error)
+	CreateForum(ctx context.Context, f models.NewForum) (models.Forum, error)
+	// GetForumThreads возвращает ветки форума, отсортированные по дате создания.
+	GetForumThreads(ctx context.Context, forum string, f models.ThreadFilter) ([]models.Thread, error)
+	// GetForumUsers возвращает участников форума, отсортированных по nickname.
+	GetForumUsers(ctx context.Context, forum string, f models.UserFilter) ([]models.User, error)
+	// SetForumTags заменяет метки, из которых выбираются метки веток форума.
+	// Пустой tags разрешает произвольные метки.
+	SetForumTags(ctx context.Context, slug string, tags models.Tags) (models.Forum, error)
+	// GetForumTags возвращает метки веток форума, начиная с самых частых, при limit 0 - все метки.
+	GetForumTags(ctx context.Context, slug string, limit int32) ([]models.TagCount, error)
+}
+
+type Thread interface {
+	// GetThreadBySlugOrID ищет ветку по id, если slugOrID - число, иначе по slug.
+	GetThreadBySlugOrID(ctx context.Context, slugOrID string) (models.Thread, error)
+	CreateThread(ctx context.Context, t models.Thread) (models.Thread, error)
+	UpdateThread(ctx context.Context, id int32, u models.ThreadUpdate) (models.Thread, error)
+	VoteThread(ctx context.Context, id int32, v models.Vote) (models.Thread, error)
+	SetThreadStatus(ctx context.Context, id int32, status models.ThreadStatus) (models.Thread, error)
+	PinThread(ctx context.Context, id int32, pinned bool) (models.Thread, error)
+	// GetThreadPosts возвращает сообщения ветки в порядке, заданном f.Sort.
+	GetThreadPosts(ctx context.Context, thread int32, f models.PostFilter) ([]models.Post, error)
+}
+
+type Post interface {
+	GetPostByID(ctx context.Context, id int64) (models.Post, error)
+	// CreatePosts создаёт сообщения в ветке с одинаковой датой создания
+	// и прикрепляет к ним файлы из Attachments. ErrInvalidAttachment - если файл нельзя прикрепить.
+	CreatePosts(ctx context.Context, thread models.Thread, posts []models.Post) ([]models.Post, error)
+	// DeletePost помечает сообщение удалённым, moderator - пользователь, удаливший его.
+	DeletePost(ctx context.Context, id int64, moderator, reason string) (models.Post, error)
+	RestorePost(ctx context.Context, id int64, moderator string) (models.Post, error)
+	// PurgeDeletedPosts окончательно удаляет сообщения, удалённые раньше before, на которые нет ответов.
+	// Ветви из удалённых сообщений удаляются за несколько вызовов, начиная с последних ответов.
+	PurgeDeletedPosts(ctx context.Context, before time.Time) (int64, error)
+	// VotePost учитывает голос пользователя за сообщение, повторный голос заменяет предыдущий.
+	// ErrWrongState - если ветка сообщения в архиве.
+	VotePost(ctx context.Context, id int64, v models.Vote) (models.Post, error)
+	// AddPostReaction добавляет реакцию пользователя на сообщение, повторная реакция не учитывается.
+	// ErrWrongState - если ветка сообщения в архиве.
+	AddPostReaction(ctx context.Context, id int64, nickname, emoji string) (models.Post, error)
+	// RemovePostReaction удаляет реакцию пользователя на сообщение.
+	// ErrWrongState - если ветка сообщения в архиве.
+	RemovePostReaction(ctx context.Context, id int64, nickname, emoji string) (models.Post, error)
+}
+
+type Search interface {
+	// Search ищет ветки и сообщения в порядке убывания релевантности.
+	Search(ctx context.Context, q models.SearchQuery) ([]models.SearchHit, error)
+}
+
+type Outbox interface {
+	// PublishEvents передаёт publish до limit неопубликованных событий в порядке id
+	// и отмечает их опубликованными, если publish завершился без ошибки.
+	// publish вызывается в транзакции, ctx которой можно передавать в методы репозитория.
+	// При ошибке события передаются publish по одному: события до первого неудачного публикуются,
+	// а неудачному засчитывается попытка. После maxAttempts попыток событие больше не публикуется.
+	// Ошибка неудачного события возвращается вместе с числом опубликованных.
+	PublishEvents(ctx context.Context, limit, maxAttempts int, publish func(ctx context.Context, events []models.Event) error) (int, error)
+	// PurgeEvents удаляет события, опубликованные раньше before, вместе с их доставками на веб-хуки.
+	// События с ожидающими доставками не удаляются.
+	PurgeEvents(ctx context.Context, before time.Time) (int64, error)
+}
+
+type Webhook interface {
+	CreateWebhook(ctx context.Context, w models.Webhook) (models.Webhook, error)
+	GetWebhook(ctx context.Context, forum string, id int32) (models.Webhook, error)
+	GetForumWebhooks(ctx context.Context, forum string) ([]models.Webhook, error)
+	DeleteWebhook(ctx context.Context, forum string, id int32) error
+	// AddWebhookDeliveries ставит событие в очередь доставки на подписанные на него веб-хуки его форума.
+	AddWebhookDeliveries(ctx context.Context, event models.Event) error
+	// GetWebhookDeliveries возвращает доставки веб-хука, начиная с последних.
+	GetWebhookDeliveries(ctx context.Context, webhook int32, f models.DeliveryFilter) ([]models.WebhookDelivery, error)
+	// ClaimWebhookDeliveries берёт на отправку до limit доставок, время следующей попытки которых наступило.
+	// Взятые доставки не выдаются повторно в течение lease.
+	ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]models.PendingDelivery, error)
+	CompleteWebhookDelivery(ctx context.Context, id int64, result models.DeliveryResult) error
+	// RedeliverWebhookDelivery снова ставит в очередь завершённую доставку.
+	RedeliverWebhookDelivery(ctx context.Context, webhook int32, id int64) (models.WebhookDelivery, error)
+}
+
+type Ban interface {
+	CreateBan(ctx context.Context, b models.Ban) (models.Ban, error)
+	GetBan(ctx context.Context, id int32) (models.Ban, error)
+	GetBans(ctx context.Context, f models.BanFilter) ([]models.Ban, error)
+	// GetActiveBan возвращает действующую блокировку пользователя в форуме или во всех форумах.
+	// Полная блокировка возвращается раньше теневой, ErrNotFound - если блокировок нет.
+	GetActiveBan(ctx context.Context, nickname, forum string) (models.Ban, error)
+	// LiftBan снимает действующую блокировку, moderator - пользователь, снявший её.
+	LiftBan(ctx context.Context, id int32, moderator string) (models.Ban, error)
+}
+
+type Report interface {
+	// CreateReport создаёт жалобу. ErrAlreadyExists - если у пользователя уже есть
+	// открытая жалоба на то же сообщение или ветку.
+	CreateReport(ctx context.Context, r models.Report) (models.Report, error)
+	GetReport(ctx context.Context, forum string, id int32) (models.Report, error)
+	// GetReportQueue возвращает открытые жалобы форума, сгруппированные по сообщениям и веткам.
+	GetReportQueue(ctx context.Context, forum string, f models.ReportQueueFilter) ([]models.ReportTarget, error)
+	// ResolveReport выполняет решение по жалобе id и закрывает её вместе с остальными открытыми
+	// жалобами на то же сообщение или ветку. ErrWrongState - если по жалобе уже принято решение.
+	ResolveReport(ctx context.Context, forum string, id int32, r models.ReportResolve) (models.ReportResolution, error)
+}
+
+type Notification interface {
+	// AddReplyNotifications уведомляет авторов родительских сообщений об ответах posts.
+	// Ответы на свои и удалённые сообщения пропускаются.
+	AddReplyNotifications(ctx context.Context, posts []models.Post) error
+	// AddMentionNotifications уведомляет упомянутых пользователей. Ники ищутся без учёта регистра,
+	// упоминания несуществующих пользователей и самого себя пропускаются.
+	AddMentionNotifications(ctx context.Context, forum string, mentions []models.Mention) error
+	GetNotifications(ctx context.Context, nickname string, f models.NotificationFilter) ([]models.Notification, error)
+	// MarkNotificationsRead отмечает прочитанными уведомления ids, при пустом ids - все уведомления пользователя.
+	// Возвращает кол-во отмеченных уведомлений.
+	MarkNotificationsRead(ctx context.Context, nickname string, ids []int64) (int64, error)
+	CountUnreadNotifications(ctx context.Context, nickname string) (int64, error)
+}
+
+type Attachment interface {
+	// CreateAttachment сохраняет сведения о загруженном файле.
+	// ErrQuotaExceeded - если размер файлов владельца вместе с новым превысит quota байт.
+	CreateAttachment(ctx context.Context, a models.Attachment, quota int64) (models.Attachment, error)
+	GetAttachment(ctx context.Context, id int64) (models.Attachment, error)
+	// DeleteOrphanAttachments удаляет до limit файлов, не прикреплённых к сообщениям
+	// и загруженных раньше before, и возвращает их, чтобы удалить содержимое из хранилища.
+	DeleteOrphanAttachments(ctx context.Context, before time.Time, limit int) ([]models.Attachment, error)
+}
+
+type Role interface {
+	GetUserRoles(ctx context.Context, nickname string) ([]models.UserRole, error)
 }
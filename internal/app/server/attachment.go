@@ -0,0 +1,123 @@
+package server
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/storm5758/Forum-test/internal/app/attachment"
+	"github.com/storm5758/Forum-test/pkg/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// AttachmentUploadPath - загрузка файла формой multipart/form-data, см. AttachmentUpload
+	AttachmentUploadPath = attachment.Path
+	// AttachmentDownloadPath - скачивание файла с его типом содержимого, см. AttachmentDownload
+	AttachmentDownloadPath = attachment.Path + "/{id}"
+	// AttachmentFormField - поле формы с файлом
+	AttachmentFormField = "file"
+)
+
+// attachmentUploadHandler принимает файл из формы и передаёт его в AttachmentUpload.
+// Запрос выполняется через gRPC соединение шлюза, поэтому проходит те же перехватчики.
+func attachmentUploadHandler(mux *runtime.ServeMux, client api.AttachmentClient) runtime.HandlerFunc {
+	method := "/" + api.Attachment_ServiceDesc.ServiceName + "/AttachmentUpload"
+
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r, method)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		req, err := readAttachment(w, r)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		created, err := client.AttachmentUpload(ctx, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		runtime.ForwardResponseMessage(ctx, mux, outbound, w, r, created)
+	}
+}
+
+// readAttachment читает файл и его имя из поля AttachmentFormField формы.
+func readAttachment(w http.ResponseWriter, r *http.Request) (*api.AttachmentUploadRequest, error) {
+	filename, data, err := readFormFile(w, r, AttachmentFormField, attachment.MaxFileSize, attachment.ErrTooLarge)
+	if err != nil {
+		return nil, err
+	}
+	return &api.AttachmentUploadRequest{Filename: filename, Data: data}, nil
+}
+
+// attachmentDownloadHandler транслирует поток AttachmentDownload в ответ с содержимым файла.
+// Содержимое не буферизуется целиком: части пишутся в ответ по мере получения.
+func attachmentDownloadHandler(mux *runtime.ServeMux, client api.AttachmentClient) runtime.HandlerFunc {
+	method := "/" + api.Attachment_ServiceDesc.ServiceName + "/AttachmentDownload"
+
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r, method)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		id, err := strconv.ParseInt(params["id"], 10, 64)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, status.Error(codes.InvalidArgument, "invalid attachment id"))
+			return
+		}
+
+		stream, err := client.AttachmentDownload(ctx, &api.AttachmentDownloadRequest{Id: id})
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		// первое сообщение - сведения о файле, ошибки доступа приходят вместо него
+		first, err := stream.Recv()
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		info := first.GetAttachment()
+		if info == nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, status.Error(codes.Internal, "no attachment info in stream"))
+			return
+		}
+
+		w.Header().Set("Content-Type", info.GetContentType())
+		w.Header().Set("Content-Length", strconv.FormatInt(info.GetSize(), 10))
+		w.Header().Set("Content-Disposition", attachment.Disposition(info.GetFilename(), info.GetContentType()))
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		// файл открывается в браузере без скриптов и доступа к сайту
+		w.Header().Set("Content-Security-Policy", "sandbox; default-src 'none'; img-src 'self'; style-src 'unsafe-inline'")
+		w.Header().Set("Cache-Control", "private, max-age=3600")
+		w.WriteHeader(http.StatusOK)
+
+		for {
+			chunk, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				// заголовки уже отправлены, клиент увидит недописанный ответ по Content-Length
+				log.Println("attachment:", err)
+				return
+			}
+			if _, err := w.Write(chunk.GetData()); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/storm5758/Forum-test/internal/app/avatar"
+	"github.com/storm5758/Forum-test/internal/pkg/storage"
+	"github.com/storm5758/Forum-test/pkg/api"
+)
+
+const (
+	// AvatarUploadPath - загрузка аватара формой multipart/form-data, см. UserAvatarUpload
+	AvatarUploadPath = "/api/user/{nickname}/avatar"
+	// AvatarFormField - поле формы с файлом аватара
+	AvatarFormField = "avatar"
+)
+
+// avatarUploadHandler принимает файл аватара из формы и передаёт его в UserAvatarUpload.
+// Запрос выполняется через gRPC соединение шлюза, поэтому проходит те же перехватчики.
+func avatarUploadHandler(mux *runtime.ServeMux, client api.UserClient) runtime.HandlerFunc {
+	method := "/" + api.User_ServiceDesc.ServiceName + "/UserAvatarUpload"
+
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r, method)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		image, err := readAvatar(w, r)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		user, err := client.UserAvatarUpload(ctx, &api.UserAvatarUploadRequest{
+			Nickname: params["nickname"],
+			Image:    image,
+		})
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		runtime.ForwardResponseMessage(ctx, mux, outbound, w, r, user)
+	}
+}
+
+// readAvatar читает файл из поля AvatarFormField формы.
+func readAvatar(w http.ResponseWriter, r *http.Request) ([]byte, error) {
+	_, image, err := readFormFile(w, r, AvatarFormField, avatar.MaxFileSize, avatar.ErrTooLarge)
+	return image, err
+}
+
+// avatarFileHandler раздаёт файлы аватаров из хранилища.
+// Ключ файла меняется вместе с аватаром, поэтому файлы кэшируются без ограничения срока.
+func avatarFileHandler(avatars storage.Storage) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		key := strings.TrimPrefix(r.URL.Path, avatar.Path)
+		if !strings.HasSuffix(key, ".png") {
+			http.NotFound(w, r)
+			return
+		}
+
+		file, err := avatars.Get(r.Context(), key)
+		if errors.Is(err, storage.ErrNotFound) || errors.Is(err, storage.ErrInvalidKey) {
+			http.NotFound(w, r)
+			return
+		}
+		if err != nil {
+			log.Println(err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		defer file.Close()
+
+		w.Header().Set("Content-Type", "image/png")
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		if r.Method == http.MethodHead {
+			return
+		}
+		if _, err := io.Copy(w, file); err != nil {
+			log.Println("avatar:", err)
+		}
+	})
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"log"
 	"net"
 	"net/http"
@@ -13,44 +14,95 @@ import (
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/storm5758/Forum-test/internal/app/auth"
+	"github.com/storm5758/Forum-test/internal/app/avatar"
+	"github.com/storm5758/Forum-test/internal/pkg/storage"
+	"github.com/storm5758/Forum-test/internal/pkg/tlsutil"
 	"github.com/storm5758/Forum-test/pkg/api"
 	gw_api "github.com/storm5758/Forum-test/pkg/gw/api"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
 	ServerAdressGRPC = ":6000"
 	ServerAdressHTTP = ":5000"
-	SwaggerDir       = "./swagger"
+	// Адрес, по которому HTTP шлюз обращается к gRPC серверу
+	GatewayTargetGRPC = "localhost" + ServerAdressGRPC
+	SwaggerDir        = "./swagger"
 
 	GRPCTimeoutConnection = 5 * time.Second
+	// Размер запроса gRPC, включает загружаемые файлы, см. attachment.MaxFileSize
+	GRPCMaxRecvMsgSize = 16 << 20
 )
 
 type Services struct {
-	User   api.UserServer
-	Forum  api.ForumServer
-	Thread api.ThreadServer
-	Post   api.PostServer
-	Admin  api.AdminServer
+	User         api.UserServer
+	Forum        api.ForumServer
+	Thread       api.ThreadServer
+	Post         api.PostServer
+	Admin        api.AdminServer
+	Search       api.SearchServer
+	Webhook      api.WebhookServer
+	Ban          api.BanServer
+	Report       api.ReportServer
+	Notification api.NotificationServer
+	Attachment   api.AttachmentServer
 }
 
 type closer func() error
 
+// Option - дополнительная настройка сервера.
+type Option func(*options)
+
+type options struct {
+	unaryInterceptors  []grpc.UnaryServerInterceptor
+	streamInterceptors []grpc.StreamServerInterceptor
+	tls                tlsutil.Config
+	gatewayTLS         tlsutil.Config
+	avatars            storage.Storage
+	sessionKey         []byte
+}
+
+// WithAuthorizer включает проверку прав доступа к методам API.
+func WithAuthorizer(a *auth.Authorizer) Option {
+	return func(o *options) {
+		o.unaryInterceptors = append(o.unaryInterceptors, a.UnaryServerInterceptor())
+		o.streamInterceptors = append(o.streamInterceptors, a.StreamServerInterceptor())
+	}
+}
+
+// WithTLS включает TLS для gRPC и HTTP серверов.
+// Проверка сертификата клиента (server.ClientAuth) применяется только к gRPC,
+// gateway - настройки, с которыми HTTP шлюз подключается к gRPC серверу.
+func WithTLS(server, gateway tlsutil.Config) Option {
+	return func(o *options) {
+		o.tls = server
+		o.gatewayTLS = gateway
+	}
+}
+
 type server struct {
 	Services
+	options
 	lis        net.Listener
 	grpcServer *grpc.Server
 	group      errgroup.Group
 	closers    []closer
 }
 
-func New(s Services) (*server, error) {
+func New(s Services, opts ...Option) (*server, error) {
 	srv := &server{
 		Services: s,
 	}
 
+	for _, opt := range opts {
+		opt(&srv.options)
+	}
+
 	// Create a listener on TCP port
 	lis, err := net.Listen("tcp", ServerAdressGRPC)
 	if err != nil {
@@ -59,16 +111,39 @@ func New(s Services) (*server, error) {
 	srv.closer(lis.Close)
 	srv.lis = lis
 
-	// Create a gRPC server object
-	srv.grpcServer = grpc.NewServer(
+	sessions, err := newSessionMarks(srv.sessionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	serverOpts := []grpc.ServerOption{
 		grpc.ConnectionTimeout(GRPCTimeoutConnection),
+		grpc.MaxRecvMsgSize(GRPCMaxRecvMsgSize),
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
-			grpc_recovery.StreamServerInterceptor(),
+			append([]grpc.StreamServerInterceptor{
+				grpc_recovery.StreamServerInterceptor(),
+				sessions.streamServerInterceptor(),
+			}, srv.streamInterceptors...)...,
 		)),
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
-			grpc_recovery.UnaryServerInterceptor(),
+			append([]grpc.UnaryServerInterceptor{
+				grpc_recovery.UnaryServerInterceptor(),
+				sessions.unaryServerInterceptor(),
+			}, srv.unaryInterceptors...)...,
 		)),
-	)
+	}
+
+	if srv.tls.Enabled() {
+		tlsConfig, reloader, err := tlsutil.ServerConfig(srv.tls)
+		if err != nil {
+			return nil, err
+		}
+		srv.closer(reloader.Close)
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	// Create a gRPC server object
+	srv.grpcServer = grpc.NewServer(serverOpts...)
 
 	srv.closer(func() error {
 		srv.grpcServer.Stop()
@@ -87,25 +162,97 @@ func (s *server) registerServices() {
 	api.RegisterForumServer(s.grpcServer, s.Forum)
 	api.RegisterThreadServer(s.grpcServer, s.Thread)
 	api.RegisterPostServer(s.grpcServer, s.Post)
+	api.RegisterSearchServer(s.grpcServer, s.Search)
+	api.RegisterWebhookServer(s.grpcServer, s.Webhook)
+	api.RegisterBanServer(s.grpcServer, s.Ban)
+	api.RegisterReportServer(s.grpcServer, s.Report)
+	api.RegisterNotificationServer(s.grpcServer, s.Notification)
+	api.RegisterAttachmentServer(s.grpcServer, s.Attachment)
 }
 
-func (s *server) registerGatewayServices(ctx context.Context, mux *runtime.ServeMux) error {
-	if err := gw_api.RegisterAdminHandlerServer(ctx, mux, s.Admin); err != nil {
+// registerGatewayServices регистрирует HTTP шлюз поверх gRPC соединения,
+// чтобы запросы через шлюз проходили те же перехватчики, что и запросы gRPC.
+func (s *server) registerGatewayServices(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	if err := gw_api.RegisterAdminHandler(ctx, mux, conn); err != nil {
+		return err
+	}
+	if err := gw_api.RegisterUserHandler(ctx, mux, conn); err != nil {
+		return err
+	}
+	if err := gw_api.RegisterForumHandler(ctx, mux, conn); err != nil {
+		return err
+	}
+	if err := gw_api.RegisterThreadHandler(ctx, mux, conn); err != nil {
+		return err
+	}
+	if err := gw_api.RegisterPostHandler(ctx, mux, conn); err != nil {
+		return err
+	}
+	if err := gw_api.RegisterSearchHandler(ctx, mux, conn); err != nil {
+		return err
+	}
+	if err := gw_api.RegisterWebhookHandler(ctx, mux, conn); err != nil {
+		return err
+	}
+	if err := gw_api.RegisterBanHandler(ctx, mux, conn); err != nil {
+		return err
+	}
+	if err := gw_api.RegisterReportHandler(ctx, mux, conn); err != nil {
+		return err
+	}
+	if err := gw_api.RegisterNotificationHandler(ctx, mux, conn); err != nil {
 		return err
 	}
-	if err := gw_api.RegisterUserHandlerServer(ctx, mux, s.User); err != nil {
+	if err := gw_api.RegisterAttachmentHandler(ctx, mux, conn); err != nil {
 		return err
 	}
-	if err := gw_api.RegisterForumHandlerServer(ctx, mux, s.Forum); err != nil {
+	if err := mux.HandlePath(http.MethodGet, ThreadEventsPath, threadEventsHandler(mux, api.NewThreadClient(conn))); err != nil {
 		return err
 	}
-	if err := gw_api.RegisterThreadHandlerServer(ctx, mux, s.Thread); err != nil {
+	attachments := api.NewAttachmentClient(conn)
+	if err := mux.HandlePath(http.MethodPost, AttachmentUploadPath, attachmentUploadHandler(mux, attachments)); err != nil {
 		return err
 	}
-	if err := gw_api.RegisterPostHandlerServer(ctx, mux, s.Post); err != nil {
+	if err := mux.HandlePath(http.MethodGet, AttachmentDownloadPath, attachmentDownloadHandler(mux, attachments)); err != nil {
 		return err
 	}
+	if s.avatars != nil {
+		if err := mux.HandlePath(http.MethodPost, AvatarUploadPath, avatarUploadHandler(mux, api.NewUserClient(conn))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// incomingHeaderMatcher пробрасывает в метаданные gRPC заголовок с временем записи клиента.
+// Заголовок Authorization с токеном пользователя шлюз передаёт сам.
+func incomingHeaderMatcher(key string) (string, bool) {
+	if http.CanonicalHeaderKey(key) == http.CanonicalHeaderKey(MetadataKeyWroteAt) {
+		return MetadataKeyWroteAt, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
 
+// outgoingHeaderMatcher возвращает время записи клиента заголовком X-Wrote-At без префикса Grpc-Metadata-.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if key == MetadataKeyWroteAt {
+		return http.CanonicalHeaderKey(key), true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// HeaderNextPageToken - заголовок HTTP-ответа с токеном следующей страницы.
+// Тело ответов со списками содержит только список, поэтому токен передаётся в заголовке.
+const HeaderNextPageToken = "X-Next-Page-Token"
+
+// forwardNextPageToken копирует next_page_token ответа в заголовок HeaderNextPageToken.
+func forwardNextPageToken(_ context.Context, w http.ResponseWriter, resp proto.Message) error {
+	if paged, ok := resp.(interface{ GetNextPageToken() string }); ok {
+		if token := paged.GetNextPageToken(); len(token) != 0 {
+			w.Header().Set(HeaderNextPageToken, token)
+		}
+	}
 	return nil
 }
 
@@ -135,6 +282,9 @@ func (s *server) Run(ctx context.Context) error {
 				DiscardUnknown: true,
 			},
 		}),
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+		runtime.WithForwardResponseOption(forwardNextPageToken),
 	)
 
 	// Serve the swagger-ui and swagger file
@@ -145,8 +295,26 @@ func (s *server) Run(ctx context.Context) error {
 	fs := http.FileServer(http.Dir(SwaggerDir))
 	mux.Handle("/swagger/", http.StripPrefix("/swagger/", fs))
 
+	if s.avatars != nil {
+		mux.Handle(avatar.Path, avatarFileHandler(s.avatars))
+	}
+
+	// Connect Gateway to gRPC server
+	dialCreds, dialReloader, err := tlsutil.DialOption(s.gatewayTLS)
+	if err != nil {
+		return err
+	}
+	if dialReloader != nil {
+		s.closer(dialReloader.Close)
+	}
+	conn, err := grpc.DialContext(ctx, GatewayTargetGRPC, dialCreds)
+	if err != nil {
+		return err
+	}
+	s.closer(conn.Close)
+
 	// Register Gateway
-	if err := s.registerGatewayServices(ctx, gwmux); err != nil {
+	if err := s.registerGatewayServices(ctx, gwmux, conn); err != nil {
 		return err
 	}
 
@@ -156,6 +324,18 @@ func (s *server) Run(ctx context.Context) error {
 		Handler: mux,
 	}
 
+	if s.tls.Enabled() {
+		// сертификат клиента у браузеров не запрашивается
+		httpTLS := s.tls
+		httpTLS.ClientAuth = tls.NoClientCert
+		tlsConfig, reloader, err := tlsutil.ServerConfig(httpTLS)
+		if err != nil {
+			return err
+		}
+		s.closer(reloader.Close)
+		gwServer.TLSConfig = tlsConfig
+	}
+
 	s.closer(gwServer.Close)
 
 	// Serve gRPC server
@@ -167,12 +347,22 @@ func (s *server) Run(ctx context.Context) error {
 	// Serve gateway server
 	s.group.Go(func() error {
 		log.Println("start listen HTTP on", ServerAdressHTTP)
+		if gwServer.TLSConfig != nil {
+			return gwServer.ListenAndServeTLS("", "")
+		}
 		return gwServer.ListenAndServe()
 	})
 
 	return s.group.Wait()
 }
 
+// WithAvatars включает загрузку аватаров формой через HTTP шлюз и раздачу их файлов из хранилища.
+func WithAvatars(avatars storage.Storage) Option {
+	return func(o *options) {
+		o.avatars = avatars
+	}
+}
+
 func (s *server) closer(c closer) {
 	s.closers = append(s.closers, c)
 }
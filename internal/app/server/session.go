@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/storm5758/Forum-test/internal/pkg/database"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKeyWroteAt - ключ метаданных с подписанным временем последней записи клиента.
+// Сервер возвращает его в заголовках ответа на запрос с записью, а клиент передаёт полученное значение
+// в следующих запросах, чтобы читать свои изменения с основного сервера, пока реплики отстают.
+// Значение непрозрачно для клиента: отметки без подписи сервера не учитываются.
+// Через HTTP шлюз передаётся заголовком X-Wrote-At.
+const MetadataKeyWroteAt = "x-wrote-at"
+
+// WithSessionKey задаёт ключ подписи отметок времени записи, см. MetadataKeyWroteAt.
+// Ключ должен совпадать у всех экземпляров сервера. Без ключа генерируется случайный,
+// и отметки учитываются только выдавшим их экземпляром до перезапуска.
+func WithSessionKey(key []byte) Option {
+	return func(o *options) {
+		o.sessionKey = key
+	}
+}
+
+// sessionMarks подписывает отметки времени записи ключом HMAC-SHA256,
+// чтобы клиент не мог сам назначить себе чтение с основного сервера.
+type sessionMarks struct {
+	key []byte
+}
+
+func newSessionMarks(key []byte) (*sessionMarks, error) {
+	if len(key) == 0 {
+		key = make([]byte, sha256.Size)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+	}
+	return &sessionMarks{key: key}, nil
+}
+
+// encode возвращает отметку "<миллисекунды Unix>.<подпись>".
+func (m *sessionMarks) encode(wroteAt time.Time) string {
+	ms := strconv.FormatInt(wroteAt.UnixMilli(), 10)
+	return ms + "." + base64.RawURLEncoding.EncodeToString(m.sign(ms))
+}
+
+// decode проверяет подпись отметки и возвращает время записи.
+func (m *sessionMarks) decode(mark string) (time.Time, bool) {
+	ms, encodedSig, ok := strings.Cut(mark, ".")
+	if !ok {
+		return time.Time{}, false
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(encodedSig)
+	if err != nil || !hmac.Equal(sig, m.sign(ms)) {
+		return time.Time{}, false
+	}
+	t, err := strconv.ParseInt(ms, 10, 64)
+	if err != nil || t <= 0 {
+		return time.Time{}, false
+	}
+	return time.UnixMilli(t), true
+}
+
+func (m *sessionMarks) sign(ms string) []byte {
+	mac := hmac.New(sha256.New, m.key)
+	mac.Write([]byte(ms))
+	return mac.Sum(nil)
+}
+
+// unaryServerInterceptor связывает запрос с сессией клиента,
+// чтобы после записи клиент читал собственные изменения.
+func (m *sessionMarks) unaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, session := m.withSession(ctx)
+		resp, err := handler(ctx, req)
+		// заголовки unary ответа отправляются после выхода из перехватчика
+		if wroteAt := session.WroteAt(); !wroteAt.IsZero() {
+			if err := grpc.SetHeader(ctx, metadata.Pairs(MetadataKeyWroteAt, m.encode(wroteAt))); err != nil {
+				log.Println("session:", err)
+			}
+		}
+		return resp, err
+	}
+}
+
+// streamServerInterceptor только учитывает отметку клиента: заголовки потока
+// отправляются до его записей, поэтому новая отметка клиенту не возвращается.
+func (m *sessionMarks) streamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext, _ = m.withSession(ss.Context())
+		return handler(srv, wrapped)
+	}
+}
+
+func (m *sessionMarks) withSession(ctx context.Context) (context.Context, *database.Session) {
+	var wroteAt time.Time
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(MetadataKeyWroteAt); len(values) > 0 {
+		wroteAt, _ = m.decode(values[0])
+	}
+	return database.WithSession(ctx, wroteAt)
+}
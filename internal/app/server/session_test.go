@@ -0,0 +1,36 @@
+package server
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSessionMarks(t *testing.T) {
+	marks, err := newSessionMarks([]byte("key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wroteAt := time.UnixMilli(time.Now().UnixMilli())
+
+	got, ok := marks.decode(marks.encode(wroteAt))
+	if !ok || !got.Equal(wroteAt) {
+		t.Fatalf("decode(encode()) = %v, %v, want %v", got, ok, wroteAt)
+	}
+
+	other, err := newSessionMarks([]byte("other key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	future := wroteAt.Add(time.Hour)
+	for name, mark := range map[string]string{
+		"unsigned":  "1700000000000",
+		"other key": other.encode(wroteAt),
+		"altered":   "9" + marks.encode(wroteAt),
+		"resigned":  marks.encode(future)[:13] + marks.encode(wroteAt)[13:],
+		"empty":     "",
+	} {
+		if got, ok := marks.decode(mark); ok {
+			t.Errorf("%s: decode(%q) = %v, want rejected", name, mark, got)
+		}
+	}
+}
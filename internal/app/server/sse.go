@@ -0,0 +1,152 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/storm5758/Forum-test/pkg/api"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// ThreadEventsPath - Server-Sent Events с событиями ветки, см. ThreadEvents
+	ThreadEventsPath = "/api/thread/{slug_or_id}/events"
+
+	// SSEHeartbeatInterval - период комментариев, которые не дают прокси закрыть простаивающее соединение
+	SSEHeartbeatInterval = 15 * time.Second
+	// SSEBufferSize - сколько событий может ждать отправки клиенту.
+	// Клиент, который не успевает их читать, отключается и продолжает с Last-Event-ID.
+	SSEBufferSize = 64
+)
+
+// threadEventsHandler транслирует поток ThreadEvents в Server-Sent Events.
+// Поток запрашивается через gRPC соединение шлюза, поэтому проходит те же перехватчики.
+func threadEventsHandler(mux *runtime.ServeMux, client api.ThreadClient) runtime.HandlerFunc {
+	method := "/" + api.Thread_ServiceDesc.ServiceName + "/ThreadEvents"
+	marshaler := protojson.MarshalOptions{EmitUnpopulated: true}
+
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+			return
+		}
+
+		lastEventID, err := lastEventID(r)
+		if err != nil {
+			http.Error(w, "invalid Last-Event-ID", http.StatusBadRequest)
+			return
+		}
+
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		ctx, err = runtime.AnnotateContext(ctx, mux, r, method)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, &runtime.JSONPb{}, w, r, err)
+			return
+		}
+
+		stream, err := client.ThreadEvents(ctx, &api.ThreadEventsRequest{
+			SlugOrId:    params["slug_or_id"],
+			LastEventId: lastEventID,
+		})
+		if err != nil {
+			runtime.HTTPError(ctx, mux, &runtime.JSONPb{}, w, r, err)
+			return
+		}
+		// первое событие - состояние ветки, ошибки поиска ветки приходят вместо него
+		first, err := stream.Recv()
+		if err != nil {
+			runtime.HTTPError(ctx, mux, &runtime.JSONPb{}, w, r, err)
+			return
+		}
+
+		events := make(chan *api.ThreadEvent, SSEBufferSize)
+		events <- first
+		go func() {
+			defer close(events)
+			for {
+				event, err := stream.Recv()
+				if err != nil {
+					return
+				}
+				select {
+				case events <- event:
+				default:
+					// клиент не успевает читать события
+					cancel()
+					return
+				}
+			}
+		}()
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		heartbeat := time.NewTicker(SSEHeartbeatInterval)
+		defer heartbeat.Stop()
+
+		for {
+			select {
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				if err := writeThreadEvent(w, marshaler, event); err != nil {
+					return
+				}
+			case <-heartbeat.C:
+				if _, err := io.WriteString(w, ": heartbeat\n\n"); err != nil {
+					return
+				}
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// lastEventID возвращает id события, с которого продолжается подписка:
+// из заголовка Last-Event-ID при переподключении или из параметра last_event_id.
+func lastEventID(r *http.Request) (int64, error) {
+	value := r.Header.Get("Last-Event-ID")
+	if len(value) == 0 {
+		value = r.URL.Query().Get("last_event_id")
+	}
+	if len(value) == 0 {
+		return 0, nil
+	}
+	return strconv.ParseInt(value, 10, 64)
+}
+
+func writeThreadEvent(w io.Writer, marshaler protojson.MarshalOptions, event *api.ThreadEvent) error {
+	var (
+		name string
+		data proto.Message
+	)
+	switch e := event.GetEvent().(type) {
+	case *api.ThreadEvent_Post:
+		name, data = "post", e.Post
+	case *api.ThreadEvent_PostEdited:
+		name, data = "post_edited", e.PostEdited
+	case *api.ThreadEvent_Thread:
+		name, data = "thread", e.Thread
+	default:
+		return nil
+	}
+
+	payload, err := marshaler.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.GetId(), name, payload)
+	return err
+}
@@ -0,0 +1,45 @@
+package server
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// formOverhead - заголовки и поля формы сверх загружаемого файла
+const formOverhead = 64 << 10
+
+// readFormFile читает файл до maxSize байт из поля field формы multipart/form-data
+// и возвращает его имя и содержимое, остальные поля пропускаются.
+// Файл больше maxSize отклоняется с текстом errTooLarge.
+func readFormFile(w http.ResponseWriter, r *http.Request, field string, maxSize int, errTooLarge error) (string, []byte, error) {
+	r.Body = http.MaxBytesReader(w, r.Body, int64(maxSize)+formOverhead)
+	form, err := r.MultipartReader()
+	if err != nil {
+		return "", nil, status.Error(codes.InvalidArgument, "expected multipart/form-data")
+	}
+	for {
+		part, err := form.NextPart()
+		if errors.Is(err, io.EOF) {
+			return "", nil, status.Errorf(codes.InvalidArgument, "no %s field in form", field)
+		}
+		if err != nil {
+			return "", nil, status.Error(codes.InvalidArgument, "invalid form")
+		}
+		if part.FormName() != field {
+			continue
+		}
+
+		data, err := io.ReadAll(io.LimitReader(part, int64(maxSize)+1))
+		if err != nil {
+			return "", nil, status.Error(codes.InvalidArgument, "invalid form")
+		}
+		if len(data) > maxSize {
+			return "", nil, status.Error(codes.InvalidArgument, errTooLarge.Error())
+		}
+		return part.FileName(), data, nil
+	}
+}
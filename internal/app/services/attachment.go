@@ -0,0 +1,169 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log"
+
+	"github.com/storm5758/Forum-test/internal/app/attachment"
+	"github.com/storm5758/Forum-test/internal/app/auth"
+	internal_models "github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+	"github.com/storm5758/Forum-test/internal/pkg/storage"
+	"github.com/storm5758/Forum-test/pkg/api"
+	"github.com/storm5758/Forum-test/pkg/api/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// attachmentChunkSize - размер частей, которыми отправляется содержимое файла
+const attachmentChunkSize = 64 << 10
+
+type attachmentService struct {
+	api.UnimplementedAttachmentServer
+	attachmentRepository repository.Attachment
+	postRepository       repository.Post
+	files                storage.Storage
+	// quota - суммарный размер файлов одного пользователя в байтах
+	quota int64
+}
+
+func NewAttachmentService(attachmentRepository repository.Attachment, postRepository repository.Post, files storage.Storage, quota int64) api.AttachmentServer {
+	return &attachmentService{
+		attachmentRepository: attachmentRepository,
+		postRepository:       postRepository,
+		files:                files,
+		quota:                quota,
+	}
+}
+
+// Загрузка файла
+//
+// Загрузка файла для последующего прикрепления к сообщению.
+func (s *attachmentService) AttachmentUpload(ctx context.Context, req *api.AttachmentUploadRequest) (*models.Attachment, error) {
+	data := req.GetData()
+	if err := attachment.Validate(data); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	owner, _ := auth.UserFromContext(ctx)
+
+	key, err := attachment.NewKey()
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+	if err := s.files.Put(ctx, key, bytes.NewReader(data)); err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	created, err := s.attachmentRepository.CreateAttachment(ctx, internal_models.Attachment{
+		Owner:       owner,
+		Filename:    attachment.Filename(req.GetFilename()),
+		ContentType: attachment.ContentType(data),
+		Size:        int64(len(data)),
+		Key:         key,
+	}, s.quota)
+	if err != nil {
+		// файл без сведений о нём не удалит и фоновая задача
+		if err := s.files.Delete(ctx, key); err != nil {
+			log.Println(err)
+		}
+	}
+	if errors.Is(err, repository.ErrQuotaExceeded) {
+		return nil, status.Error(codes.ResourceExhausted, "attachment quota exceeded")
+	}
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	return attachmentToAPI(created), nil
+}
+
+// Скачивание файла
+//
+// Отправка сведений о файле и его содержимого частями.
+func (s *attachmentService) AttachmentDownload(req *api.AttachmentDownloadRequest, stream api.Attachment_AttachmentDownloadServer) error {
+	ctx := stream.Context()
+
+	a, err := s.getAttachment(ctx, req.GetId())
+	if err != nil {
+		return err
+	}
+
+	file, err := s.files.Get(ctx, a.Key)
+	if errors.Is(err, storage.ErrNotFound) {
+		log.Printf("attachment %d: no file %s in storage", a.Id, a.Key)
+		return status.Error(codes.NotFound, "attachment not found")
+	}
+	if err != nil {
+		log.Println(err)
+		return status.Error(codes.Internal, codes.Internal.String())
+	}
+	defer file.Close()
+
+	err = stream.Send(&api.AttachmentChunk{Chunk: &api.AttachmentChunk_Attachment{Attachment: attachmentToAPI(a)}})
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, attachmentChunkSize)
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&api.AttachmentChunk{Chunk: &api.AttachmentChunk_Data{Data: buf[:n]}}); err != nil {
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			log.Println(err)
+			return status.Error(codes.Internal, codes.Internal.String())
+		}
+	}
+}
+
+// getAttachment возвращает файл, если он доступен пользователю: файлы видны вместе с сообщением,
+// а файл, не прикреплённый к сообщению, - только загрузившему его пользователю.
+func (s *attachmentService) getAttachment(ctx context.Context, id int64) (internal_models.Attachment, error) {
+	notFound := status.Error(codes.NotFound, "attachment not found")
+
+	a, err := s.attachmentRepository.GetAttachment(ctx, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return internal_models.Attachment{}, notFound
+	}
+	if err != nil {
+		log.Println(err)
+		return internal_models.Attachment{}, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	viewer, _ := auth.UserFromContext(ctx)
+	if a.Post == 0 {
+		if a.Owner != viewer {
+			return internal_models.Attachment{}, notFound
+		}
+		return a, nil
+	}
+
+	post, err := s.postRepository.GetPostByID(ctx, a.Post)
+	if errors.Is(err, repository.ErrNotFound) {
+		return internal_models.Attachment{}, notFound
+	}
+	if err != nil {
+		log.Println(err)
+		return internal_models.Attachment{}, status.Error(codes.Internal, codes.Internal.String())
+	}
+	// файлы удалённых сообщений не выводятся, как и их текст
+	if post.IsDeleted || (post.Shadow && post.Author != viewer) {
+		return internal_models.Attachment{}, notFound
+	}
+	return a, nil
+}
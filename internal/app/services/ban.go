@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/storm5758/Forum-test/internal/app/auth"
+	internal_models "github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+	"github.com/storm5758/Forum-test/pkg/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	bansDefaultLimit = 20
+	bansMaxLimit     = 100
+)
+
+type banService struct {
+	api.UnimplementedBanServer
+	banRepository   repository.Ban
+	userRepository  repository.User
+	forumRepository repository.Forum
+}
+
+func NewBanService(banRepository repository.Ban, userRepository repository.User, forumRepository repository.Forum) api.BanServer {
+	return &banService{
+		banRepository:   banRepository,
+		userRepository:  userRepository,
+		forumRepository: forumRepository,
+	}
+}
+
+// Блокировка пользователя
+//
+// Блокировка пользователя в форуме или во всех форумах.
+func (s *banService) BanCreate(ctx context.Context, req *api.BanCreateRequest) (*api.BanInfo, error) {
+	ban := internal_models.Ban{
+		Shadow: req.GetShadow(),
+		Reason: req.GetReason(),
+	}
+	ban.Moderator, _ = auth.UserFromContext(ctx)
+
+	if expires := req.GetExpires(); len(expires) != 0 {
+		t, err := time.Parse(time.RFC3339Nano, expires)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid expires")
+		}
+		if !t.After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "expires is in the past")
+		}
+		ban.Expires = &expires
+	}
+
+	user, err := s.userRepository.GetUserByNickname(ctx, req.GetNickname())
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+	ban.Nickname = user.Nickname
+
+	if len(req.GetForum()) != 0 {
+		forum, err := getForum(ctx, s.forumRepository, req.GetForum())
+		if err != nil {
+			return nil, err
+		}
+		ban.Forum = forum.Slug
+	}
+
+	created, err := s.banRepository.CreateBan(ctx, ban)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+	return banToAPI(created), nil
+}
+
+// Список блокировок
+//
+// Получение блокировок форума или блокировок во всех форумах, начиная с последних.
+func (s *banService) BanList(ctx context.Context, req *api.BanListRequest) (*api.BanListResponse, error) {
+	limit := req.GetLimit()
+	if limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "negative limit")
+	}
+	if limit == 0 {
+		limit = bansDefaultLimit
+	}
+	if limit > bansMaxLimit {
+		limit = bansMaxLimit
+	}
+
+	filter := internal_models.BanFilter{
+		Nickname:        req.GetNickname(),
+		IncludeInactive: req.GetIncludeInactive(),
+		Limit:           limit,
+	}
+	if len(req.GetForum()) != 0 {
+		forum, err := getForum(ctx, s.forumRepository, req.GetForum())
+		if err != nil {
+			return nil, err
+		}
+		filter.Forum = forum.Slug
+	}
+
+	bans, err := s.banRepository.GetBans(ctx, filter)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	resp := &api.BanListResponse{
+		Bans: make([]*api.BanInfo, 0, len(bans)),
+	}
+	for _, ban := range bans {
+		resp.Bans = append(resp.Bans, banToAPI(ban))
+	}
+	return resp, nil
+}
+
+// Снятие блокировки
+//
+// Досрочное снятие блокировки.
+func (s *banService) BanLift(ctx context.Context, req *api.BanLiftRequest) (*api.BanInfo, error) {
+	moderator, _ := auth.UserFromContext(ctx)
+
+	lifted, err := s.banRepository.LiftBan(ctx, req.GetId(), moderator)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "ban not found")
+	}
+	if errors.Is(err, repository.ErrWrongState) {
+		return nil, status.Error(codes.FailedPrecondition, "ban is not active")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+	return banToAPI(lifted), nil
+}
+
+// checkBan проверяет блокировку в форуме пользователя, от имени которого выполняется запрос.
+// Пользователь берётся только из контекста, а не из тела запроса, чтобы блокировку нельзя было обойти.
+// Возвращает истину при теневой блокировке и PermissionDenied при полной.
+func checkBan(ctx context.Context, banRepository repository.Ban, forum string) (bool, error) {
+	nickname, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return false, status.Error(codes.Unauthenticated, codes.Unauthenticated.String())
+	}
+	ban, err := banRepository.GetActiveBan(ctx, nickname, forum)
+	if errors.Is(err, repository.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		log.Println(err)
+		return false, status.Error(codes.Internal, codes.Internal.String())
+	}
+	if !ban.Shadow {
+		return false, status.Errorf(codes.PermissionDenied, "user %s is banned", nickname)
+	}
+	return true, nil
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/storm5758/Forum-test/internal/app/auth"
+	internal_models "github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+	mock_repository "github.com/storm5758/Forum-test/internal/app/repository/mocks"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckBan(t *testing.T) {
+	tests := []struct {
+		name   string
+		caller string
+		ban    internal_models.Ban
+		err    error
+		shadow bool
+		code   codes.Code
+	}{
+		{"anonymous", "", internal_models.Ban{}, nil, false, codes.Unauthenticated},
+		{"not banned", "alice", internal_models.Ban{}, repository.ErrNotFound, false, codes.OK},
+		{"banned", "alice", internal_models.Ban{Nickname: "alice"}, nil, false, codes.PermissionDenied},
+		{"shadow banned", "alice", internal_models.Ban{Nickname: "alice", Shadow: true}, nil, true, codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bans := mock_repository.NewMockBan(gomock.NewController(t))
+			if len(tt.caller) > 0 {
+				// блокировка ищется для вызывающего пользователя
+				bans.EXPECT().GetActiveBan(gomock.Any(), tt.caller, "forum").Return(tt.ban, tt.err)
+			}
+
+			ctx := auth.NewContext(context.Background(), tt.caller)
+			shadow, err := checkBan(ctx, bans, "forum")
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("code = %s, want %s (%v)", code, tt.code, err)
+			}
+			if shadow != tt.shadow {
+				t.Errorf("shadow = %t, want %t", shadow, tt.shadow)
+			}
+		})
+	}
+}
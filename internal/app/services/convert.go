@@ -0,0 +1,273 @@
+package service
+
+import (
+	"sort"
+
+	"github.com/storm5758/Forum-test/internal/app/attachment"
+	"github.com/storm5758/Forum-test/internal/app/avatar"
+	"github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/pkg/markdown"
+	"github.com/storm5758/Forum-test/pkg/api"
+	api_models "github.com/storm5758/Forum-test/pkg/api/models"
+)
+
+func userToAPI(u models.User) *api_models.User {
+	user := &api_models.User{
+		About:    u.About,
+		Email:    u.Email,
+		Fullname: u.Fullname,
+		Nickname: u.Nickname,
+	}
+	if len(u.Avatar) != 0 {
+		user.Avatar = avatar.URL(u.Avatar, avatar.DefaultSize)
+		user.AvatarSizes = make(map[int32]string, len(avatar.Sizes))
+		for _, size := range avatar.Sizes {
+			user.AvatarSizes[int32(size)] = avatar.URL(u.Avatar, size)
+		}
+	}
+	return user
+}
+
+func forumToAPI(f models.Forum) *api_models.Forum {
+	return &api_models.Forum{
+		Posts:   f.Posts,
+		Slug:    f.Slug,
+		Threads: f.Threads,
+		Title:   f.Title,
+		User:    f.User,
+		Tags:    f.Tags,
+	}
+}
+
+func threadToAPI(t models.Thread) *api_models.Thread {
+	return &api_models.Thread{
+		Author:      t.Author,
+		Created:     t.Created,
+		Forum:       t.Forum,
+		Id:          t.Id,
+		Message:     t.Message,
+		MessageHtml: messageHTML(t.Message, t.MessageHTML),
+		Slug:        t.Slug,
+		Title:       t.Title,
+		Votes:       t.Votes,
+		Status:      threadStatuses[t.Status],
+		Pinned:      t.Pinned,
+		Tags:        t.Tags,
+	}
+}
+
+var threadStatuses = map[models.ThreadStatus]api_models.Thread_Status{
+	models.ThreadOpen:     api_models.Thread_STATUS_OPEN,
+	models.ThreadClosed:   api_models.Thread_STATUS_CLOSED,
+	models.ThreadArchived: api_models.Thread_STATUS_ARCHIVED,
+}
+
+func postToAPI(p models.Post) *api_models.Post {
+	return &api_models.Post{
+		Author:      p.Author,
+		Created:     p.Created,
+		Forum:       p.Forum,
+		Id:          p.Id,
+		IsEdited:    p.IsEdited,
+		Message:     p.Message,
+		MessageHtml: messageHTML(p.Message, p.MessageHTML),
+		Parent:      p.Parent,
+		Thread:      p.Thread,
+		IsDeleted:   p.IsDeleted,
+		Reactions:   reactionsToAPI(p.Reactions),
+		Score:       p.Score,
+		Attachments: attachmentsToAPI(p.Attachments),
+	}
+}
+
+func attachmentToAPI(a models.Attachment) *api_models.Attachment {
+	return &api_models.Attachment{
+		Id:          a.Id,
+		Filename:    a.Filename,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		Url:         attachment.URL(a.Id),
+		Created:     a.Created,
+	}
+}
+
+func attachmentsToAPI(list models.Attachments) []*api_models.Attachment {
+	if len(list) == 0 {
+		return nil
+	}
+	attachments := make([]*api_models.Attachment, 0, len(list))
+	for _, a := range list {
+		attachments = append(attachments, attachmentToAPI(a))
+	}
+	return attachments
+}
+
+// messageHTML возвращает сохранённый HTML сообщения.
+// Сообщения, созданные до отрисовки Markdown при записи, отрисовываются при чтении.
+func messageHTML(message, html string) string {
+	if len(html) == 0 && len(message) != 0 {
+		return markdown.Render(message)
+	}
+	return html
+}
+
+// reactionsToAPI возвращает реакции начиная с самых частых, при равенстве - по эмодзи.
+func reactionsToAPI(r models.Reactions) []*api_models.Reaction {
+	if len(r) == 0 {
+		return nil
+	}
+	reactions := make([]*api_models.Reaction, 0, len(r))
+	for emoji, count := range r {
+		reactions = append(reactions, &api_models.Reaction{Emoji: emoji, Count: count})
+	}
+	sort.Slice(reactions, func(i, j int) bool {
+		if reactions[i].Count != reactions[j].Count {
+			return reactions[i].Count > reactions[j].Count
+		}
+		return reactions[i].Emoji < reactions[j].Emoji
+	})
+	return reactions
+}
+
+var postSorts = map[api.ThreadGetPostsRequest_ThreadGetPostsRequestSort]models.PostSort{
+	api.ThreadGetPostsRequest_THREAD_GET_POSTS_REQUEST_SORT_FLAT:        models.PostSortFlat,
+	api.ThreadGetPostsRequest_THREAD_GET_POSTS_REQUEST_SORT_TREE:        models.PostSortTree,
+	api.ThreadGetPostsRequest_THREAD_GET_POSTS_REQUEST_SORT_PARENT_TREE: models.PostSortParentTree,
+	api.ThreadGetPostsRequest_THREAD_GET_POSTS_REQUEST_SORT_TOP:         models.PostSortTop,
+}
+
+func validPostSort(sort models.PostSort) bool {
+	for _, s := range postSorts {
+		if s == sort {
+			return true
+		}
+	}
+	return false
+}
+
+func webhookToAPI(w models.Webhook) *api.WebhookInfo {
+	webhook := &api.WebhookInfo{
+		Id:      w.Id,
+		Forum:   w.Forum,
+		Url:     w.Url,
+		Events:  make([]api.WebhookEvent, 0, len(w.Events)),
+		Created: w.Created,
+	}
+	for _, eventType := range w.Events {
+		for event, t := range webhookEvents {
+			if t == eventType {
+				webhook.Events = append(webhook.Events, event)
+			}
+		}
+	}
+	return webhook
+}
+
+func deliveryToAPI(d models.WebhookDelivery) *api.WebhookDelivery {
+	delivery := &api.WebhookDelivery{
+		Id:           d.Id,
+		Event:        d.Event,
+		EventType:    string(d.EventType),
+		Status:       deliveryStatuses[d.Status],
+		Attempts:     d.Attempts,
+		ResponseCode: d.ResponseCode,
+		LastError:    d.LastError,
+		Created:      d.Created,
+	}
+	if d.Status == models.DeliveryPending {
+		delivery.NextAttempt = d.NextAttempt
+	}
+	if d.Delivered != nil {
+		delivery.Delivered = *d.Delivered
+	}
+	return delivery
+}
+
+func banToAPI(b models.Ban) *api.BanInfo {
+	ban := &api.BanInfo{
+		Id:        b.Id,
+		Nickname:  b.Nickname,
+		Forum:     b.Forum,
+		Shadow:    b.Shadow,
+		Reason:    b.Reason,
+		Moderator: b.Moderator,
+		Created:   b.Created,
+		Active:    b.Active,
+	}
+	if b.Expires != nil {
+		ban.Expires = *b.Expires
+	}
+	if b.Lifted != nil {
+		ban.Lifted = *b.Lifted
+	}
+	if b.LiftedBy != nil {
+		ban.LiftedBy = *b.LiftedBy
+	}
+	return ban
+}
+
+func reportToAPI(r models.Report) *api.ReportInfo {
+	report := &api.ReportInfo{
+		Id:       r.Id,
+		Forum:    r.Forum,
+		Thread:   r.Thread,
+		Post:     r.Post,
+		Reporter: r.Reporter,
+		Reason:   r.Reason,
+		Created:  r.Created,
+	}
+	if r.Resolution != nil {
+		report.Resolution = *r.Resolution
+	}
+	return report
+}
+
+func reportTargetToAPI(t models.ReportTarget) *api.ReportTarget {
+	return &api.ReportTarget{
+		Thread:      t.Thread,
+		Post:        t.Post,
+		Author:      t.Author,
+		Reports:     t.Reports,
+		FirstReport: t.FirstReport,
+		Created:     t.Created,
+		Updated:     t.Updated,
+		Reasons:     t.Reasons,
+	}
+}
+
+func resolutionToAPI(r models.ReportResolution) *api.ReportResolution {
+	resolution := &api.ReportResolution{
+		Id:        r.Id,
+		Forum:     r.Forum,
+		Moderator: r.Moderator,
+		Comment:   r.Comment,
+		Thread:    r.Thread,
+		Post:      r.Post,
+		Ban:       r.Ban,
+		Created:   r.Created,
+		Reports:   r.Reports,
+	}
+	for action, a := range reportActions {
+		if a == r.Action {
+			resolution.Action = action
+		}
+	}
+	return resolution
+}
+
+func notificationToAPI(n models.Notification) *api.NotificationInfo {
+	notification := &api.NotificationInfo{
+		Id:      n.Id,
+		Kind:    api.NotificationKind_NOTIFICATION_KIND_MENTION,
+		Actor:   n.Actor,
+		Forum:   n.Forum,
+		Thread:  n.Thread,
+		Post:    n.Post,
+		Created: n.Created,
+		Read:    n.Read != nil,
+	}
+	if n.Kind == models.NotificationReply {
+		notification.Kind = api.NotificationKind_NOTIFICATION_KIND_REPLY
+	}
+	return notification
+}
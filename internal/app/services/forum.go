@@ -2,7 +2,17 @@ package service
 
 import (
 	"context"
+	"errors"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/storm5758/Forum-test/internal/app/auth"
+	internal_models "github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+	"github.com/storm5758/Forum-test/internal/pkg/pagetoken"
 	"github.com/storm5758/Forum-test/pkg/api"
 	"github.com/storm5758/Forum-test/pkg/api/models"
 	"google.golang.org/grpc/codes"
@@ -11,24 +21,75 @@ import (
 
 type forumService struct {
 	api.UnimplementedForumServer
+	forumRepository repository.Forum
+	userRepository  repository.User
+	pageTokens      *pagetoken.Codec
 }
 
-func NewForumService() api.ForumServer {
-	return &forumService{}
+func NewForumService(forumRepository repository.Forum, userRepository repository.User, pageTokens *pagetoken.Codec) api.ForumServer {
+	return &forumService{
+		forumRepository: forumRepository,
+		userRepository:  userRepository,
+		pageTokens:      pageTokens,
+	}
 }
 
 // Создание форума
 //
 // Создание нового форума.
-func (s *forumService) ForumCreate(context.Context, *api.ForumCreateRequest) (*models.Forum, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ForumCreate not implemented")
+func (s *forumService) ForumCreate(ctx context.Context, req *api.ForumCreateRequest) (*models.Forum, error) {
+	forum := req.GetForum()
+	if len(forum.GetSlug()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty slug")
+	}
+	if len(forum.GetTitle()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty title")
+	}
+
+	user, err := s.userRepository.GetUserByNickname(ctx, forum.GetUser())
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	created, err := s.forumRepository.CreateForum(ctx, internal_models.NewForum{
+		Slug:  forum.GetSlug(),
+		Title: forum.GetTitle(),
+		User:  user.Nickname,
+	})
+	if errors.Is(err, repository.ErrAlreadyExists) {
+		return nil, status.Error(codes.AlreadyExists, codes.AlreadyExists.String())
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	return forumToAPI(created), nil
 }
 
 // Получение информации о форуме
 //
 // Получение информации о форуме по его идентификаторе.
-func (s *forumService) ForumGetOne(context.Context, *api.ForumGetOneRequest) (*models.Forum, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ForumGetOne not implemented")
+func (s *forumService) ForumGetOne(ctx context.Context, req *api.ForumGetOneRequest) (*models.Forum, error) {
+	slug := req.GetSlug()
+	if len(slug) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty slug")
+	}
+
+	forum, err := s.forumRepository.GetForumBySlug(ctx, slug)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, codes.NotFound.String())
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	return forumToAPI(forum), nil
 }
 
 // Список ветвей обсужления форума
@@ -36,8 +97,65 @@ func (s *forumService) ForumGetOne(context.Context, *api.ForumGetOneRequest) (*m
 // Получение списка ветвей обсужления данного форума.
 //
 // Ветви обсуждения выводятся отсортированные по дате создания.
-func (s *forumService) ForumGetThreads(context.Context, *api.ForumGetThreadsRequest) (*models.Thread, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ForumGetThreads not implemented")
+func (s *forumService) ForumGetThreads(ctx context.Context, req *api.ForumGetThreadsRequest) (*api.ForumGetThreadsResponse, error) {
+	if req.GetLimit() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "negative limit")
+	}
+
+	forum, err := getForum(ctx, s.forumRepository, req.GetSlug())
+	if err != nil {
+		return nil, err
+	}
+
+	filter := internal_models.ThreadFilter{
+		Limit: req.GetLimit(),
+		Since: req.GetSince(),
+		Desc:  req.GetDesc(),
+		Tag:   strings.TrimSpace(req.GetTag()),
+	}
+	filter.Viewer, _ = auth.UserFromContext(ctx)
+	// токен страницы действует только для выборки с той же меткой
+	scope := forum.Slug
+	if len(filter.Tag) != 0 {
+		scope += "#" + strings.ToLower(filter.Tag)
+	}
+	if token := req.GetPageToken(); len(token) != 0 {
+		cursor, err := s.pageTokens.DecodeFor(token, listForumThreads, scope)
+		if err != nil {
+			return nil, errInvalidPageToken
+		}
+		if filter.After, err = decodeThreadKey(cursor); err != nil {
+			return nil, err
+		}
+		filter.Since, filter.Desc = "", cursor.Desc
+	} else if len(filter.Since) != 0 {
+		if _, err := time.Parse(time.RFC3339Nano, filter.Since); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid since")
+		}
+	}
+
+	threads, err := s.forumRepository.GetForumThreads(ctx, forum.Slug, filter)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	resp := &api.ForumGetThreadsResponse{
+		Threads: make([]*models.Thread, 0, len(threads)),
+	}
+	for _, thread := range threads {
+		resp.Threads = append(resp.Threads, threadToAPI(thread))
+	}
+	if hasNextPage(filter.Limit, len(threads)) {
+		last := threads[len(threads)-1]
+		resp.NextPageToken = s.pageTokens.Encode(pagetoken.Cursor{
+			List:  listForumThreads,
+			Scope: scope,
+			Desc:  filter.Desc,
+			Key:   []string{strconv.FormatBool(last.Pinned), last.Created, strconv.FormatInt(int64(last.Id), 10)},
+		})
+	}
+	return resp, nil
 }
 
 // Пользователи данного форума
@@ -46,6 +164,148 @@ func (s *forumService) ForumGetThreads(context.Context, *api.ForumGetThreadsRequ
 //
 // Пользователи выводятся отсортированные по nickname в порядке возрастания.
 // Порядок сотрировки должен соответсвовать побайтовому сравнение в нижнем регистре.
-func (s *forumService) ForumGetUsers(context.Context, *api.ForumGetUsersRequest) (*api.ForumGetUsersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ForumGetUsers not implemented")
+func (s *forumService) ForumGetUsers(ctx context.Context, req *api.ForumGetUsersRequest) (*api.ForumGetUsersResponse, error) {
+	if req.GetLimit() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "negative limit")
+	}
+
+	forum, err := getForum(ctx, s.forumRepository, req.GetSlug())
+	if err != nil {
+		return nil, err
+	}
+
+	filter := internal_models.UserFilter{
+		Limit: req.GetLimit(),
+		Since: req.GetSince(),
+		Desc:  req.GetDesc(),
+	}
+	if token := req.GetPageToken(); len(token) != 0 {
+		cursor, err := s.pageTokens.DecodeFor(token, listForumUsers, forum.Slug)
+		if err != nil {
+			return nil, errInvalidPageToken
+		}
+		if filter.Since, err = decodeSingleKey(cursor); err != nil {
+			return nil, err
+		}
+		filter.Desc = cursor.Desc
+	}
+
+	users, err := s.forumRepository.GetForumUsers(ctx, forum.Slug, filter)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	resp := &api.ForumGetUsersResponse{
+		Users: make([]*models.User, 0, len(users)),
+	}
+	for _, user := range users {
+		resp.Users = append(resp.Users, userToAPI(user))
+	}
+	if hasNextPage(filter.Limit, len(users)) {
+		resp.NextPageToken = s.pageTokens.Encode(pagetoken.Cursor{
+			List:  listForumUsers,
+			Scope: forum.Slug,
+			Desc:  filter.Desc,
+			Key:   []string{users[len(users)-1].Nickname},
+		})
+	}
+	return resp, nil
+}
+
+func getForum(ctx context.Context, forumRepository repository.Forum, slug string) (internal_models.Forum, error) {
+	if len(slug) == 0 {
+		return internal_models.Forum{}, status.Error(codes.InvalidArgument, "empty slug")
+	}
+
+	forum, err := forumRepository.GetForumBySlug(ctx, slug)
+	if errors.Is(err, repository.ErrNotFound) {
+		return internal_models.Forum{}, status.Error(codes.NotFound, "forum not found")
+	}
+	if err != nil {
+		log.Println(err)
+		return internal_models.Forum{}, status.Error(codes.Internal, codes.Internal.String())
+	}
+	return forum, nil
+}
+
+// Метки ветвей обсуждения форума
+//
+// Получение меток ветвей обсуждения форума с кол-вом ветвей, начиная с самых частых.
+func (s *forumService) ListTags(ctx context.Context, req *api.ListTagsRequest) (*api.ListTagsResponse, error) {
+	limit := req.GetLimit()
+	if limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "negative limit")
+	}
+	if limit == 0 {
+		limit = tagsDefaultLimit
+	}
+	if limit > tagsMaxLimit {
+		limit = tagsMaxLimit
+	}
+
+	forum, err := getForum(ctx, s.forumRepository, req.GetSlug())
+	if err != nil {
+		return nil, err
+	}
+
+	curated := len(forum.Tags) != 0
+	// кол-во веток для меток из списка форума нужно независимо от того, насколько часты другие метки
+	queryLimit := limit
+	if curated {
+		queryLimit = 0
+	}
+	counts, err := s.forumRepository.GetForumTags(ctx, forum.Slug, queryLimit)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	resp := &api.ListTagsResponse{
+		Tags:    make([]*api.TagCount, 0, len(counts)),
+		Curated: curated,
+	}
+	if !resp.Curated {
+		for _, count := range counts {
+			resp.Tags = append(resp.Tags, &api.TagCount{Tag: count.Tag, Count: count.Count})
+		}
+		return resp, nil
+	}
+
+	// метки из списка форума выводятся и без веток, метки, удалённые из списка, не выводятся
+	byTag := make(map[string]int32, len(counts))
+	for _, count := range counts {
+		byTag[strings.ToLower(count.Tag)] = count.Count
+	}
+	for _, tag := range forum.Tags {
+		resp.Tags = append(resp.Tags, &api.TagCount{Tag: tag, Count: byTag[strings.ToLower(tag)]})
+	}
+	sort.SliceStable(resp.Tags, func(i, j int) bool {
+		return resp.Tags[i].Count > resp.Tags[j].Count
+	})
+	if len(resp.Tags) > int(limit) {
+		resp.Tags = resp.Tags[:limit]
+	}
+	return resp, nil
+}
+
+// Изменение списка меток форума
+//
+// Замена списка меток, из которых выбираются метки ветвей обсуждения форума.
+func (s *forumService) ForumSetTags(ctx context.Context, req *api.ForumSetTagsRequest) (*models.Forum, error) {
+	tags, err := normalizeTags(req.GetTags(), forumMaxTags)
+	if err != nil {
+		return nil, err
+	}
+	forum, err := getForum(ctx, s.forumRepository, req.GetSlug())
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.forumRepository.SetForumTags(ctx, forum.Slug, tags)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+	return forumToAPI(updated), nil
 }
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/storm5758/Forum-test/internal/app/auth"
+	internal_models "github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+	"github.com/storm5758/Forum-test/internal/pkg/pagetoken"
+	"github.com/storm5758/Forum-test/pkg/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	notificationsDefaultLimit = 20
+	notificationsMaxLimit     = 100
+	// notificationsMaxMarked - уведомлений, которые можно перечислить в одном запросе
+	notificationsMaxMarked = 1000
+)
+
+type notificationService struct {
+	api.UnimplementedNotificationServer
+	notificationRepository repository.Notification
+	pageTokens             *pagetoken.Codec
+}
+
+func NewNotificationService(notificationRepository repository.Notification, pageTokens *pagetoken.Codec) api.NotificationServer {
+	return &notificationService{
+		notificationRepository: notificationRepository,
+		pageTokens:             pageTokens,
+	}
+}
+
+// Уведомления пользователя
+//
+// Уведомления об упоминаниях и ответах, начиная с последних.
+func (s *notificationService) NotificationList(ctx context.Context, req *api.NotificationListRequest) (*api.NotificationListResponse, error) {
+	limit := req.GetLimit()
+	if limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "negative limit")
+	}
+	if limit == 0 {
+		limit = notificationsDefaultLimit
+	}
+	if limit > notificationsMaxLimit {
+		limit = notificationsMaxLimit
+	}
+	nickname, _ := auth.UserFromContext(ctx)
+
+	filter := internal_models.NotificationFilter{
+		UnreadOnly: req.GetUnreadOnly(),
+		Limit:      limit,
+	}
+	if token := req.GetPageToken(); len(token) != 0 {
+		cursor, err := s.pageTokens.DecodeFor(token, listNotifications, nickname)
+		if err != nil {
+			return nil, errInvalidPageToken
+		}
+		key, err := decodeSingleKey(cursor)
+		if err != nil {
+			return nil, err
+		}
+		if filter.After, err = strconv.ParseInt(key, 10, 64); err != nil {
+			return nil, errInvalidPageToken
+		}
+	}
+
+	notifications, err := s.notificationRepository.GetNotifications(ctx, nickname, filter)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	resp := &api.NotificationListResponse{
+		Notifications: make([]*api.NotificationInfo, 0, len(notifications)),
+	}
+	for _, notification := range notifications {
+		resp.Notifications = append(resp.Notifications, notificationToAPI(notification))
+	}
+	if hasNextPage(filter.Limit, len(notifications)) {
+		resp.NextPageToken = s.pageTokens.Encode(pagetoken.Cursor{
+			List:  listNotifications,
+			Scope: nickname,
+			Key:   []string{strconv.FormatInt(notifications[len(notifications)-1].Id, 10)},
+		})
+	}
+	return resp, nil
+}
+
+// Отметка уведомлений прочитанными
+//
+// Отметка перечисленных уведомлений или всех уведомлений пользователя.
+func (s *notificationService) NotificationMarkRead(ctx context.Context, req *api.NotificationMarkReadRequest) (*api.NotificationMarkReadResponse, error) {
+	ids := req.GetIds()
+	if req.GetAll() == (len(ids) != 0) {
+		return nil, status.Error(codes.InvalidArgument, "either ids or all must be set")
+	}
+	if len(ids) > notificationsMaxMarked {
+		return nil, status.Errorf(codes.InvalidArgument, "too many ids, max %d", notificationsMaxMarked)
+	}
+	nickname, _ := auth.UserFromContext(ctx)
+
+	marked, err := s.notificationRepository.MarkNotificationsRead(ctx, nickname, ids)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+	return &api.NotificationMarkReadResponse{Marked: marked}, nil
+}
+
+// Кол-во непрочитанных уведомлений
+func (s *notificationService) NotificationUnreadCount(ctx context.Context, _ *api.NotificationUnreadCountRequest) (*api.NotificationUnreadCountResponse, error) {
+	nickname, _ := auth.UserFromContext(ctx)
+
+	count, err := s.notificationRepository.CountUnreadNotifications(ctx, nickname)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+	return &api.NotificationUnreadCountResponse{Count: count}, nil
+}
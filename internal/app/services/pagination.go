@@ -0,0 +1,67 @@
+package service
+
+import (
+	"strconv"
+
+	internal_models "github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/pkg/pagetoken"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Виды выборок в токенах страниц
+const (
+	listForumThreads  = "forum_threads"
+	listForumUsers    = "forum_users"
+	listThreadPosts   = "thread_posts"
+	listReportQueue   = "report_queue"
+	listNotifications = "notifications"
+)
+
+var errInvalidPageToken = status.Error(codes.InvalidArgument, "invalid page token")
+
+// hasNextPage сообщает, что за выданной страницей могут быть записи.
+// Без лимита выдаётся вся выборка.
+func hasNextPage(limit int32, n int) bool {
+	return limit > 0 && n == int(limit)
+}
+
+// decodeThreadKey возвращает ключ ветки из токена страницы.
+func decodeThreadKey(cursor pagetoken.Cursor) (*internal_models.ThreadKey, error) {
+	if len(cursor.Key) != 3 {
+		return nil, errInvalidPageToken
+	}
+	pinned, err := strconv.ParseBool(cursor.Key[0])
+	if err != nil {
+		return nil, errInvalidPageToken
+	}
+	id, err := strconv.ParseInt(cursor.Key[2], 10, 32)
+	if err != nil {
+		return nil, errInvalidPageToken
+	}
+	return &internal_models.ThreadKey{Pinned: pinned, Created: cursor.Key[1], Id: int32(id)}, nil
+}
+
+// decodePostKey возвращает ключ корневого сообщения сортировки top из токена страницы.
+func decodePostKey(cursor pagetoken.Cursor) (*internal_models.PostKey, error) {
+	if len(cursor.Key) != 2 {
+		return nil, errInvalidPageToken
+	}
+	score, err := strconv.ParseInt(cursor.Key[0], 10, 32)
+	if err != nil {
+		return nil, errInvalidPageToken
+	}
+	id, err := strconv.ParseInt(cursor.Key[1], 10, 64)
+	if err != nil {
+		return nil, errInvalidPageToken
+	}
+	return &internal_models.PostKey{Score: int32(score), Id: id}, nil
+}
+
+// decodeSingleKey возвращает ключ из токена страницы, состоящий из одного значения.
+func decodeSingleKey(cursor pagetoken.Cursor) (string, error) {
+	if len(cursor.Key) != 1 || len(cursor.Key[0]) == 0 {
+		return "", errInvalidPageToken
+	}
+	return cursor.Key[0], nil
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/storm5758/Forum-test/internal/app/auth"
+	"github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+	"github.com/storm5758/Forum-test/pkg/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NewPolicy возвращает правила доступа к методам API.
+// Каждый новый метод API должен быть явно объявлен здесь, иначе его вызов будет запрещён.
+func NewPolicy(forumRepository repository.Forum, threadRepository repository.Thread, postRepository repository.Post, banRepository repository.Ban) auth.Policy {
+	var (
+		anyone        = auth.Rule{Anonymous: true}
+		authenticated = auth.Rule{Roles: []models.Role{models.RoleUser}}
+		adminOnly     = auth.Rule{Roles: []models.Role{models.RoleAdmin}}
+		profileOwner  = auth.Rule{
+			Roles:    []models.Role{models.RoleModerator},
+			Owner:    true,
+			Resource: userResource,
+		}
+		forumOwner = auth.Rule{
+			Roles:    []models.Role{models.RoleForumOwner},
+			Owner:    true,
+			Resource: forumResource(forumRepository),
+		}
+		threadModerator = auth.Rule{
+			Roles:    []models.Role{models.RoleModerator, models.RoleForumOwner},
+			Resource: threadResource(threadRepository),
+		}
+		forumModerator = auth.Rule{
+			Roles:    []models.Role{models.RoleModerator, models.RoleForumOwner},
+			Owner:    true,
+			Resource: forumResource(forumRepository),
+		}
+		// блокировки во всех форумах доступны только глобальным модераторам
+		banModerator = auth.Rule{
+			Roles:    []models.Role{models.RoleModerator, models.RoleForumOwner},
+			Owner:    true,
+			Resource: banResource(forumRepository, banRepository),
+		}
+	)
+
+	admin := api.Admin_ServiceDesc.ServiceName
+	user := api.User_ServiceDesc.ServiceName
+	forum := api.Forum_ServiceDesc.ServiceName
+	thread := api.Thread_ServiceDesc.ServiceName
+	post := api.Post_ServiceDesc.ServiceName
+	search := api.Search_ServiceDesc.ServiceName
+	webhook := api.Webhook_ServiceDesc.ServiceName
+	ban := api.Ban_ServiceDesc.ServiceName
+	report := api.Report_ServiceDesc.ServiceName
+	notification := api.Notification_ServiceDesc.ServiceName
+	attachment := api.Attachment_ServiceDesc.ServiceName
+
+	return auth.Policy{
+		auth.Method(admin, "Clear"):  adminOnly,
+		auth.Method(admin, "Status"): adminOnly,
+
+		auth.Method(user, "UserCreate"):       anyone,
+		auth.Method(user, "UserGetOne"):       anyone,
+		auth.Method(user, "UserUpdate"):       profileOwner,
+		auth.Method(user, "UserAvatarUpload"): profileOwner,
+		auth.Method(user, "UserAvatarDelete"): profileOwner,
+
+		auth.Method(forum, "ForumCreate"):     anyone,
+		auth.Method(forum, "ForumGetOne"):     anyone,
+		auth.Method(forum, "ForumGetThreads"): anyone,
+		auth.Method(forum, "ForumGetUsers"):   anyone,
+		auth.Method(forum, "ListTags"):        anyone,
+		auth.Method(forum, "ForumSetTags"):    forumOwner,
+
+		auth.Method(thread, "ThreadCreate"):    authenticated,
+		auth.Method(thread, "ThreadGetOne"):    anyone,
+		auth.Method(thread, "ThreadGetPosts"):  anyone,
+		auth.Method(thread, "ThreadVote"):      authenticated,
+		auth.Method(thread, "SubscribeThread"): anyone,
+		auth.Method(thread, "ThreadEvents"):    anyone,
+		auth.Method(thread, "ThreadSetStatus"): threadModerator,
+		auth.Method(thread, "ThreadPin"):       threadModerator,
+		auth.Method(thread, "ThreadUpdate"): {
+			Roles:    []models.Role{models.RoleModerator},
+			Owner:    true,
+			Resource: threadResource(threadRepository),
+		},
+
+		auth.Method(post, "PostsCreate"): authenticated,
+		auth.Method(post, "PostGetOne"):  anyone,
+		auth.Method(post, "PostUpdate"): {
+			Roles:    []models.Role{models.RoleModerator},
+			Owner:    true,
+			Resource: postResource(postRepository),
+		},
+		auth.Method(post, "PostDelete"): {
+			Roles:    []models.Role{models.RoleModerator, models.RoleForumOwner},
+			Owner:    true,
+			Resource: postResource(postRepository),
+		},
+		// автор не может восстановить сообщение, удалённое модератором
+		auth.Method(post, "PostRestore"): {
+			Roles:    []models.Role{models.RoleModerator, models.RoleForumOwner},
+			Resource: postResource(postRepository),
+		},
+		auth.Method(post, "PostVote"):       authenticated,
+		auth.Method(post, "PostReact"):      authenticated,
+		auth.Method(post, "PostUnreact"):    authenticated,
+		auth.Method(post, "ReactionList"):   anyone,
+		auth.Method(post, "PreviewMessage"): anyone,
+
+		auth.Method(search, "Search"): anyone,
+
+		auth.Method(webhook, "WebhookCreate"):     forumOwner,
+		auth.Method(webhook, "WebhookList"):       forumOwner,
+		auth.Method(webhook, "WebhookDelete"):     forumOwner,
+		auth.Method(webhook, "WebhookDeliveries"): forumOwner,
+		auth.Method(webhook, "WebhookRedeliver"):  forumOwner,
+
+		auth.Method(ban, "BanCreate"): banModerator,
+		auth.Method(ban, "BanList"):   banModerator,
+		auth.Method(ban, "BanLift"):   banModerator,
+
+		auth.Method(report, "ReportPost"):    authenticated,
+		auth.Method(report, "ReportThread"):  authenticated,
+		auth.Method(report, "ReportQueue"):   forumModerator,
+		auth.Method(report, "ReportResolve"): forumModerator,
+
+		auth.Method(notification, "NotificationList"):        authenticated,
+		auth.Method(notification, "NotificationMarkRead"):    authenticated,
+		auth.Method(notification, "NotificationUnreadCount"): authenticated,
+
+		auth.Method(attachment, "AttachmentUpload"): authenticated,
+		// доступ к файлу проверяется по его сообщению
+		auth.Method(attachment, "AttachmentDownload"): anyone,
+	}
+}
+
+// userResource - владельцем профиля является сам пользователь.
+func userResource(_ context.Context, req interface{}) (auth.Resource, error) {
+	return auth.Resource{Owner: req.(interface{ GetNickname() string }).GetNickname()}, nil
+}
+
+// forumResource - владельцем форума является его создатель.
+func forumResource(forumRepository repository.Forum) auth.ResourceFunc {
+	return func(ctx context.Context, req interface{}) (auth.Resource, error) {
+		slug := req.(interface{ GetSlug() string }).GetSlug()
+		forum, err := forumRepository.GetForumBySlug(ctx, slug)
+		if err != nil {
+			return auth.Resource{}, err
+		}
+		return auth.Resource{Owner: forum.User, Forum: forum.Slug}, nil
+	}
+}
+
+// threadResource - владельцем ветки является её автор.
+// Правило threadModerator не учитывает владельца: автор не может сам закрыть или закрепить ветку.
+func threadResource(threadRepository repository.Thread) auth.ResourceFunc {
+	return func(ctx context.Context, req interface{}) (auth.Resource, error) {
+		slugOrID := req.(interface{ GetSlugOrId() string }).GetSlugOrId()
+		thread, err := threadRepository.GetThreadBySlugOrID(ctx, slugOrID)
+		if err != nil {
+			return auth.Resource{}, err
+		}
+		return auth.Resource{Owner: thread.Author, Forum: thread.Forum}, nil
+	}
+}
+
+// postResource - владельцем сообщения является его автор.
+func postResource(postRepository repository.Post) auth.ResourceFunc {
+	return func(ctx context.Context, req interface{}) (auth.Resource, error) {
+		post, err := postRepository.GetPostByID(ctx, req.(interface{ GetId() int64 }).GetId())
+		if err != nil {
+			return auth.Resource{}, err
+		}
+		return auth.Resource{Owner: post.Author, Forum: post.Forum}, nil
+	}
+}
+
+// banResource - блокировка относится к своему форуму, владельцем считается создатель форума.
+// Для блокировок во всех форумах объект пустой.
+func banResource(forumRepository repository.Forum, banRepository repository.Ban) auth.ResourceFunc {
+	return func(ctx context.Context, req interface{}) (auth.Resource, error) {
+		var slug string
+		if lift, ok := req.(*api.BanLiftRequest); ok {
+			ban, err := banRepository.GetBan(ctx, lift.GetId())
+			if err != nil {
+				return auth.Resource{}, err
+			}
+			slug = ban.Forum
+		} else {
+			slug = req.(interface{ GetForum() string }).GetForum()
+		}
+		if len(slug) == 0 {
+			return auth.Resource{}, nil
+		}
+
+		forum, err := forumRepository.GetForumBySlug(ctx, slug)
+		if err != nil {
+			return auth.Resource{}, err
+		}
+		return auth.Resource{Owner: forum.User, Forum: forum.Slug}, nil
+	}
+}
+
+// actingUser возвращает пользователя из контекста, от имени которого выполняется изменение.
+// nickname из тела запроса можно не указывать, указанный должен совпадать с вызывающим пользователем.
+func actingUser(ctx context.Context, nickname string) (string, error) {
+	caller, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, codes.Unauthenticated.String())
+	}
+	if len(nickname) != 0 && !strings.EqualFold(nickname, caller) {
+		return "", status.Error(codes.PermissionDenied, "cannot act on behalf of another user")
+	}
+	return caller, nil
+}
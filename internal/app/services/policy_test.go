@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/storm5758/Forum-test/internal/app/auth"
+	internal_models "github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+	mock_repository "github.com/storm5758/Forum-test/internal/app/repository/mocks"
+	"github.com/storm5758/Forum-test/pkg/api"
+	"github.com/storm5758/Forum-test/pkg/api/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestActingUser(t *testing.T) {
+	tests := []struct {
+		name     string
+		caller   string
+		nickname string
+		want     string
+		code     codes.Code
+	}{
+		{"anonymous", "", "alice", "", codes.Unauthenticated},
+		{"omitted", "alice", "", "alice", codes.OK},
+		{"same user", "alice", "ALICE", "alice", codes.OK},
+		{"another user", "alice", "bob", "", codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := auth.NewContext(context.Background(), tt.caller)
+			got, err := actingUser(ctx, tt.nickname)
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("code = %s, want %s (%v)", code, tt.code, err)
+			}
+			if got != tt.want {
+				t.Errorf("actingUser() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPostsCreateAsAnotherUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	threads := mock_repository.NewMockThread(ctrl)
+	users := mock_repository.NewMockUser(ctrl)
+	bans := mock_repository.NewMockBan(ctrl)
+	// CreatePosts не ожидается: сообщение от чужого имени отклоняется до записи
+	posts := mock_repository.NewMockPost(ctrl)
+
+	thread := internal_models.Thread{Id: 1, Forum: "forum", Status: internal_models.ThreadOpen}
+	threads.EXPECT().GetThreadBySlugOrID(gomock.Any(), "1").Return(thread, nil)
+	users.EXPECT().GetUserByNickname(gomock.Any(), "alice").Return(internal_models.User{Nickname: "alice"}, nil)
+	bans.EXPECT().GetActiveBan(gomock.Any(), "alice", "forum").Return(internal_models.Ban{}, repository.ErrNotFound)
+
+	s := NewPostService(posts, threads, users, nil, bans, nil)
+	ctx := auth.NewContext(context.Background(), "alice")
+	_, err := s.PostsCreate(ctx, &api.PostsCreateRequest{
+		SlugOrId: "1",
+		Posts: []*models.Post{
+			{Author: "alice", Message: "mine"},
+			{Author: "bob", Message: "not mine"},
+		},
+	})
+	if code := status.Code(err); code != codes.PermissionDenied {
+		t.Fatalf("code = %s, want %s (%v)", code, codes.PermissionDenied, err)
+	}
+}
+
+func TestPostVoteAsAnotherUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	// VotePost не ожидается: голос от чужого имени отклоняется до записи
+	posts := mock_repository.NewMockPost(ctrl)
+	posts.EXPECT().GetPostByID(gomock.Any(), int64(1)).Return(internal_models.Post{Id: 1, Author: "bob", Forum: "forum"}, nil)
+
+	s := NewPostService(posts, nil, nil, nil, nil, nil)
+	ctx := auth.NewContext(context.Background(), "alice")
+	_, err := s.PostVote(ctx, &api.PostVoteRequest{Id: 1, Vote: &models.Vote{Nickname: "bob", Voice: 1}})
+	if code := status.Code(err); code != codes.PermissionDenied {
+		t.Fatalf("code = %s, want %s (%v)", code, codes.PermissionDenied, err)
+	}
+}
@@ -2,7 +2,15 @@ package service
 
 import (
 	"context"
+	"errors"
+	"log"
+	"strconv"
 
+	"github.com/storm5758/Forum-test/internal/app/attachment"
+	"github.com/storm5758/Forum-test/internal/app/auth"
+	internal_models "github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+	"github.com/storm5758/Forum-test/internal/pkg/markdown"
 	"github.com/storm5758/Forum-test/pkg/api"
 	"github.com/storm5758/Forum-test/pkg/api/models"
 	"google.golang.org/grpc/codes"
@@ -11,10 +19,24 @@ import (
 
 type postService struct {
 	api.UnimplementedPostServer
+	postRepository   repository.Post
+	threadRepository repository.Thread
+	userRepository   repository.User
+	forumRepository  repository.Forum
+	banRepository    repository.Ban
+	// reactions - эмодзи, которыми можно реагировать на сообщения, в порядке вывода
+	reactions []string
 }
 
-func NewPostService() api.PostServer {
-	return &postService{}
+func NewPostService(postRepository repository.Post, threadRepository repository.Thread, userRepository repository.User, forumRepository repository.Forum, banRepository repository.Ban, reactions []string) api.PostServer {
+	return &postService{
+		postRepository:   postRepository,
+		threadRepository: threadRepository,
+		userRepository:   userRepository,
+		forumRepository:  forumRepository,
+		banRepository:    banRepository,
+		reactions:        reactions,
+	}
 }
 
 // Создание новых постов
@@ -22,15 +44,121 @@ func NewPostService() api.PostServer {
 // Добавление новых постов в ветку обсуждения на форум.
 //
 // Все посты, созданные в рамках одного вызова данного метода должны иметь одинаковую дату создания (Post.Created).
-func (s *postService) PostsCreate(context.Context, *api.PostsCreateRequest) (*models.Post, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PostsCreate not implemented")
+func (s *postService) PostsCreate(ctx context.Context, req *api.PostsCreateRequest) (*api.PostsCreateResponse, error) {
+	thread, err := s.threadRepository.GetThreadBySlugOrID(ctx, req.GetSlugOrId())
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "thread not found")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	if thread.Status == internal_models.ThreadClosed || thread.Status == internal_models.ThreadArchived {
+		return nil, status.Error(codes.FailedPrecondition, "thread is not open")
+	}
+
+	nickname, err := actingUser(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	author, err := s.userRepository.GetUserByNickname(ctx, nickname)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "author not found")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+	shadow, err := checkBan(ctx, s.banRepository, thread.Forum)
+	if err != nil {
+		return nil, err
+	}
+
+	// все сообщения создаются от имени вызывающего пользователя
+	posts := make([]internal_models.Post, 0, len(req.GetPosts()))
+	for _, post := range req.GetPosts() {
+		if len(post.GetMessage()) == 0 {
+			return nil, status.Error(codes.InvalidArgument, "empty message")
+		}
+		if _, err := actingUser(ctx, post.GetAuthor()); err != nil {
+			return nil, err
+		}
+		attachments, err := postAttachments(post.GetAttachments())
+		if err != nil {
+			return nil, err
+		}
+		posts = append(posts, internal_models.Post{
+			Author:      author.Nickname,
+			Message:     post.GetMessage(),
+			MessageHTML: markdown.Render(post.GetMessage()),
+			Parent:      post.GetParent(),
+			Shadow:      shadow,
+			Attachments: attachments,
+		})
+	}
+
+	created, err := s.postRepository.CreatePosts(ctx, thread, posts)
+	if errors.Is(err, repository.ErrParentNotInThread) {
+		return nil, status.Error(codes.FailedPrecondition, "parent post is not in thread")
+	}
+	// состояние могло измениться после чтения ветки
+	if errors.Is(err, repository.ErrWrongState) {
+		return nil, status.Error(codes.FailedPrecondition, "thread is not open")
+	}
+	if errors.Is(err, repository.ErrInvalidAttachment) {
+		return nil, status.Error(codes.FailedPrecondition, "attachment not found or already attached")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	resp := &api.PostsCreateResponse{
+		Posts: make([]*models.Post, 0, len(created)),
+	}
+	for _, post := range created {
+		resp.Posts = append(resp.Posts, postToAPI(post))
+	}
+	return resp, nil
 }
 
 // Получение информации о ветке обсуждения
 //
 // Получение информации о ветке обсуждения по его имени.
-func (s *postService) PostGetOne(context.Context, *api.PostGetOneRequest) (*models.PostFull, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PostGetOne not implemented")
+func (s *postService) PostGetOne(ctx context.Context, req *api.PostGetOneRequest) (*models.PostFull, error) {
+	post, err := s.getPost(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	full := &models.PostFull{Post: postToAPI(post)}
+	for _, related := range req.GetRelated() {
+		switch related {
+		case api.PostGetOneRequest_RELATED_USER:
+			author, err := s.userRepository.GetUserByNickname(ctx, post.Author)
+			if err != nil {
+				log.Println(err)
+				return nil, status.Error(codes.Internal, codes.Internal.String())
+			}
+			full.Author = userToAPI(author)
+		case api.PostGetOneRequest_RELATED_FORUM:
+			forum, err := s.forumRepository.GetForumBySlug(ctx, post.Forum)
+			if err != nil {
+				log.Println(err)
+				return nil, status.Error(codes.Internal, codes.Internal.String())
+			}
+			full.Forum = forumToAPI(forum)
+		case api.PostGetOneRequest_RELATED_THREAD:
+			thread, err := s.threadRepository.GetThreadBySlugOrID(ctx, strconv.FormatInt(int64(post.Thread), 10))
+			if err != nil {
+				log.Println(err)
+				return nil, status.Error(codes.Internal, codes.Internal.String())
+			}
+			full.Thread = threadToAPI(thread)
+		}
+	}
+	return full, nil
 }
 
 // Изменение сообщения
@@ -41,3 +169,209 @@ func (s *postService) PostGetOne(context.Context, *api.PostGetOneRequest) (*mode
 func (s *postService) PostUpdate(context.Context, *api.PostUpdateRequest) (*models.Post, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PostUpdate not implemented")
 }
+
+// Удаление сообщения
+//
+// Сообщение заменяется заглушкой, ответы на него остаются в ветке.
+// Удалённое сообщение можно восстановить, пока оно не удалено окончательно
+// по истечении срока хранения.
+func (s *postService) PostDelete(ctx context.Context, req *api.PostDeleteRequest) (*models.Post, error) {
+	moderator, _ := auth.UserFromContext(ctx)
+
+	post, err := s.postRepository.DeletePost(ctx, req.GetId(), moderator, req.GetReason())
+	return moderatedPost(post, err, "post is already deleted")
+}
+
+// Восстановление сообщения
+//
+// Восстановление удалённого сообщения.
+func (s *postService) PostRestore(ctx context.Context, req *api.PostRestoreRequest) (*models.Post, error) {
+	moderator, _ := auth.UserFromContext(ctx)
+
+	post, err := s.postRepository.RestorePost(ctx, req.GetId(), moderator)
+	return moderatedPost(post, err, "post is not deleted")
+}
+
+// moderatedPost возвращает ответ на удаление или восстановление сообщения.
+func moderatedPost(post internal_models.Post, err error, wrongState string) (*models.Post, error) {
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "post not found")
+	}
+	if errors.Is(err, repository.ErrWrongState) {
+		return nil, status.Error(codes.FailedPrecondition, wrongState)
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+	return postToAPI(post), nil
+}
+
+// Проголосовать за сообщение
+//
+// Изменение голоса за сообщение.
+//
+// Один пользователь учитывается только один раз и может изменить своё
+// мнение.
+func (s *postService) PostVote(ctx context.Context, req *api.PostVoteRequest) (*models.Post, error) {
+	voice := req.GetVote().GetVoice()
+	if voice != 1 && voice != -1 {
+		return nil, status.Error(codes.InvalidArgument, "voice must be 1 or -1")
+	}
+
+	post, err := s.getPost(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if post.IsDeleted {
+		return nil, status.Error(codes.FailedPrecondition, "post is deleted")
+	}
+
+	nickname, err := actingUser(ctx, req.GetVote().GetNickname())
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.userRepository.GetUserByNickname(ctx, nickname)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	// голос при теневой блокировке не учитывается, но пользователь об этом не узнаёт
+	shadow, err := checkBan(ctx, s.banRepository, post.Forum)
+	if err != nil {
+		return nil, err
+	}
+	if shadow {
+		return postToAPI(post), nil
+	}
+
+	voted, err := s.postRepository.VotePost(ctx, post.Id, internal_models.Vote{
+		Nickname: user.Nickname,
+		Voice:    int(voice),
+	})
+	if errors.Is(err, repository.ErrWrongState) {
+		return nil, status.Error(codes.FailedPrecondition, "thread is archived")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+	return postToAPI(voted), nil
+}
+
+// Реакция на сообщение
+//
+// Добавление реакции пользователя на сообщение.
+func (s *postService) PostReact(ctx context.Context, req *api.PostReactRequest) (*models.Post, error) {
+	return s.react(ctx, req.GetId(), req.GetEmoji(), s.postRepository.AddPostReaction)
+}
+
+// Удаление реакции
+//
+// Удаление реакции пользователя на сообщение.
+func (s *postService) PostUnreact(ctx context.Context, req *api.PostUnreactRequest) (*models.Post, error) {
+	return s.react(ctx, req.GetId(), req.GetEmoji(), s.postRepository.RemovePostReaction)
+}
+
+// Доступные реакции
+//
+// Список эмодзи, которыми можно реагировать на сообщения.
+func (s *postService) ReactionList(context.Context, *api.ReactionListRequest) (*api.ReactionListResponse, error) {
+	return &api.ReactionListResponse{Emoji: s.reactions}, nil
+}
+
+// Предпросмотр сообщения
+//
+// Отрисовка сообщения из Markdown в HTML без создания сообщения.
+func (s *postService) PreviewMessage(_ context.Context, req *api.PreviewMessageRequest) (*api.PreviewMessageResponse, error) {
+	if len(req.GetMessage()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty message")
+	}
+	return &api.PreviewMessageResponse{MessageHtml: markdown.Render(req.GetMessage())}, nil
+}
+
+// react добавляет или удаляет реакцию пользователя из контекста методом change.
+func (s *postService) react(ctx context.Context, id int64, emoji string, change func(ctx context.Context, id int64, nickname, emoji string) (internal_models.Post, error)) (*models.Post, error) {
+	if !s.validReaction(emoji) {
+		return nil, status.Error(codes.InvalidArgument, "unknown reaction")
+	}
+	nickname, _ := auth.UserFromContext(ctx)
+
+	post, err := s.getPost(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if post.IsDeleted {
+		return nil, status.Error(codes.FailedPrecondition, "post is deleted")
+	}
+
+	// реакция при теневой блокировке не учитывается, как и голос за ветку
+	shadow, err := checkBan(ctx, s.banRepository, post.Forum)
+	if err != nil {
+		return nil, err
+	}
+	if shadow {
+		return postToAPI(post), nil
+	}
+
+	changed, err := change(ctx, post.Id, nickname, emoji)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, codes.NotFound.String())
+	}
+	if errors.Is(err, repository.ErrWrongState) {
+		return nil, status.Error(codes.FailedPrecondition, "thread is archived")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+	return postToAPI(changed), nil
+}
+
+func (s *postService) validReaction(emoji string) bool {
+	for _, reaction := range s.reactions {
+		if reaction == emoji {
+			return true
+		}
+	}
+	return false
+}
+
+// postAttachments возвращает файлы, прикрепляемые к создаваемому сообщению, по их id.
+func postAttachments(list []*models.Attachment) (internal_models.Attachments, error) {
+	if len(list) == 0 {
+		return nil, nil
+	}
+	if len(list) > attachment.MaxPerPost {
+		return nil, status.Errorf(codes.InvalidArgument, "too many attachments, max %d", attachment.MaxPerPost)
+	}
+	attachments := make(internal_models.Attachments, 0, len(list))
+	for _, a := range list {
+		if a.GetId() <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid attachment id")
+		}
+		attachments = append(attachments, internal_models.Attachment{Id: a.GetId()})
+	}
+	return attachments, nil
+}
+
+// getPost возвращает сообщение, видимое пользователю из контекста, ошибка уже приведена к статусу gRPC.
+func (s *postService) getPost(ctx context.Context, id int64) (internal_models.Post, error) {
+	post, err := s.postRepository.GetPostByID(ctx, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return internal_models.Post{}, status.Error(codes.NotFound, "post not found")
+	}
+	if err != nil {
+		log.Println(err)
+		return internal_models.Post{}, status.Error(codes.Internal, codes.Internal.String())
+	}
+	// сообщения, скрытые теневой блокировкой, видны только автору
+	if viewer, _ := auth.UserFromContext(ctx); post.Shadow && post.Author != viewer {
+		return internal_models.Post{}, status.Error(codes.NotFound, "post not found")
+	}
+	return post, nil
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/storm5758/Forum-test/pkg/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPreviewMessage(t *testing.T) {
+	s := NewPostService(nil, nil, nil, nil, nil, nil)
+
+	tests := []struct {
+		name    string
+		message string
+		want    string
+		code    codes.Code
+	}{
+		{"empty", "", "", codes.InvalidArgument},
+		{"markdown", "**a** [b](https://x.y)", `<p><strong>a</strong> <a href="https://x.y" rel="nofollow noopener noreferrer">b</a></p>`, codes.OK},
+		{"script", "<script>alert(1)</script>", "<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>", codes.OK},
+		{"javascript link", "[b](JavaScript:alert(1))", "<p>b</p>", codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.PreviewMessage(context.Background(), &api.PreviewMessageRequest{Message: tt.message})
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("code = %s, want %s (%v)", code, tt.code, err)
+			}
+			if got := resp.GetMessageHtml(); got != tt.want {
+				t.Errorf("message_html = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
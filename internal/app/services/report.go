@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/storm5758/Forum-test/internal/app/auth"
+	internal_models "github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+	"github.com/storm5758/Forum-test/internal/pkg/pagetoken"
+	"github.com/storm5758/Forum-test/pkg/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	reportQueueDefaultLimit = 20
+	reportQueueMaxLimit     = 100
+)
+
+var reportActions = map[api.ReportAction]internal_models.ReportAction{
+	api.ReportAction_REPORT_ACTION_DISMISS: internal_models.ReportDismiss,
+	api.ReportAction_REPORT_ACTION_DELETE:  internal_models.ReportDelete,
+	api.ReportAction_REPORT_ACTION_BAN:     internal_models.ReportBan,
+}
+
+type reportService struct {
+	api.UnimplementedReportServer
+	reportRepository repository.Report
+	postRepository   repository.Post
+	threadRepository repository.Thread
+	forumRepository  repository.Forum
+	pageTokens       *pagetoken.Codec
+}
+
+func NewReportService(reportRepository repository.Report, postRepository repository.Post, threadRepository repository.Thread, forumRepository repository.Forum, pageTokens *pagetoken.Codec) api.ReportServer {
+	return &reportService{
+		reportRepository: reportRepository,
+		postRepository:   postRepository,
+		threadRepository: threadRepository,
+		forumRepository:  forumRepository,
+		pageTokens:       pageTokens,
+	}
+}
+
+// Жалоба на сообщение
+//
+// Отметка сообщения как нарушающего правила форума.
+func (s *reportService) ReportPost(ctx context.Context, req *api.ReportPostRequest) (*api.ReportInfo, error) {
+	if len(req.GetReason()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty reason")
+	}
+	reporter, _ := auth.UserFromContext(ctx)
+
+	post, err := s.postRepository.GetPostByID(ctx, req.GetId())
+	// скрытые теневой блокировкой сообщения видны только автору
+	if errors.Is(err, repository.ErrNotFound) || err == nil && post.Shadow && post.Author != reporter {
+		return nil, status.Error(codes.NotFound, "post not found")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+	if post.IsDeleted {
+		return nil, status.Error(codes.FailedPrecondition, "post is deleted")
+	}
+
+	return s.createReport(ctx, internal_models.Report{
+		Forum:    post.Forum,
+		Thread:   post.Thread,
+		Post:     post.Id,
+		Reporter: reporter,
+		Reason:   req.GetReason(),
+	})
+}
+
+// Жалоба на ветку
+//
+// Отметка ветки обсуждения как нарушающей правила форума.
+func (s *reportService) ReportThread(ctx context.Context, req *api.ReportThreadRequest) (*api.ReportInfo, error) {
+	if len(req.GetSlugOrId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty slug_or_id")
+	}
+	if len(req.GetReason()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty reason")
+	}
+	reporter, _ := auth.UserFromContext(ctx)
+
+	thread, err := s.threadRepository.GetThreadBySlugOrID(ctx, req.GetSlugOrId())
+	if errors.Is(err, repository.ErrNotFound) || err == nil && thread.Shadow && thread.Author != reporter {
+		return nil, status.Error(codes.NotFound, "thread not found")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	return s.createReport(ctx, internal_models.Report{
+		Forum:    thread.Forum,
+		Thread:   thread.Id,
+		Reporter: reporter,
+		Reason:   req.GetReason(),
+	})
+}
+
+func (s *reportService) createReport(ctx context.Context, report internal_models.Report) (*api.ReportInfo, error) {
+	created, err := s.reportRepository.CreateReport(ctx, report)
+	if errors.Is(err, repository.ErrAlreadyExists) {
+		return nil, status.Error(codes.AlreadyExists, "already reported")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+	return reportToAPI(created), nil
+}
+
+// Очередь модерации
+//
+// Открытые жалобы форума, сгруппированные по сообщениям и веткам.
+func (s *reportService) ReportQueue(ctx context.Context, req *api.ReportQueueRequest) (*api.ReportQueueResponse, error) {
+	limit := req.GetLimit()
+	if limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "negative limit")
+	}
+	if limit == 0 {
+		limit = reportQueueDefaultLimit
+	}
+	if limit > reportQueueMaxLimit {
+		limit = reportQueueMaxLimit
+	}
+
+	forum, err := getForum(ctx, s.forumRepository, req.GetSlug())
+	if err != nil {
+		return nil, err
+	}
+
+	filter := internal_models.ReportQueueFilter{Limit: limit}
+	if token := req.GetPageToken(); len(token) != 0 {
+		cursor, err := s.pageTokens.DecodeFor(token, listReportQueue, forum.Slug)
+		if err != nil {
+			return nil, errInvalidPageToken
+		}
+		key, err := decodeSingleKey(cursor)
+		if err != nil {
+			return nil, err
+		}
+		after, err := strconv.ParseInt(key, 10, 32)
+		if err != nil {
+			return nil, errInvalidPageToken
+		}
+		filter.After = int32(after)
+	}
+
+	targets, err := s.reportRepository.GetReportQueue(ctx, forum.Slug, filter)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	resp := &api.ReportQueueResponse{
+		Targets: make([]*api.ReportTarget, 0, len(targets)),
+	}
+	for _, target := range targets {
+		resp.Targets = append(resp.Targets, reportTargetToAPI(target))
+	}
+	if hasNextPage(filter.Limit, len(targets)) {
+		last := targets[len(targets)-1]
+		resp.NextPageToken = s.pageTokens.Encode(pagetoken.Cursor{
+			List:  listReportQueue,
+			Scope: forum.Slug,
+			Key:   []string{strconv.FormatInt(int64(last.FirstReport), 10)},
+		})
+	}
+	return resp, nil
+}
+
+// Решение по жалобе
+//
+// Закрытие жалобы и остальных открытых жалоб на то же сообщение или ветку.
+func (s *reportService) ReportResolve(ctx context.Context, req *api.ReportResolveRequest) (*api.ReportResolution, error) {
+	action, ok := reportActions[req.GetAction()]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "unknown action")
+	}
+	resolve := internal_models.ReportResolve{
+		Action:    action,
+		Comment:   req.GetComment(),
+		BanShadow: req.GetBanShadow(),
+	}
+	resolve.Moderator, _ = auth.UserFromContext(ctx)
+
+	if expires := req.GetBanExpires(); len(expires) != 0 && action == internal_models.ReportBan {
+		t, err := time.Parse(time.RFC3339Nano, expires)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid ban_expires")
+		}
+		if !t.After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "ban_expires is in the past")
+		}
+		resolve.BanExpires = &expires
+	}
+
+	forum, err := getForum(ctx, s.forumRepository, req.GetSlug())
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.reportRepository.GetReport(ctx, forum.Slug, req.GetId())
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "report not found")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	resolution, err := s.reportRepository.ResolveReport(ctx, forum.Slug, report.Id, resolve)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "report not found")
+	}
+	if errors.Is(err, repository.ErrWrongState) {
+		return nil, status.Error(codes.FailedPrecondition, "report is already resolved")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+	return resolutionToAPI(resolution), nil
+}
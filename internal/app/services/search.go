@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	internal_models "github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+	"github.com/storm5758/Forum-test/internal/pkg/pagetoken"
+	"github.com/storm5758/Forum-test/pkg/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	searchDefaultLimit = 20
+	searchMaxLimit     = 100
+
+	listSearch = "search"
+)
+
+var searchLanguages = map[api.SearchRequest_Language]internal_models.SearchLanguage{
+	api.SearchRequest_LANGUAGE_RUSSIAN: internal_models.SearchRussian,
+	api.SearchRequest_LANGUAGE_ENGLISH: internal_models.SearchEnglish,
+}
+
+var searchKinds = map[internal_models.SearchKind]api.SearchResponse_Hit_Kind{
+	internal_models.SearchKindThread: api.SearchResponse_Hit_KIND_THREAD,
+	internal_models.SearchKindPost:   api.SearchResponse_Hit_KIND_POST,
+}
+
+type searchService struct {
+	api.UnimplementedSearchServer
+	searchRepository repository.Search
+	pageTokens       *pagetoken.Codec
+}
+
+func NewSearchService(searchRepository repository.Search, pageTokens *pagetoken.Codec) api.SearchServer {
+	return &searchService{
+		searchRepository: searchRepository,
+		pageTokens:       pageTokens,
+	}
+}
+
+// Поиск по форуму
+//
+// Полнотекстовый поиск по заголовкам и описаниям веток обсуждения и по сообщениям.
+//
+// Результаты выводятся отсортированные по релевантности.
+func (s *searchService) Search(ctx context.Context, req *api.SearchRequest) (*api.SearchResponse, error) {
+	if len(strings.TrimSpace(req.GetQuery())) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty query")
+	}
+	language, ok := searchLanguages[req.GetLanguage()]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "unknown language")
+	}
+	for _, date := range []string{req.GetSince(), req.GetUntil()} {
+		if len(date) == 0 {
+			continue
+		}
+		if _, err := time.Parse(time.RFC3339Nano, date); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid date")
+		}
+	}
+
+	limit := req.GetLimit()
+	switch {
+	case limit < 0:
+		return nil, status.Error(codes.InvalidArgument, "negative limit")
+	case limit == 0:
+		limit = searchDefaultLimit
+	case limit > searchMaxLimit:
+		limit = searchMaxLimit
+	}
+
+	target := req.GetTarget()
+	query := internal_models.SearchQuery{
+		Query:    req.GetQuery(),
+		Language: language,
+		Threads:  target == api.SearchRequest_TARGET_ALL || target == api.SearchRequest_TARGET_THREADS,
+		Posts:    target == api.SearchRequest_TARGET_ALL || target == api.SearchRequest_TARGET_POSTS,
+		Forum:    req.GetForum(),
+		Author:   req.GetAuthor(),
+		Since:    req.GetSince(),
+		Until:    req.GetUntil(),
+		Limit:    limit,
+	}
+
+	scope := searchScope(req)
+	if token := req.GetPageToken(); len(token) != 0 {
+		cursor, err := s.pageTokens.DecodeFor(token, listSearch, scope)
+		if err != nil {
+			return nil, errInvalidPageToken
+		}
+		if query.After, err = decodeSearchKey(cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	hits, err := s.searchRepository.Search(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	resp := &api.SearchResponse{
+		Hits: make([]*api.SearchResponse_Hit, 0, len(hits)),
+	}
+	for _, hit := range hits {
+		resp.Hits = append(resp.Hits, &api.SearchResponse_Hit{
+			Kind:    searchKinds[hit.Kind],
+			Id:      hit.Id,
+			Thread:  hit.Thread,
+			Forum:   hit.Forum,
+			Author:  hit.Author,
+			Created: hit.Created,
+			Title:   hit.Title,
+			Snippet: hit.Snippet,
+			Rank:    hit.Rank,
+		})
+	}
+	if hasNextPage(limit, len(hits)) {
+		last := hits[len(hits)-1]
+		resp.NextPageToken = s.pageTokens.Encode(pagetoken.Cursor{
+			List:  listSearch,
+			Scope: scope,
+			Key: []string{
+				strconv.FormatFloat(last.Rank, 'g', -1, 64),
+				string(last.Kind),
+				strconv.FormatInt(last.Id, 10),
+			},
+		})
+	}
+	return resp, nil
+}
+
+// searchScope связывает токен страницы с запросом и фильтрами, но не с лимитом.
+func searchScope(req *api.SearchRequest) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{
+		req.GetQuery(),
+		req.GetLanguage().String(),
+		req.GetTarget().String(),
+		strings.ToLower(req.GetForum()),
+		strings.ToLower(req.GetAuthor()),
+		req.GetSince(),
+		req.GetUntil(),
+	}, "\x00")))
+	return hex.EncodeToString(sum[:16])
+}
+
+// decodeSearchKey возвращает ключ результата поиска из токена страницы.
+func decodeSearchKey(cursor pagetoken.Cursor) (*internal_models.SearchKey, error) {
+	if len(cursor.Key) != 3 {
+		return nil, errInvalidPageToken
+	}
+	rank, err := strconv.ParseFloat(cursor.Key[0], 64)
+	if err != nil {
+		return nil, errInvalidPageToken
+	}
+	kind := internal_models.SearchKind(cursor.Key[1])
+	if _, ok := searchKinds[kind]; !ok {
+		return nil, errInvalidPageToken
+	}
+	id, err := strconv.ParseInt(cursor.Key[2], 10, 64)
+	if err != nil {
+		return nil, errInvalidPageToken
+	}
+	return &internal_models.SearchKey{Rank: rank, Kind: kind, Id: id}, nil
+}
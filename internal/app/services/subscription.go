@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	"github.com/storm5758/Forum-test/internal/app/auth"
+	"github.com/storm5758/Forum-test/internal/app/events"
+	internal_models "github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/pkg/database"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// subscribeBatch - сколько сообщений подписки читается из базы за раз
+	subscribeBatch = 100
+	// subscribeBuffer - сколько событий ветки ждут отправки подписчику
+	subscribeBuffer = 16
+)
+
+// threadSubscription - подписка на ветку, общая для потоков SubscribeThread и ThreadEvents:
+// выдаёт видимые пользователю сообщения после last по порядку и ждёт событий ветки.
+type threadSubscription struct {
+	ctx    context.Context
+	s      *threadService
+	sub    *events.Subscription
+	thread internal_models.Thread
+	viewer string
+	// last - id последнего выданного сообщения
+	last int64
+}
+
+// subscribeThread подписывается на ветку slugOrID и продолжает с сообщения last,
+// 0 - с последнего сообщения ветки. Подписку нужно закрыть Close.
+func (s *threadService) subscribeThread(ctx context.Context, slugOrID string, last int64) (*threadSubscription, error) {
+	// события приходят после фиксации на основном сервере, реплики могут отставать
+	ctx = database.WithPrimary(ctx)
+
+	thread, err := s.getThread(ctx, slugOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	// подписка до чтения последнего id, чтобы не пропустить сообщения между ними
+	ts := &threadSubscription{
+		ctx:    ctx,
+		s:      s,
+		sub:    s.events.Subscribe(thread.Id, subscribeBuffer),
+		thread: thread,
+		last:   last,
+	}
+	ts.viewer, _ = auth.UserFromContext(ctx)
+	if last == 0 {
+		if ts.last, err = s.lastPostID(ctx, thread.Id, ts.viewer); err != nil {
+			ts.Close()
+			return nil, err
+		}
+	}
+	return ts, nil
+}
+
+// Close отменяет подписку.
+func (ts *threadSubscription) Close() {
+	ts.sub.Close()
+}
+
+// forwardPosts передаёт в send все сообщения после last, пачками по subscribeBatch.
+// last сдвигается до передачи сообщения, поэтому send видит его id в ts.last.
+func (ts *threadSubscription) forwardPosts(send func(post internal_models.Post) error) error {
+	for {
+		posts, err := ts.s.threadRepository.GetThreadPosts(ts.ctx, ts.thread.Id, internal_models.PostFilter{
+			Limit:  subscribeBatch,
+			Since:  ts.last,
+			Sort:   internal_models.PostSortFlat,
+			Viewer: ts.viewer,
+		})
+		if err != nil {
+			log.Println(err)
+			return status.Error(codes.Internal, codes.Internal.String())
+		}
+		for _, post := range posts {
+			ts.last = post.Id
+			if err := send(post); err != nil {
+				return err
+			}
+		}
+		if len(posts) < subscribeBatch {
+			return nil
+		}
+	}
+}
+
+// wait ждёт следующего события ветки, ok = false после отмены контекста потока.
+func (ts *threadSubscription) wait() (e events.Event, ok bool) {
+	select {
+	case <-ts.ctx.Done():
+		return events.Event{}, false
+	case e = <-ts.sub.C:
+		return e, true
+	}
+}
+
+// lagged сообщает, что подписчик не успевал читать события и часть из них отброшена.
+func (ts *threadSubscription) lagged() bool {
+	return ts.sub.Lagged()
+}
+
+// lastPostID возвращает наибольший id видимого пользователю сообщения ветки или 0, если сообщений нет.
+func (s *threadService) lastPostID(ctx context.Context, thread int32, viewer string) (int64, error) {
+	posts, err := s.threadRepository.GetThreadPosts(ctx, thread, internal_models.PostFilter{
+		Limit:  1,
+		Desc:   true,
+		Sort:   internal_models.PostSortFlat,
+		Viewer: viewer,
+	})
+	if err != nil {
+		log.Println(err)
+		return 0, status.Error(codes.Internal, codes.Internal.String())
+	}
+	if len(posts) == 0 {
+		return 0, nil
+	}
+	return posts[0].Id, nil
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	internal_models "github.com/storm5758/Forum-test/internal/app/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// threadMaxTags - меток у одной ветки
+	threadMaxTags = 5
+	// forumMaxTags - меток в списке меток форума
+	forumMaxTags = 100
+	// tagMaxLength - длина метки в символах
+	tagMaxLength = 32
+
+	tagsDefaultLimit = 100
+	tagsMaxLimit     = 1000
+)
+
+// tagPattern - метка из букв, цифр и разделителей внутри, например "bug-report"
+var tagPattern = regexp.MustCompile(`^[\p{L}\p{N}]+([-_.+][\p{L}\p{N}]+)*$`)
+
+// normalizeTags проверяет метки и убирает повторы без учёта регистра, сохраняя написание первой метки.
+func normalizeTags(tags []string, max int) (internal_models.Tags, error) {
+	if len(tags) > max {
+		return nil, status.Errorf(codes.InvalidArgument, "too many tags, max %d", max)
+	}
+	normalized := make(internal_models.Tags, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if utf8.RuneCountInString(tag) > tagMaxLength || !tagPattern.MatchString(tag) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid tag %q", tag)
+		}
+		key := strings.ToLower(tag)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		normalized = append(normalized, tag)
+	}
+	return normalized, nil
+}
+
+// threadTags проверяет метки ветки форума forum. Если у форума задан список меток,
+// метки ветки выбираются из него и записываются в написании форума.
+func threadTags(tags []string, forum internal_models.Forum) (internal_models.Tags, error) {
+	normalized, err := normalizeTags(tags, threadMaxTags)
+	if err != nil || len(forum.Tags) == 0 {
+		return normalized, err
+	}
+
+	curated := make(map[string]string, len(forum.Tags))
+	for _, tag := range forum.Tags {
+		curated[strings.ToLower(tag)] = tag
+	}
+	for i, tag := range normalized {
+		canonical, ok := curated[strings.ToLower(tag)]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "tag %q is not allowed in forum", tag)
+		}
+		normalized[i] = canonical
+	}
+	return normalized, nil
+}
@@ -2,7 +2,16 @@ package service
 
 import (
 	"context"
+	"errors"
+	"log"
+	"strconv"
 
+	"github.com/storm5758/Forum-test/internal/app/auth"
+	"github.com/storm5758/Forum-test/internal/app/events"
+	internal_models "github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+	"github.com/storm5758/Forum-test/internal/pkg/markdown"
+	"github.com/storm5758/Forum-test/internal/pkg/pagetoken"
 	"github.com/storm5758/Forum-test/pkg/api"
 	"github.com/storm5758/Forum-test/pkg/api/models"
 	"google.golang.org/grpc/codes"
@@ -11,24 +20,102 @@ import (
 
 type threadService struct {
 	api.UnimplementedThreadServer
+	threadRepository repository.Thread
+	forumRepository  repository.Forum
+	userRepository   repository.User
+	postRepository   repository.Post
+	banRepository    repository.Ban
+	pageTokens       *pagetoken.Codec
+	events           *events.Hub
 }
 
-func NewThreadService() api.ThreadServer {
-	return &threadService{}
+func NewThreadService(threadRepository repository.Thread, forumRepository repository.Forum, userRepository repository.User, postRepository repository.Post, banRepository repository.Ban, pageTokens *pagetoken.Codec, hub *events.Hub) api.ThreadServer {
+	return &threadService{
+		threadRepository: threadRepository,
+		forumRepository:  forumRepository,
+		userRepository:   userRepository,
+		postRepository:   postRepository,
+		banRepository:    banRepository,
+		pageTokens:       pageTokens,
+		events:           hub,
+	}
 }
 
 // Создание ветки
 //
 // Добавление новой ветки обсуждения на форум.
-func (s *threadService) ThreadCreate(context.Context, *api.ThreadCreateRequest) (*models.Thread, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ThreadCreate not implemented")
+func (s *threadService) ThreadCreate(ctx context.Context, req *api.ThreadCreateRequest) (*models.Thread, error) {
+	thread := req.GetThread()
+	if len(thread.GetTitle()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty title")
+	}
+	if len(thread.GetMessage()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty message")
+	}
+
+	forum, err := s.forumRepository.GetForumBySlug(ctx, req.GetSlug())
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "forum not found")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	nickname, err := actingUser(ctx, thread.GetAuthor())
+	if err != nil {
+		return nil, err
+	}
+	author, err := s.userRepository.GetUserByNickname(ctx, nickname)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "author not found")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	shadow, err := checkBan(ctx, s.banRepository, forum.Slug)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := threadTags(thread.GetTags(), forum)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.threadRepository.CreateThread(ctx, internal_models.Thread{
+		Author:      author.Nickname,
+		Created:     thread.GetCreated(),
+		Forum:       forum.Slug,
+		Message:     thread.GetMessage(),
+		MessageHTML: markdown.Render(thread.GetMessage()),
+		Slug:        thread.GetSlug(),
+		Title:       thread.GetTitle(),
+		Shadow:      shadow,
+		Tags:        tags,
+	})
+	if errors.Is(err, repository.ErrAlreadyExists) {
+		return nil, status.Error(codes.AlreadyExists, codes.AlreadyExists.String())
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	return threadToAPI(created), nil
 }
 
 // Получение информации о ветке обсуждения
 //
 // Получение информации о ветке обсуждения по его имени.
-func (s *threadService) ThreadGetOne(context.Context, *api.ThreadGetOneRequest) (*models.Thread, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ThreadGetOne not implemented")
+func (s *threadService) ThreadGetOne(ctx context.Context, req *api.ThreadGetOneRequest) (*models.Thread, error) {
+	thread, err := s.getThread(ctx, req.GetSlugOrId())
+	if err != nil {
+		return nil, err
+	}
+	return threadToAPI(thread), nil
 }
 
 // Сообщения данной ветви обсуждения
@@ -36,15 +123,143 @@ func (s *threadService) ThreadGetOne(context.Context, *api.ThreadGetOneRequest)
 // Получение списка сообщений в данной ветке форуме.
 //
 // Сообщения выводятся отсортированные по дате создания.
-func (s *threadService) ThreadGetPosts(context.Context, *api.ThreadGetPostsRequest) (*models.Thread, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ThreadGetPosts not implemented")
+func (s *threadService) ThreadGetPosts(ctx context.Context, req *api.ThreadGetPostsRequest) (*api.ThreadGetPostsResponse, error) {
+	if req.GetLimit() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "negative limit")
+	}
+	sort, ok := postSorts[req.GetSort()]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "unknown sort")
+	}
+
+	thread, err := s.getThread(ctx, req.GetSlugOrId())
+	if err != nil {
+		return nil, err
+	}
+	scope := strconv.FormatInt(int64(thread.Id), 10)
+
+	filter := internal_models.PostFilter{
+		Limit: req.GetLimit(),
+		Since: req.GetSince(),
+		Desc:  req.GetDesc(),
+		Sort:  sort,
+	}
+	filter.Viewer, _ = auth.UserFromContext(ctx)
+	if token := req.GetPageToken(); len(token) != 0 {
+		cursor, err := s.pageTokens.DecodeFor(token, listThreadPosts, scope)
+		if err != nil {
+			return nil, errInvalidPageToken
+		}
+		filter.Sort, filter.Desc = internal_models.PostSort(cursor.Sort), cursor.Desc
+		if !validPostSort(filter.Sort) {
+			return nil, errInvalidPageToken
+		}
+		if filter.Sort == internal_models.PostSortTop {
+			filter.Since = 0
+			if filter.After, err = decodePostKey(cursor); err != nil {
+				return nil, err
+			}
+		} else {
+			key, err := decodeSingleKey(cursor)
+			if err != nil {
+				return nil, err
+			}
+			if filter.Since, err = strconv.ParseInt(key, 10, 64); err != nil {
+				return nil, errInvalidPageToken
+			}
+		}
+	}
+
+	posts, err := s.threadRepository.GetThreadPosts(ctx, thread.Id, filter)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	resp := &api.ThreadGetPostsResponse{
+		Posts: make([]*models.Post, 0, len(posts)),
+	}
+	// для parent_tree и top лимит считается по корневым сообщениям, а ключ - последний корень
+	byRoots := filter.Sort == internal_models.PostSortParentTree || filter.Sort == internal_models.PostSortTop
+	var (
+		count int
+		last  internal_models.Post
+	)
+	for _, post := range posts {
+		resp.Posts = append(resp.Posts, postToAPI(post))
+		if !byRoots || post.Parent == 0 {
+			count++
+			last = post
+		}
+	}
+	if hasNextPage(filter.Limit, count) {
+		key := []string{strconv.FormatInt(last.Id, 10)}
+		if filter.Sort == internal_models.PostSortTop {
+			key = []string{strconv.FormatInt(int64(last.Score), 10), strconv.FormatInt(last.Id, 10)}
+		}
+		resp.NextPageToken = s.pageTokens.Encode(pagetoken.Cursor{
+			List:  listThreadPosts,
+			Scope: scope,
+			Sort:  string(filter.Sort),
+			Desc:  filter.Desc,
+			Key:   key,
+		})
+	}
+	return resp, nil
 }
 
 // Обновление ветки
 //
 // Обновление ветки обсуждения на форуме.
-func (s *threadService) ThreadUpdate(context.Context, *api.ThreadUpdateRequest) (*models.Thread, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ThreadUpdate not implemented")
+func (s *threadService) ThreadUpdate(ctx context.Context, req *api.ThreadUpdateRequest) (*models.Thread, error) {
+	thread, err := s.getThread(ctx, req.GetSlugOrId())
+	if err != nil {
+		return nil, err
+	}
+
+	update := internal_models.ThreadUpdate{
+		Message: req.GetThread().GetMessage(),
+		Title:   req.GetThread().GetTitle(),
+	}
+	if len(update.Message) != 0 {
+		update.MessageHTML = markdown.Render(update.Message)
+	}
+	if update.Tags, err = s.updatedTags(ctx, thread, req.GetThread()); err != nil {
+		return nil, err
+	}
+	updated, err := s.threadRepository.UpdateThread(ctx, thread.Id, update)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, codes.NotFound.String())
+	}
+	if errors.Is(err, repository.ErrWrongState) {
+		return nil, status.Error(codes.FailedPrecondition, "thread is archived")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	return threadToAPI(updated), nil
+}
+
+// updatedTags возвращает новые метки ветки или nil, если метки не меняются.
+func (s *threadService) updatedTags(ctx context.Context, thread internal_models.Thread, update *api.ThreadUpdateRequest_ThreadUpdate) (internal_models.Tags, error) {
+	if update.GetClearTags() {
+		if len(update.GetTags()) != 0 {
+			return nil, status.Error(codes.InvalidArgument, "tags and clear_tags are mutually exclusive")
+		}
+		return internal_models.Tags{}, nil
+	}
+	if len(update.GetTags()) == 0 {
+		return nil, nil
+	}
+
+	forum, err := s.forumRepository.GetForumBySlug(ctx, thread.Forum)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+	return threadTags(update.GetTags(), forum)
 }
 
 // Проголосовать за ветвь обсуждения
@@ -53,6 +268,223 @@ func (s *threadService) ThreadUpdate(context.Context, *api.ThreadUpdateRequest)
 //
 // Один пользователь учитывается только один раз и может изменить своё
 // мнение.
-func (s *threadService) ThreadVote(context.Context, *api.ThreadVoteRequest) (*models.Thread, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ThreadVote not implemented")
+func (s *threadService) ThreadVote(ctx context.Context, req *api.ThreadVoteRequest) (*models.Thread, error) {
+	voice := req.GetVote().GetVoice()
+	if voice != 1 && voice != -1 {
+		return nil, status.Error(codes.InvalidArgument, "voice must be 1 or -1")
+	}
+
+	thread, err := s.getThread(ctx, req.GetSlugOrId())
+	if err != nil {
+		return nil, err
+	}
+
+	nickname, err := actingUser(ctx, req.GetVote().GetNickname())
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.userRepository.GetUserByNickname(ctx, nickname)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	// голос при теневой блокировке не учитывается, но пользователь об этом не узнаёт
+	shadow, err := checkBan(ctx, s.banRepository, thread.Forum)
+	if err != nil {
+		return nil, err
+	}
+	if shadow {
+		return threadToAPI(thread), nil
+	}
+
+	voted, err := s.threadRepository.VoteThread(ctx, thread.Id, internal_models.Vote{
+		Nickname: user.Nickname,
+		Voice:    int(voice),
+	})
+	if errors.Is(err, repository.ErrWrongState) {
+		return nil, status.Error(codes.FailedPrecondition, "thread is archived")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	return threadToAPI(voted), nil
+}
+
+// Изменение состояния ветки
+//
+// Закрытие ветки для новых сообщений, перенос в архив или открытие.
+// Ветка в архиве доступна только для чтения.
+func (s *threadService) ThreadSetStatus(ctx context.Context, req *api.ThreadSetStatusRequest) (*models.Thread, error) {
+	var threadStatus internal_models.ThreadStatus
+	for internalStatus, apiStatus := range threadStatuses {
+		if apiStatus == req.GetStatus() {
+			threadStatus = internalStatus
+		}
+	}
+	if len(threadStatus) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "unknown status")
+	}
+
+	thread, err := s.getThread(ctx, req.GetSlugOrId())
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.threadRepository.SetThreadStatus(ctx, thread.Id, threadStatus)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+	return threadToAPI(updated), nil
+}
+
+// Закрепление ветки
+//
+// Закреплённые ветки выводятся в списке веток форума первыми.
+func (s *threadService) ThreadPin(ctx context.Context, req *api.ThreadPinRequest) (*models.Thread, error) {
+	thread, err := s.getThread(ctx, req.GetSlugOrId())
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.threadRepository.PinThread(ctx, thread.Id, req.GetPinned())
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+	return threadToAPI(updated), nil
+}
+
+// getThread возвращает видимую пользователю ветку по slug или id, ошибка уже приведена к статусу gRPC.
+func (s *threadService) getThread(ctx context.Context, slugOrID string) (internal_models.Thread, error) {
+	if len(slugOrID) == 0 {
+		return internal_models.Thread{}, status.Error(codes.InvalidArgument, "empty slug_or_id")
+	}
+
+	thread, err := s.threadRepository.GetThreadBySlugOrID(ctx, slugOrID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return internal_models.Thread{}, status.Error(codes.NotFound, "thread not found")
+	}
+	if err != nil {
+		log.Println(err)
+		return internal_models.Thread{}, status.Error(codes.Internal, codes.Internal.String())
+	}
+	// ветки, скрытые теневой блокировкой, видны только автору
+	if viewer, _ := auth.UserFromContext(ctx); thread.Shadow && thread.Author != viewer {
+		return internal_models.Thread{}, status.Error(codes.NotFound, "thread not found")
+	}
+	return thread, nil
+}
+
+// Подписка на новые сообщения ветки
+//
+// Сообщения отправляются по мере создания, в порядке возрастания id.
+// Если указан last_post_id, сначала отправляются сообщения, созданные после него.
+func (s *threadService) SubscribeThread(req *api.SubscribeThreadRequest, stream api.Thread_SubscribeThreadServer) error {
+	ts, err := s.subscribeThread(stream.Context(), req.GetSlugOrId(), req.GetLastPostId())
+	if err != nil {
+		return err
+	}
+	defer ts.Close()
+
+	send := func(post internal_models.Post) error {
+		return stream.Send(postToAPI(post))
+	}
+	for {
+		if err := ts.forwardPosts(send); err != nil {
+			return err
+		}
+		// любое событие, в том числе после отброшенных, означает, что нужно перечитать сообщения после last
+		if _, ok := ts.wait(); !ok {
+			return nil
+		}
+	}
+}
+
+// События ветки
+//
+// Новые сообщения, изменения сообщений и рейтинга ветки.
+// Первым событием отправляется текущее состояние ветки.
+func (s *threadService) ThreadEvents(req *api.ThreadEventsRequest, stream api.Thread_ThreadEventsServer) error {
+	ts, err := s.subscribeThread(stream.Context(), req.GetSlugOrId(), req.GetLastEventId())
+	if err != nil {
+		return err
+	}
+	defer ts.Close()
+	ctx := ts.ctx
+
+	send := func(event *api.ThreadEvent) error {
+		event.Id = ts.last
+		return stream.Send(event)
+	}
+	// рейтинг берётся из базы, а не из кэша, который может быть сброшен только на другом экземпляре
+	sendThread := func() error {
+		current, err := s.threadRepository.GetThreadBySlugOrID(ctx, strconv.FormatInt(int64(ts.thread.Id), 10))
+		if err != nil {
+			log.Println(err)
+			return status.Error(codes.Internal, codes.Internal.String())
+		}
+		ts.thread = current
+		return send(&api.ThreadEvent{Event: &api.ThreadEvent_Thread{Thread: threadToAPI(ts.thread)}})
+	}
+	sendPosts := func() error {
+		return ts.forwardPosts(func(post internal_models.Post) error {
+			return send(&api.ThreadEvent{Event: &api.ThreadEvent_Post{Post: postToAPI(post)}})
+		})
+	}
+
+	if err := sendThread(); err != nil {
+		return err
+	}
+	if err := sendPosts(); err != nil {
+		return err
+	}
+
+	for {
+		e, ok := ts.wait()
+		if !ok {
+			return nil
+		}
+		// отброшенные изменения уже не восстановить, клиент продолжит подписку с last_event_id
+		if ts.lagged() {
+			return status.Error(codes.ResourceExhausted, "subscriber is too slow")
+		}
+
+		switch e.Kind {
+		case events.KindPosts:
+			err = sendPosts()
+		case events.KindPostEdited:
+			// новые сообщения отправятся целиком, изменения отправленных - отдельно
+			if e.Id > ts.last {
+				break
+			}
+			post, errGet := s.postRepository.GetPostByID(ctx, e.Id)
+			if errGet != nil {
+				log.Println(errGet)
+				return status.Error(codes.Internal, codes.Internal.String())
+			}
+			if post.Shadow && post.Author != ts.viewer {
+				break
+			}
+			err = send(&api.ThreadEvent{Event: &api.ThreadEvent_PostEdited{PostEdited: postToAPI(post)}})
+		case events.KindVotes:
+			ts.thread.Votes = e.Votes
+			err = send(&api.ThreadEvent{Event: &api.ThreadEvent_Thread{Thread: threadToAPI(ts.thread)}})
+		case events.KindThreadUpdated:
+			err = sendThread()
+		case events.KindResync:
+			if err = sendThread(); err == nil {
+				err = sendPosts()
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
 }
@@ -1,12 +1,15 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"log"
-	"sync"
 
+	"github.com/storm5758/Forum-test/internal/app/avatar"
 	"github.com/storm5758/Forum-test/internal/app/models"
 	"github.com/storm5758/Forum-test/internal/app/repository"
+	"github.com/storm5758/Forum-test/internal/pkg/storage"
 	"github.com/storm5758/Forum-test/pkg/api"
 	api_models "github.com/storm5758/Forum-test/pkg/api/models"
 	"google.golang.org/grpc/codes"
@@ -16,12 +19,14 @@ import (
 type UserService struct {
 	api.UnimplementedUserServer
 	userRepository repository.User
+	avatars        storage.Storage
 }
 
 // NewUserService return new instance of Implementation.
-func NewUserService(userRepository repository.User) *UserService {
+func NewUserService(userRepository repository.User, avatars storage.Storage) *UserService {
 	return &UserService{
 		userRepository: userRepository,
+		avatars:        avatars,
 	}
 }
 
@@ -60,17 +65,15 @@ func (s *UserService) UserCreate(ctx context.Context, req *api.UserCreateRequest
 	}
 
 	createdUser, err := s.userRepository.CreateUser(ctx, user)
+	if errors.Is(err, repository.ErrAlreadyExists) {
+		return nil, status.Error(codes.AlreadyExists, codes.AlreadyExists.String())
+	}
 	if err != nil {
 		log.Println(err)
 		return nil, status.Error(codes.Internal, codes.Internal.String())
 	}
 
-	return &api_models.User{
-		About:    createdUser.About,
-		Email:    createdUser.Email,
-		Fullname: createdUser.Fullname,
-		Nickname: createdUser.Nickname,
-	}, nil
+	return userToAPI(createdUser), nil
 }
 
 // Получение информации о пользователе
@@ -81,39 +84,123 @@ func (s *UserService) UserGetOne(ctx context.Context, req *api.UserGetOneRequest
 	if len(nikname) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "empty nickname")
 	}
-	user, err := s.userRepository.GetUsersByNicknameOrEmail(ctx, nikname, "")
-	if err != nil {
-		log.Println(err)
-		return nil, status.Error(codes.Internal, err.Error())
+	user, err := s.userRepository.GetUserByNickname(ctx, nikname)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, codes.NotFound.String())
 	}
-	if len(user) == 0 {
+	if err != nil {
 		log.Println(err)
-		return nil, status.Error(codes.NotFound, codes.NotFound.String())
+		return nil, status.Error(codes.Internal, codes.Internal.String())
 	}
-	return &api_models.User{
-		About:    user[0].About,
-		Email:    user[0].Email,
-		Fullname: user[0].Fullname,
-		Nickname: user[0].Nickname,
-	}, nil
+	return userToAPI(user), nil
 }
 
 // Изменение данных о пользователе
 //
 // Изменение информации в профиле пользователя.
 func (s *UserService) UserUpdate(ctx context.Context, req *api.UserUpdateRequest) (*api_models.User, error) {
-	var wg sync.WaitGroup
-
-	for i := 0; i < 5; i++ {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			_, err := s.userRepository.GetUsersByNicknameOrEmail(ctx, req.Nickname, req.Nickname)
-			if err != nil {
-				return
-			}
-		}()
-	}
-	wg.Wait()
-	return nil, status.Errorf(codes.Unimplemented, "method UserUpdate not implemented")
+	nikname := req.GetNickname()
+	if len(nikname) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty nickname")
+	}
+	profile := req.GetProfile()
+
+	user, err := s.userRepository.UpdateUser(ctx, nikname, models.UserUpdate{
+		About:    profile.GetAbout(),
+		Email:    profile.GetEmail(),
+		Fullname: profile.GetFullname(),
+	})
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, codes.NotFound.String())
+	}
+	if errors.Is(err, repository.ErrAlreadyExists) {
+		return nil, status.Error(codes.AlreadyExists, "email is already used")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+	return userToAPI(user), nil
+}
+
+// Загрузка аватара
+//
+// Изображение обрезается до квадрата и сохраняется в стандартных размерах.
+func (s *UserService) UserAvatarUpload(ctx context.Context, req *api.UserAvatarUploadRequest) (*api_models.User, error) {
+	if len(req.GetImage()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty image")
+	}
+	user, err := s.getUser(ctx, req.GetNickname())
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := avatar.Resize(req.GetImage())
+	if errors.Is(err, avatar.ErrTooLarge) || errors.Is(err, avatar.ErrUnsupportedFormat) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	key := avatar.Key(user.Nickname, req.GetImage())
+	for size, file := range files {
+		if err := s.avatars.Put(ctx, avatar.FileKey(key, size), bytes.NewReader(file)); err != nil {
+			log.Println(err)
+			return nil, status.Error(codes.Internal, codes.Internal.String())
+		}
+	}
+
+	updated, err := s.userRepository.SetUserAvatar(ctx, user.Nickname, key)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+	if len(user.Avatar) != 0 && user.Avatar != key {
+		s.deleteAvatar(ctx, user.Avatar)
+	}
+	return userToAPI(updated), nil
+}
+
+// Удаление аватара
+func (s *UserService) UserAvatarDelete(ctx context.Context, req *api.UserAvatarDeleteRequest) (*api_models.User, error) {
+	user, err := s.getUser(ctx, req.GetNickname())
+	if err != nil {
+		return nil, err
+	}
+	if len(user.Avatar) == 0 {
+		return userToAPI(user), nil
+	}
+
+	updated, err := s.userRepository.SetUserAvatar(ctx, user.Nickname, "")
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+	s.deleteAvatar(ctx, user.Avatar)
+	return userToAPI(updated), nil
+}
+
+func (s *UserService) getUser(ctx context.Context, nickname string) (models.User, error) {
+	if len(nickname) == 0 {
+		return models.User{}, status.Error(codes.InvalidArgument, "empty nickname")
+	}
+	user, err := s.userRepository.GetUserByNickname(ctx, nickname)
+	if errors.Is(err, repository.ErrNotFound) {
+		return models.User{}, status.Error(codes.NotFound, codes.NotFound.String())
+	}
+	if err != nil {
+		log.Println(err)
+		return models.User{}, status.Error(codes.Internal, codes.Internal.String())
+	}
+	return user, nil
+}
+
+// deleteAvatar удаляет файлы прежнего аватара. Пользователь уже не ссылается на них,
+// поэтому ошибка удаления только оставляет лишние файлы в хранилище.
+func (s *UserService) deleteAvatar(ctx context.Context, key string) {
+	if err := s.avatars.Delete(ctx, avatar.FileKeys(key)...); err != nil {
+		log.Println(err)
+	}
 }
@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"log"
+
+	internal_models "github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+	"github.com/storm5758/Forum-test/internal/app/webhook"
+	"github.com/storm5758/Forum-test/pkg/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	deliveriesDefaultLimit = 20
+	deliveriesMaxLimit     = 100
+
+	webhookSecretBytes = 32
+)
+
+var webhookEvents = map[api.WebhookEvent]internal_models.EventType{
+	api.WebhookEvent_WEBHOOK_EVENT_THREAD_CREATED: internal_models.EventThreadCreated,
+	api.WebhookEvent_WEBHOOK_EVENT_POSTS_CREATED:  internal_models.EventPostsCreated,
+	api.WebhookEvent_WEBHOOK_EVENT_THREAD_VOTED:   internal_models.EventThreadVoted,
+}
+
+var deliveryFilters = map[api.WebhookDeliveriesRequest_Filter]internal_models.DeliveryStatus{
+	api.WebhookDeliveriesRequest_FILTER_ALL:       "",
+	api.WebhookDeliveriesRequest_FILTER_PENDING:   internal_models.DeliveryPending,
+	api.WebhookDeliveriesRequest_FILTER_DELIVERED: internal_models.DeliveryDelivered,
+	api.WebhookDeliveriesRequest_FILTER_DEAD:      internal_models.DeliveryDead,
+}
+
+var deliveryStatuses = map[internal_models.DeliveryStatus]api.WebhookDelivery_Status{
+	internal_models.DeliveryPending:   api.WebhookDelivery_STATUS_PENDING,
+	internal_models.DeliveryDelivered: api.WebhookDelivery_STATUS_DELIVERED,
+	internal_models.DeliveryDead:      api.WebhookDelivery_STATUS_DEAD,
+}
+
+type webhookService struct {
+	api.UnimplementedWebhookServer
+	webhookRepository repository.Webhook
+	forumRepository   repository.Forum
+}
+
+func NewWebhookService(webhookRepository repository.Webhook, forumRepository repository.Forum) api.WebhookServer {
+	return &webhookService{
+		webhookRepository: webhookRepository,
+		forumRepository:   forumRepository,
+	}
+}
+
+// Регистрация веб-хука
+//
+// Регистрация адреса, на который POST-запросами отправляются события форума.
+func (s *webhookService) WebhookCreate(ctx context.Context, req *api.WebhookCreateRequest) (*api.WebhookCreateResponse, error) {
+	// адрес проверяется и при каждой доставке, здесь - чтобы сразу сообщить владельцу форума
+	if err := webhook.CheckURL(ctx, req.GetUrl()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	forum, err := getForum(ctx, s.forumRepository, req.GetSlug())
+	if err != nil {
+		return nil, err
+	}
+
+	webhook := internal_models.Webhook{
+		Forum:  forum.Slug,
+		Url:    req.GetUrl(),
+		Secret: req.GetSecret(),
+		Events: make([]internal_models.EventType, 0, len(req.GetEvents())),
+	}
+	for _, event := range req.GetEvents() {
+		eventType, ok := webhookEvents[event]
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "unknown event")
+		}
+		webhook.Events = append(webhook.Events, eventType)
+	}
+	if len(webhook.Secret) == 0 {
+		secret := make([]byte, webhookSecretBytes)
+		if _, err := rand.Read(secret); err != nil {
+			log.Println(err)
+			return nil, status.Error(codes.Internal, codes.Internal.String())
+		}
+		webhook.Secret = hex.EncodeToString(secret)
+	}
+
+	created, err := s.webhookRepository.CreateWebhook(ctx, webhook)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	return &api.WebhookCreateResponse{
+		Webhook: webhookToAPI(created),
+		Secret:  created.Secret,
+	}, nil
+}
+
+// Веб-хуки форума
+//
+// Получение списка веб-хуков форума без секретов.
+func (s *webhookService) WebhookList(ctx context.Context, req *api.WebhookListRequest) (*api.WebhookListResponse, error) {
+	forum, err := getForum(ctx, s.forumRepository, req.GetSlug())
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks, err := s.webhookRepository.GetForumWebhooks(ctx, forum.Slug)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	resp := &api.WebhookListResponse{
+		Webhooks: make([]*api.WebhookInfo, 0, len(webhooks)),
+	}
+	for _, webhook := range webhooks {
+		resp.Webhooks = append(resp.Webhooks, webhookToAPI(webhook))
+	}
+	return resp, nil
+}
+
+// Удаление веб-хука
+//
+// Удаление веб-хука вместе с историей его доставок.
+func (s *webhookService) WebhookDelete(ctx context.Context, req *api.WebhookDeleteRequest) (*emptypb.Empty, error) {
+	forum, err := getForum(ctx, s.forumRepository, req.GetSlug())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.webhookRepository.DeleteWebhook(ctx, forum.Slug, req.GetId())
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "webhook not found")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// Доставки веб-хука
+//
+// Получение последних доставок веб-хука, в том числе недоставленных событий.
+func (s *webhookService) WebhookDeliveries(ctx context.Context, req *api.WebhookDeliveriesRequest) (*api.WebhookDeliveriesResponse, error) {
+	filter, ok := deliveryFilters[req.GetFilter()]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "unknown filter")
+	}
+	limit := req.GetLimit()
+	switch {
+	case limit < 0:
+		return nil, status.Error(codes.InvalidArgument, "negative limit")
+	case limit == 0:
+		limit = deliveriesDefaultLimit
+	case limit > deliveriesMaxLimit:
+		limit = deliveriesMaxLimit
+	}
+
+	webhook, err := s.getWebhook(ctx, req.GetSlug(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := s.webhookRepository.GetWebhookDeliveries(ctx, webhook.Id, internal_models.DeliveryFilter{
+		Status: filter,
+		Limit:  limit,
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	resp := &api.WebhookDeliveriesResponse{
+		Deliveries: make([]*api.WebhookDelivery, 0, len(deliveries)),
+	}
+	for _, delivery := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, deliveryToAPI(delivery))
+	}
+	return resp, nil
+}
+
+// Повторная доставка
+//
+// Повторная отправка события, доставка которого завершена, например недоставленного.
+func (s *webhookService) WebhookRedeliver(ctx context.Context, req *api.WebhookRedeliverRequest) (*api.WebhookDelivery, error) {
+	webhook, err := s.getWebhook(ctx, req.GetSlug(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := s.webhookRepository.RedeliverWebhookDelivery(ctx, webhook.Id, req.GetDelivery())
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "completed delivery not found")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Error(codes.Internal, codes.Internal.String())
+	}
+	return deliveryToAPI(delivery), nil
+}
+
+func (s *webhookService) getWebhook(ctx context.Context, slug string, id int32) (internal_models.Webhook, error) {
+	forum, err := getForum(ctx, s.forumRepository, slug)
+	if err != nil {
+		return internal_models.Webhook{}, err
+	}
+
+	webhook, err := s.webhookRepository.GetWebhook(ctx, forum.Slug, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return internal_models.Webhook{}, status.Error(codes.NotFound, "webhook not found")
+	}
+	if err != nil {
+		log.Println(err)
+		return internal_models.Webhook{}, status.Error(codes.Internal, codes.Internal.String())
+	}
+	return webhook, nil
+}
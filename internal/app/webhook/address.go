@@ -0,0 +1,82 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// ErrForbiddenAddress возвращается для адреса веб-хука во внутренней сети или на самом сервере.
+var ErrForbiddenAddress = errors.New("webhook address is not public")
+
+// ErrInvalidURL возвращается для адреса веб-хука, который не является URL http или https.
+var ErrInvalidURL = errors.New("invalid webhook url")
+
+// CheckURL проверяет адрес веб-хука при регистрации: URL http или https,
+// все адреса хоста публичные. Хост может сменить адреса позже, поэтому
+// Dispatcher повторяет проверку при каждом подключении.
+func CheckURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Hostname()) == 0 {
+		return ErrInvalidURL
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, u.Hostname())
+	if err != nil {
+		return ErrInvalidURL
+	}
+	for _, addr := range addrs {
+		if !publicIP(addr.IP) {
+			return ErrForbiddenAddress
+		}
+	}
+	return nil
+}
+
+// publicIP сообщает, что ip не относится к адресам самого сервера, частных сетей,
+// link-local или групповых рассылок. Адреса IPv4 в IPv6 проверяются как IPv4.
+func publicIP(ip net.IP) bool {
+	return ip != nil &&
+		!ip.IsUnspecified() &&
+		!ip.IsLoopback() &&
+		!ip.IsPrivate() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast()
+}
+
+// newClient возвращает HTTP клиент доставок. Адрес проверяется после разрешения имени,
+// непосредственно перед подключением, так что имя нельзя перенаправить на внутренний адрес
+// после регистрации. Перенаправления не выполняются, прокси из окружения не используется.
+func newClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: RequestTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if !publicIP(net.ParseIP(host)) {
+				return ErrForbiddenAddress
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: RequestTimeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: RequestTimeout,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
@@ -0,0 +1,205 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/storm5758/Forum-test/internal/app/models"
+	"github.com/storm5758/Forum-test/internal/app/outbox"
+	"github.com/storm5758/Forum-test/internal/app/repository"
+)
+
+const (
+	// MaxAttempts - попыток доставки, после которых доставка попадает в список недоставленных
+	MaxAttempts = 8
+	// MinBackoff - пауза перед второй попыткой, далее она удваивается до MaxBackoff
+	MinBackoff = 10 * time.Second
+	MaxBackoff = time.Hour
+
+	// RequestTimeout - время ожидания ответа веб-хука
+	RequestTimeout = 10 * time.Second
+
+	dispatchBatch    = 32
+	dispatchInterval = time.Second
+	// dispatchLease - время, на которое доставка берётся на отправку, больше RequestTimeout
+	dispatchLease = 2 * RequestTimeout
+	// maxDrainLength - сколько байт ответа дочитывается, чтобы переиспользовать соединение
+	maxDrainLength = 4 << 10
+)
+
+// Enqueue возвращает обработчик шины outbox, который ставит события в очередь доставки.
+// Доставки добавляются в транзакции публикации события, поэтому не теряются и не дублируются.
+// События веток и сообщений, скрытых теневой блокировкой, не доставляются.
+func Enqueue(webhookRepository repository.Webhook) outbox.Handler {
+	return func(ctx context.Context, event models.Event) error {
+		if len(event.Forum) == 0 {
+			return nil
+		}
+		shadow, err := shadowEvent(event)
+		if err != nil || shadow {
+			return err
+		}
+		return webhookRepository.AddWebhookDeliveries(ctx, event)
+	}
+}
+
+// shadowEvent сообщает, что событие относится к ветке или сообщениям, скрытым теневой блокировкой.
+func shadowEvent(event models.Event) (bool, error) {
+	switch event.Type {
+	case models.EventThreadCreated:
+		var thread models.Thread
+		if err := json.Unmarshal(event.Payload, &thread); err != nil {
+			return false, errors.Wrap(err, "webhook: thread payload")
+		}
+		return thread.Shadow, nil
+
+	case models.EventPostsCreated:
+		var posts []models.Post
+		if err := json.Unmarshal(event.Payload, &posts); err != nil {
+			return false, errors.Wrap(err, "webhook: posts payload")
+		}
+		// скрытые сообщения публикуются отдельным событием, общее событие тоже не должно их раскрыть
+		for _, post := range posts {
+			if post.Shadow {
+				return true, nil
+			}
+		}
+		return false, nil
+
+	case models.EventThreadVoted:
+		var vote models.ThreadVote
+		if err := json.Unmarshal(event.Payload, &vote); err != nil {
+			return false, errors.Wrap(err, "webhook: vote payload")
+		}
+		return vote.Shadow, nil
+	}
+	return false, nil
+}
+
+// Dispatcher отправляет доставки веб-хуков.
+// Несколько экземпляров сервера могут отправлять доставки одновременно, каждая доставка берётся одним из них.
+type Dispatcher struct {
+	webhookRepository repository.Webhook
+	client            *http.Client
+}
+
+func NewDispatcher(webhookRepository repository.Webhook) *Dispatcher {
+	return &Dispatcher{
+		webhookRepository: webhookRepository,
+		client:            newClient(),
+	}
+}
+
+// Run отправляет доставки до отмены ctx.
+func (d *Dispatcher) Run(ctx context.Context) {
+	for {
+		deliveries, err := d.webhookRepository.ClaimWebhookDeliveries(ctx, dispatchBatch, dispatchLease)
+		if err != nil && ctx.Err() == nil {
+			log.Println("webhook:", err)
+		}
+
+		var wg sync.WaitGroup
+		for _, delivery := range deliveries {
+			wg.Add(1)
+			go func(delivery models.PendingDelivery) {
+				defer wg.Done()
+				result := d.deliver(ctx, delivery)
+				if err := d.webhookRepository.CompleteWebhookDelivery(ctx, delivery.Id, result); err != nil {
+					log.Println("webhook:", err)
+				}
+			}(delivery)
+		}
+		wg.Wait()
+
+		if len(deliveries) == dispatchBatch {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(dispatchInterval):
+		}
+	}
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, delivery models.PendingDelivery) models.DeliveryResult {
+	result := d.send(ctx, delivery)
+	if !result.Delivered && delivery.Attempts < MaxAttempts {
+		result.RetryIn = Backoff(delivery.Attempts)
+	}
+	return result
+}
+
+func (d *Dispatcher) send(ctx context.Context, delivery models.PendingDelivery) models.DeliveryResult {
+	body, err := json.Marshal(delivery.Event)
+	if err != nil {
+		return models.DeliveryResult{Error: err.Error()}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Url, bytes.NewReader(body))
+	if err != nil {
+		return models.DeliveryResult{Error: "invalid url"}
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, string(delivery.Event.Type))
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(delivery.Id, 10))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(delivery.Secret, timestamp, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return models.DeliveryResult{Error: errorClass(err)}
+	}
+	defer resp.Body.Close()
+	// соединение переиспользуется, только если ответ дочитан
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrainLength))
+
+	result := models.DeliveryResult{
+		Delivered:    resp.StatusCode >= 200 && resp.StatusCode < 300,
+		ResponseCode: int32(resp.StatusCode),
+	}
+	// тело и текст статуса ответа не сохраняются: их видит владелец форума,
+	// а для недоступного ему адреса они раскрыли бы чужой ответ
+	if !result.Delivered {
+		result.Error = fmt.Sprintf("unexpected status %d", resp.StatusCode)
+	}
+	return result
+}
+
+// errorClass возвращает вид ошибки запроса без подробностей о сети получателя.
+func errorClass(err error) string {
+	var netErr net.Error
+	switch {
+	case errors.Is(err, ErrForbiddenAddress):
+		return ErrForbiddenAddress.Error()
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return "timeout"
+	default:
+		return "connection failed"
+	}
+}
+
+// Backoff возвращает паузу после неудачной попытки attempt (начиная с 1).
+func Backoff(attempt int32) time.Duration {
+	backoff := MinBackoff
+	for i := int32(1); i < attempt && backoff < MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > MaxBackoff {
+		backoff = MaxBackoff
+	}
+	return backoff
+}
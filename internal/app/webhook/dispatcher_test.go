@@ -0,0 +1,233 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/storm5758/Forum-test/internal/app/models"
+	mock_repository "github.com/storm5758/Forum-test/internal/app/repository/mocks"
+)
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempt int32
+		want    time.Duration
+	}{
+		{0, MinBackoff},
+		{1, MinBackoff},
+		{2, 2 * MinBackoff},
+		{3, 4 * MinBackoff},
+		{9, 256 * MinBackoff},
+		{10, MaxBackoff},
+		{MaxAttempts * 100, MaxBackoff},
+	}
+	for _, tt := range tests {
+		if got := Backoff(tt.attempt); got != tt.want {
+			t.Errorf("Backoff(%d) = %s, want %s", tt.attempt, got, tt.want)
+		}
+	}
+}
+
+// receiver - веб-хук, проверяющий подпись и отвечающий кодом status.
+type receiver struct {
+	t      *testing.T
+	status int32
+	hits   int32
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	atomic.AddInt32(&r.hits, 1)
+	body, _ := io.ReadAll(req.Body)
+	if !Verify("secret", req.Header.Get(HeaderTimestamp), req.Header.Get(HeaderSignature), body, time.Minute) {
+		r.t.Errorf("request signature does not verify")
+	}
+	if got := req.Header.Get(HeaderEvent); got != string(models.EventThreadCreated) {
+		r.t.Errorf("%s = %q", HeaderEvent, got)
+	}
+	var event models.Event
+	if err := json.Unmarshal(body, &event); err != nil || event.Id != 7 {
+		r.t.Errorf("body = %s, %v", body, err)
+	}
+	w.WriteHeader(int(atomic.LoadInt32(&r.status)))
+	io.WriteString(w, "internal details")
+}
+
+func newTestDispatcher(t *testing.T, repo *mock_repository.MockWebhook) (*Dispatcher, *receiver, string) {
+	r := &receiver{t: t, status: http.StatusOK}
+	srv := httptest.NewServer(r)
+	t.Cleanup(srv.Close)
+
+	d := NewDispatcher(repo)
+	// тестовый сервер слушает loopback, который запрещён клиентом доставок
+	d.client = srv.Client()
+	d.client.CheckRedirect = newClient().CheckRedirect
+	return d, r, srv.URL
+}
+
+func pendingDelivery(url string, attempts int32) models.PendingDelivery {
+	return models.PendingDelivery{
+		Id:       1,
+		Attempts: attempts,
+		Url:      url,
+		Secret:   "secret",
+		Event:    models.Event{Id: 7, Type: models.EventThreadCreated, Forum: "pirate", Payload: json.RawMessage(`{}`)},
+	}
+}
+
+func TestDeliver(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int32
+		attempts int32
+		want     models.DeliveryResult
+	}{
+		{"delivered", http.StatusNoContent, 1, models.DeliveryResult{Delivered: true, ResponseCode: http.StatusNoContent}},
+		{"retry", http.StatusInternalServerError, 1,
+			models.DeliveryResult{ResponseCode: http.StatusInternalServerError, Error: "unexpected status 500", RetryIn: MinBackoff}},
+		{"retry later", http.StatusBadGateway, 3,
+			models.DeliveryResult{ResponseCode: http.StatusBadGateway, Error: "unexpected status 502", RetryIn: Backoff(3)}},
+		{"give up", http.StatusInternalServerError, MaxAttempts,
+			models.DeliveryResult{ResponseCode: http.StatusInternalServerError, Error: "unexpected status 500"}},
+		{"redirect", http.StatusFound, 1,
+			models.DeliveryResult{ResponseCode: http.StatusFound, Error: "unexpected status 302", RetryIn: MinBackoff}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, r, url := newTestDispatcher(t, nil)
+			r.status = tt.status
+
+			got := d.deliver(context.Background(), pendingDelivery(url, tt.attempts))
+			if got != tt.want {
+				t.Errorf("deliver() = %+v, want %+v", got, tt.want)
+			}
+			if hits := atomic.LoadInt32(&r.hits); hits != 1 {
+				t.Errorf("hits = %d, want 1", hits)
+			}
+		})
+	}
+}
+
+func TestDeliverForbiddenAddress(t *testing.T) {
+	r := &receiver{t: t, status: http.StatusOK}
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	got := NewDispatcher(nil).deliver(context.Background(), pendingDelivery(srv.URL, 1))
+	want := models.DeliveryResult{Error: ErrForbiddenAddress.Error(), RetryIn: MinBackoff}
+	if got != want {
+		t.Errorf("deliver() = %+v, want %+v", got, want)
+	}
+	if hits := atomic.LoadInt32(&r.hits); hits != 0 {
+		t.Errorf("loopback webhook received %d requests", hits)
+	}
+}
+
+func TestDispatcherRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	repo := mock_repository.NewMockWebhook(ctrl)
+	d, r, url := newTestDispatcher(t, repo)
+	r.status = http.StatusServiceUnavailable
+
+	// первая выборка - доставка, исчерпавшая попытки, вторая - пустая, после неё Run останавливается
+	gomock.InOrder(
+		repo.EXPECT().ClaimWebhookDeliveries(gomock.Any(), dispatchBatch, dispatchLease).
+			Return([]models.PendingDelivery{pendingDelivery(url, MaxAttempts)}, nil),
+		repo.EXPECT().CompleteWebhookDelivery(gomock.Any(), int64(1), models.DeliveryResult{
+			ResponseCode: http.StatusServiceUnavailable,
+			Error:        "unexpected status 503",
+		}).Return(nil),
+		repo.EXPECT().ClaimWebhookDeliveries(gomock.Any(), dispatchBatch, dispatchLease).
+			DoAndReturn(func(context.Context, int, time.Duration) ([]models.PendingDelivery, error) {
+				cancel()
+				return nil, nil
+			}),
+	)
+
+	done := make(chan struct{})
+	go func() {
+		d.Run(ctx)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not stop after ctx cancel")
+	}
+}
+
+func TestCheckURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want error
+	}{
+		{"https://93.184.216.34/hook", nil},
+		{"http://[2606:2800:220:1:248:1893:25c8:1946]:8080/hook", nil},
+		{"ftp://93.184.216.34/hook", ErrInvalidURL},
+		{"/hook", ErrInvalidURL},
+		{"http://", ErrInvalidURL},
+		{"http://127.0.0.1/hook", ErrForbiddenAddress},
+		{"http://localhost:8080/hook", ErrForbiddenAddress},
+		{"http://[::1]/hook", ErrForbiddenAddress},
+		{"http://[::ffff:10.0.0.1]/hook", ErrForbiddenAddress},
+		{"http://0.0.0.0/hook", ErrForbiddenAddress},
+		{"http://10.1.2.3/hook", ErrForbiddenAddress},
+		{"http://172.16.0.1/hook", ErrForbiddenAddress},
+		{"http://192.168.1.1/hook", ErrForbiddenAddress},
+		{"http://169.254.169.254/latest/meta-data", ErrForbiddenAddress},
+		{"http://[fe80::1]/hook", ErrForbiddenAddress},
+		{"http://[fd00::1]/hook", ErrForbiddenAddress},
+	}
+	for _, tt := range tests {
+		if err := CheckURL(context.Background(), tt.url); err != tt.want {
+			t.Errorf("CheckURL(%s) = %v, want %v", tt.url, err, tt.want)
+		}
+	}
+}
+
+func TestErrorClassHidesDetails(t *testing.T) {
+	d := &Dispatcher{client: newClient()}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	got := d.send(ctx, pendingDelivery("http://93.184.216.34/hook", 1))
+	if got.Error != "canceled" || strings.Contains(got.Error, "93.184.216.34") {
+		t.Errorf("send() error = %q, want %q", got.Error, "canceled")
+	}
+}
+
+func TestEnqueueSkipsShadow(t *testing.T) {
+	tests := []struct {
+		name    string
+		event   models.Event
+		enqueue bool
+	}{
+		{"thread", models.Event{Forum: "pirate", Type: models.EventThreadCreated, Payload: json.RawMessage(`{"id":1}`)}, true},
+		{"shadow thread", models.Event{Forum: "pirate", Type: models.EventThreadCreated, Payload: json.RawMessage(`{"id":1,"shadow":true}`)}, false},
+		{"posts", models.Event{Forum: "pirate", Type: models.EventPostsCreated, Payload: json.RawMessage(`[{"id":1},{"id":2}]`)}, true},
+		{"shadow post", models.Event{Forum: "pirate", Type: models.EventPostsCreated, Payload: json.RawMessage(`[{"id":1},{"id":2,"shadow":true}]`)}, false},
+		{"vote", models.Event{Forum: "pirate", Type: models.EventThreadVoted, Payload: json.RawMessage(`{"thread":1,"votes":1}`)}, true},
+		{"shadow thread vote", models.Event{Forum: "pirate", Type: models.EventThreadVoted, Payload: json.RawMessage(`{"thread":1,"votes":1,"shadow":true}`)}, false},
+		{"no forum", models.Event{Type: models.EventUserCreated, Payload: json.RawMessage(`{}`)}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mock_repository.NewMockWebhook(gomock.NewController(t))
+			if tt.enqueue {
+				repo.EXPECT().AddWebhookDeliveries(gomock.Any(), tt.event).Return(nil)
+			}
+			if err := Enqueue(repo)(context.Background(), tt.event); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
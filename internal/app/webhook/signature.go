@@ -0,0 +1,49 @@
+// Package webhook доставляет события форумов на веб-хуки, зарегистрированные владельцами форумов.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Заголовки запроса доставки.
+const (
+	HeaderEvent     = "X-Forum-Event"
+	HeaderDelivery  = "X-Forum-Delivery"
+	HeaderTimestamp = "X-Forum-Timestamp"
+	// HeaderSignature - подпись "sha256=<hex>", см. Sign
+	HeaderSignature = "X-Forum-Signature"
+)
+
+// signaturePrefix - алгоритм подписи в HeaderSignature
+const signaturePrefix = "sha256="
+
+// Sign возвращает подпись тела запроса: HMAC-SHA256 с секретом веб-хука
+// от строки "<timestamp>.<body>", где timestamp - значение HeaderTimestamp.
+// Время в подписи не даёт повторно отправить перехваченный запрос позже.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify проверяет подпись запроса и что он отправлен не раньше, чем maxAge назад.
+func Verify(secret, timestamp, signature string, body []byte, maxAge time.Duration) bool {
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	if age := time.Since(time.Unix(ts, 0)); age > maxAge || age < -maxAge {
+		return false
+	}
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return false
+	}
+	return hmac.Equal([]byte(signature), []byte(Sign(secret, ts, body)))
+}
@@ -0,0 +1,39 @@
+package webhook
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSignVerify(t *testing.T) {
+	body := []byte(`{"type":"thread.created"}`)
+	now := time.Now().Unix()
+	signature := Sign("secret", now, body)
+
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp string
+		signature string
+		body      []byte
+		want      bool
+	}{
+		{"valid", "secret", strconv.FormatInt(now, 10), signature, body, true},
+		{"wrong secret", "other", strconv.FormatInt(now, 10), signature, body, false},
+		{"changed body", "secret", strconv.FormatInt(now, 10), signature, []byte(`{"type":"thread.voted"}`), false},
+		{"changed timestamp", "secret", strconv.FormatInt(now+1, 10), signature, body, false},
+		{"old", "secret", strconv.FormatInt(now-600, 10), Sign("secret", now-600, body), body, false},
+		{"future", "secret", strconv.FormatInt(now+600, 10), Sign("secret", now+600, body), body, false},
+		{"bad timestamp", "secret", "now", signature, body, false},
+		{"no prefix", "secret", strconv.FormatInt(now, 10), signature[len(signaturePrefix):], body, false},
+		{"empty", "secret", strconv.FormatInt(now, 10), "", body, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Verify(tt.secret, tt.timestamp, tt.signature, tt.body, 5*time.Minute); got != tt.want {
+				t.Errorf("Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
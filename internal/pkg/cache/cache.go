@@ -0,0 +1,16 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// Cache - хранилище значений с ограниченным временем жизни.
+type Cache interface {
+	// Get возвращает значение по ключу. ok = false, если значения нет или оно устарело.
+	Get(ctx context.Context, key string) (value []byte, ok bool, err error)
+	// Set сохраняет значение на время ttl.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Delete удаляет значения по ключам.
+	Delete(ctx context.Context, keys ...string) error
+}
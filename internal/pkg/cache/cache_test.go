@@ -0,0 +1,131 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+)
+
+// backend - кэш и способ сдвинуть его время вперёд для проверки TTL.
+type backend struct {
+	cache   Cache
+	advance func(time.Duration)
+}
+
+func backends() map[string]func(t *testing.T) backend {
+	return map[string]func(t *testing.T) backend{
+		"lru": func(t *testing.T) backend {
+			c := NewLRU(100)
+			now := time.Now()
+			c.now = func() time.Time { return now }
+			return backend{cache: c, advance: func(d time.Duration) { now = now.Add(d) }}
+		},
+		"redis": func(t *testing.T) backend {
+			mr := miniredis.RunT(t)
+			client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+			t.Cleanup(func() { client.Close() })
+			return backend{cache: NewRedis(client, "test:"), advance: mr.FastForward}
+		},
+	}
+}
+
+func TestCache(t *testing.T) {
+	ctx := context.Background()
+	for name, newBackend := range backends() {
+		t.Run(name, func(t *testing.T) {
+			b := newBackend(t)
+
+			if _, ok, err := b.cache.Get(ctx, "a"); ok || err != nil {
+				t.Fatalf("Get(a) on empty cache = %v, %v", ok, err)
+			}
+
+			mustSet(t, b.cache, "a", "1", time.Minute)
+			mustSet(t, b.cache, "b", "2", time.Second)
+			mustGet(t, b.cache, "a", "1")
+			mustGet(t, b.cache, "b", "2")
+
+			// перезапись меняет значение и срок
+			mustSet(t, b.cache, "a", "3", 2*time.Minute)
+			mustGet(t, b.cache, "a", "3")
+
+			b.advance(time.Minute + time.Second)
+			mustGet(t, b.cache, "a", "3")
+			mustMiss(t, b.cache, "b")
+
+			b.advance(time.Minute)
+			mustMiss(t, b.cache, "a")
+
+			mustSet(t, b.cache, "c", "4", time.Minute)
+			mustSet(t, b.cache, "d", "5", time.Minute)
+			if err := b.cache.Delete(ctx, "c", "d", "missing"); err != nil {
+				t.Fatal(err)
+			}
+			if err := b.cache.Delete(ctx); err != nil {
+				t.Fatal(err)
+			}
+			mustMiss(t, b.cache, "c")
+			mustMiss(t, b.cache, "d")
+		})
+	}
+}
+
+func TestLRUEviction(t *testing.T) {
+	c := NewLRU(2)
+	mustSet(t, c, "a", "1", time.Minute)
+	mustSet(t, c, "b", "2", time.Minute)
+	// a использован последним, поэтому вытесняется b
+	mustGet(t, c, "a", "1")
+	mustSet(t, c, "c", "3", time.Minute)
+
+	mustGet(t, c, "a", "1")
+	mustGet(t, c, "c", "3")
+	mustMiss(t, c, "b")
+
+	// перезапись тоже считается использованием
+	mustSet(t, c, "a", "4", time.Minute)
+	mustSet(t, c, "d", "5", time.Minute)
+	mustGet(t, c, "a", "4")
+	mustMiss(t, c, "c")
+
+	if c.ll.Len() != 2 || len(c.items) != 2 {
+		t.Errorf("len = %d, %d, want 2", c.ll.Len(), len(c.items))
+	}
+}
+
+func TestRedisPrefix(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer client.Close()
+
+	mustSet(t, NewRedis(client, "forum:"), "user:a", "1", time.Minute)
+	if got, err := mr.Get("forum:user:a"); err != nil || got != "1" {
+		t.Errorf("stored value = %q, %v, want key with prefix", got, err)
+	}
+	mustMiss(t, NewRedis(client, "other:"), "user:a")
+}
+
+func mustSet(t *testing.T, c Cache, key, value string, ttl time.Duration) {
+	t.Helper()
+	if err := c.Set(context.Background(), key, []byte(value), ttl); err != nil {
+		t.Fatalf("Set(%s) error = %v", key, err)
+	}
+}
+
+func mustGet(t *testing.T, c Cache, key, want string) {
+	t.Helper()
+	value, ok, err := c.Get(context.Background(), key)
+	if err != nil || !ok || string(value) != want {
+		t.Fatalf("Get(%s) = %q, %v, %v, want %q", key, value, ok, err, want)
+	}
+}
+
+func mustMiss(t *testing.T, c Cache, key string) {
+	t.Helper()
+	value, ok, err := c.Get(context.Background(), key)
+	if err != nil || ok {
+		t.Fatalf("Get(%s) = %q, %v, %v, want miss", key, value, ok, err)
+	}
+}
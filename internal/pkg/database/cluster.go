@@ -0,0 +1,172 @@
+package database
+
+import (
+	"context"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultHealthCheckInterval - период проверки доступности реплик
+	DefaultHealthCheckInterval = 5 * time.Second
+	healthCheckTimeout         = time.Second
+)
+
+// Cluster - основной сервер базы данных и его реплики.
+// Запись и транзакции выполняются на основном сервере, чтение - на доступных репликах.
+type Cluster struct {
+	primary  *sqlx.DB
+	replicas []*replica
+	next     uint32
+
+	readYourWrites      time.Duration
+	healthCheckInterval time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+type replica struct {
+	db      *sqlx.DB
+	healthy int32
+}
+
+// ClusterOption - дополнительная настройка Cluster.
+type ClusterOption func(*Cluster)
+
+// WithReadYourWrites направляет чтение сессии на основной сервер в течение window после её записи,
+// чтобы сессия видела свои изменения независимо от отставания реплик, см. WithSession.
+func WithReadYourWrites(window time.Duration) ClusterOption {
+	return func(c *Cluster) {
+		c.readYourWrites = window
+	}
+}
+
+// WithHealthCheckInterval задаёт период проверки доступности реплик.
+func WithHealthCheckInterval(interval time.Duration) ClusterOption {
+	return func(c *Cluster) {
+		c.healthCheckInterval = interval
+	}
+}
+
+// NewCluster подключается к основному серверу и репликам.
+// Недоступная при старте реплика не считается ошибкой: она начнёт использоваться после восстановления.
+func NewCluster(ctx context.Context, driver, primaryDSN string, replicaDSNs []string, opts ...ClusterOption) (*Cluster, error) {
+	primary, err := NewPostgres(ctx, primaryDSN, driver)
+	if err != nil {
+		return nil, errors.Wrap(err, "connect primary")
+	}
+
+	c := &Cluster{
+		primary:             primary,
+		healthCheckInterval: DefaultHealthCheckInterval,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	for _, dsn := range replicaDSNs {
+		db, err := sqlx.Open(driver, dsn)
+		if err != nil {
+			c.Close()
+			return nil, errors.Wrap(err, "open replica")
+		}
+		c.replicas = append(c.replicas, &replica{db: db})
+	}
+
+	ctx, c.cancel = context.WithCancel(ctx)
+	c.checkReplicas(ctx)
+	if len(c.replicas) > 0 {
+		c.wg.Add(1)
+		go c.healthCheck(ctx)
+	}
+
+	return c, nil
+}
+
+// Primary возвращает подключение к основному серверу.
+func (c *Cluster) Primary() *sqlx.DB {
+	return c.primary
+}
+
+// Writer возвращает подключение к основному серверу и отмечает запись в сессии запроса.
+func (c *Cluster) Writer(ctx context.Context) *sqlx.DB {
+	if s := sessionFromContext(ctx); s != nil {
+		s.touch(time.Now())
+	}
+	return c.primary
+}
+
+// Reader возвращает подключение для чтения: одну из доступных реплик,
+// либо основной сервер, если реплик нет, сессия недавно выполняла запись или задан WithPrimary.
+func (c *Cluster) Reader(ctx context.Context) *sqlx.DB {
+	if len(c.replicas) == 0 || readsPrimary(ctx) {
+		return c.primary
+	}
+	if s := sessionFromContext(ctx); s != nil && time.Since(s.WroteAt()) < c.readYourWrites {
+		return c.primary
+	}
+
+	n := uint32(len(c.replicas))
+	start := atomic.AddUint32(&c.next, 1)
+	for i := uint32(0); i < n; i++ {
+		r := c.replicas[(start+i)%n]
+		if atomic.LoadInt32(&r.healthy) == 1 {
+			return r.db
+		}
+	}
+	return c.primary
+}
+
+// Close останавливает проверку реплик и закрывает все подключения.
+func (c *Cluster) Close() error {
+	if c.cancel != nil {
+		c.cancel()
+	}
+	c.wg.Wait()
+
+	err := c.primary.Close()
+	for _, r := range c.replicas {
+		if errClose := r.db.Close(); errClose != nil {
+			err = errClose
+		}
+	}
+	return err
+}
+
+func (c *Cluster) healthCheck(ctx context.Context) {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(c.healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.checkReplicas(ctx)
+		}
+	}
+}
+
+func (c *Cluster) checkReplicas(ctx context.Context) {
+	for i, r := range c.replicas {
+		pingCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+		err := r.db.PingContext(pingCtx)
+		cancel()
+
+		var healthy int32
+		if err == nil {
+			healthy = 1
+		}
+		if old := atomic.SwapInt32(&r.healthy, healthy); old != healthy {
+			log.Printf("database: replica %d healthy=%t: %v", i, healthy == 1, err)
+		}
+	}
+}
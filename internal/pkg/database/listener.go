@@ -0,0 +1,98 @@
+package database
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/stdlib"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+const (
+	listenerMinBackoff = time.Second
+	listenerMaxBackoff = 30 * time.Second
+)
+
+// NotificationHandler обрабатывает уведомление NOTIFY.
+// Вызывается в горутине Listener и не должен блокироваться.
+// Пустой payload означает, что подключение было восстановлено
+// и уведомления за время разрыва могли быть потеряны.
+type NotificationHandler func(payload string)
+
+// Listener получает уведомления канала Postgres через LISTEN на отдельном подключении.
+// Уведомления отправляются при фиксации транзакции и доходят до всех экземпляров сервера.
+type Listener struct {
+	db      *sqlx.DB
+	channel string
+	handler NotificationHandler
+}
+
+// NewListener возвращает Listener канала channel. db должен быть открыт драйвером pgx.
+func NewListener(db *sqlx.DB, channel string, handler NotificationHandler) *Listener {
+	return &Listener{
+		db:      db,
+		channel: channel,
+		handler: handler,
+	}
+}
+
+// Run слушает канал до отмены ctx, переподключаясь при ошибках.
+func (l *Listener) Run(ctx context.Context) {
+	backoff := listenerMinBackoff
+	for {
+		started := time.Now()
+		err := l.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("database: listen %s: %v", l.channel, err)
+
+		if time.Since(started) > listenerMaxBackoff {
+			backoff = listenerMinBackoff
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > listenerMaxBackoff {
+			backoff = listenerMaxBackoff
+		}
+	}
+}
+
+func (l *Listener) listen(ctx context.Context) error {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return errors.Wrap(err, "db.Conn()")
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn interface{}) error {
+		c, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return errors.Errorf("Listener: unsupported driver connection %T", driverConn)
+		}
+		pgConn := c.Conn()
+
+		if _, err := pgConn.Exec(ctx, "LISTEN "+pgx.Identifier{l.channel}.Sanitize()); err != nil {
+			return errors.Wrap(err, "LISTEN")
+		}
+		// уведомления, отправленные до LISTEN, могли быть пропущены
+		l.handler("")
+
+		for {
+			notification, err := pgConn.WaitForNotification(ctx)
+			if err != nil {
+				// после отмены ожидания подключение закрыто и не вернётся в пул
+				return errors.Wrap(err, "WaitForNotification")
+			}
+			if len(notification.Payload) != 0 {
+				l.handler(notification.Payload)
+			}
+		}
+	})
+}
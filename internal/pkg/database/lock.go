@@ -2,21 +2,200 @@ package database
 
 import (
 	"context"
+	"database/sql/driver"
+	"expvar"
 	"fmt"
+	"log"
+	"sync"
+	"time"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
 )
 
-type lockType int
+// LockKind - вид рекомендательной блокировки.
+// Идентификатор вида используется первым ключом pg_advisory_*(int, int), вторым - ключ объекта,
+// поэтому блокировки разных видов с одинаковым ключом не пересекаются.
+type LockKind struct {
+	id   int32
+	name string
+}
+
+func (k LockKind) String() string {
+	return k.name
+}
+
+var (
+	lockKindsMu sync.Mutex
+	lockKinds   = make(map[int32]string)
+
+	// lockMetrics - статистика блокировок по видам, доступна в /debug/vars
+	lockMetrics = expvar.NewMap("database_advisory_locks")
+)
+
+// RegisterLockKind регистрирует вид блокировки.
+// Идентификаторы видов должны быть уникальны, повторная регистрация приводит к панике.
+func RegisterLockKind(id int32, name string) LockKind {
+	lockKindsMu.Lock()
+	defer lockKindsMu.Unlock()
+
+	if registered, ok := lockKinds[id]; ok {
+		panic(fmt.Sprintf("database: lock kind %d is already registered as %q", id, registered))
+	}
+	lockKinds[id] = name
+	return LockKind{id: id, name: name}
+}
 
-// LockTypeOfficeEvents - константа для блокировки таблицы office events
-const (
-	_ lockType = iota
-	LockTypeOfficeEvents
+// Виды блокировок. Все виды регистрируются здесь, чтобы их идентификаторы не пересекались.
+var (
+	// LockKindThread - запись в ветку обсуждения, ключ - id ветки
+	LockKindThread = RegisterLockKind(1, "thread")
+	// LockKindOutbox - публикация событий outbox, ключ всегда 0
+	LockKindOutbox = RegisterLockKind(2, "outbox")
+	// LockKindPurgePosts - окончательное удаление сообщений, см. RunSingleton
+	LockKindPurgePosts = RegisterLockKind(3, "purge_posts")
+	// LockKindCollectAttachments - удаление неиспользуемых файлов, см. RunSingleton
+	LockKindCollectAttachments = RegisterLockKind(4, "collect_attachments")
 )
 
-// AcquireLock берёт рекомендательную блокировку, которая снимается при завершении транзакции (xact)
-func AcquireLock(ctx context.Context, tx *sqlx.Tx, lockID lockType) error {
-	_, err := tx.ExecContext(ctx, fmt.Sprintf("select pg_advisory_xact_lock(%d)", lockID))
-	return err
+// RunSingleton выполняет job сразу и затем раз в interval до отмены ctx.
+// job выполняет один экземпляр сервера за раз под блокировкой уровня сессии kind с ключом 0 на db,
+// остальные экземпляры пропускают срабатывание таймера, пока она занята.
+// Ошибки job пишутся в журнал.
+func RunSingleton(ctx context.Context, db *sqlx.DB, kind LockKind, interval time.Duration, job func(ctx context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := runLocked(ctx, db, kind, job); err != nil && ctx.Err() == nil {
+			log.Printf("database: %s: %v", kind, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runLocked выполняет job, если блокировку kind не держит другой экземпляр сервера.
+func runLocked(ctx context.Context, db *sqlx.DB, kind LockKind, job func(ctx context.Context) error) error {
+	lock, err := TrySessionLock(ctx, db, kind, 0)
+	if err != nil || lock == nil {
+		return err
+	}
+	// блокировка снимается и после отмены ctx
+	defer func() {
+		if err := lock.Release(context.Background()); err != nil {
+			log.Printf("database: %s: %v", kind, err)
+		}
+	}()
+
+	return job(ctx)
+}
+
+// AcquireLock берёт рекомендательную блокировку, которая снимается при завершении транзакции (xact).
+// Транзакция берётся из контекста (см. WithTx).
+func AcquireLock(ctx context.Context, kind LockKind, key int32) error {
+	tx, ok := TxFromContext(ctx)
+	if !ok {
+		return errors.New("AcquireLock: no transaction in context")
+	}
+
+	start := time.Now()
+	_, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1, $2)", kind.id, key)
+	observeLock(kind, start, err == nil)
+	return errors.Wrap(err, "pg_advisory_xact_lock")
+}
+
+// TryLock пытается взять блокировку транзакции без ожидания.
+// Возвращает false, если блокировка уже занята.
+func TryLock(ctx context.Context, kind LockKind, key int32) (bool, error) {
+	tx, ok := TxFromContext(ctx)
+	if !ok {
+		return false, errors.New("TryLock: no transaction in context")
+	}
+
+	var locked bool
+	err := tx.QueryRowxContext(ctx, "SELECT pg_try_advisory_xact_lock($1, $2)", kind.id, key).Scan(&locked)
+	if err != nil {
+		return false, errors.Wrap(err, "pg_try_advisory_xact_lock")
+	}
+	observeTry(kind, locked)
+	return locked, nil
+}
+
+// SessionLock - блокировка уровня сессии, которая удерживается на отдельном соединении
+// до вызова Release. Подходит для фоновых задач, которые должны выполняться в одном экземпляре.
+type SessionLock struct {
+	conn *sqlx.Conn
+	kind LockKind
+	key  int32
+}
+
+// TrySessionLock пытается взять блокировку уровня сессии без ожидания.
+// Возвращает nil, если блокировка уже занята.
+func TrySessionLock(ctx context.Context, db *sqlx.DB, kind LockKind, key int32) (*SessionLock, error) {
+	conn, err := db.Connx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "db.Connx()")
+	}
+
+	var locked bool
+	err = conn.QueryRowxContext(ctx, "SELECT pg_try_advisory_lock($1, $2)", kind.id, key).Scan(&locked)
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "pg_try_advisory_lock")
+	}
+	observeTry(kind, locked)
+	if !locked {
+		conn.Close()
+		return nil, nil
+	}
+	return &SessionLock{conn: conn, kind: kind, key: key}, nil
+}
+
+// Release снимает блокировку и возвращает соединение в пул.
+// Если снять блокировку не удалось, соединение закрывается: блокировка снимается вместе с сессией.
+func (l *SessionLock) Release(ctx context.Context) error {
+	_, err := l.conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1, $2)", l.kind.id, l.key)
+	if err != nil {
+		_ = l.conn.Raw(func(interface{}) error { return driver.ErrBadConn })
+	}
+	l.conn.Close()
+	return errors.Wrap(err, "pg_advisory_unlock")
+}
+
+func observeLock(kind LockKind, start time.Time, acquired bool) {
+	m := kindMetrics(kind)
+	m.AddFloat("wait_seconds_total", time.Since(start).Seconds())
+	if acquired {
+		m.Add("acquired", 1)
+	} else {
+		m.Add("failed", 1)
+	}
+}
+
+func observeTry(kind LockKind, locked bool) {
+	m := kindMetrics(kind)
+	if locked {
+		m.Add("acquired", 1)
+	} else {
+		m.Add("busy", 1)
+	}
+}
+
+func kindMetrics(kind LockKind) *expvar.Map {
+	if m, ok := lockMetrics.Get(kind.name).(*expvar.Map); ok {
+		return m
+	}
+	lockKindsMu.Lock()
+	defer lockKindsMu.Unlock()
+	if m, ok := lockMetrics.Get(kind.name).(*expvar.Map); ok {
+		return m
+	}
+	m := new(expvar.Map).Init()
+	lockMetrics.Set(kind.name, m)
+	return m
 }
@@ -0,0 +1,85 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/stdlib"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+type withPgxTxFunc func(ctx context.Context, tx pgx.Tx) error
+
+// WithPgxTx выполняет fn в транзакции на нативном соединении pgx.
+// Нужна для COPY и пакетных запросов (pgx.Batch), недоступных через database/sql,
+// поэтому db должен быть открыт драйвером pgx. Повторы при конфликтах - как в WithTx.
+// Транзакция из контекста не используется: вызывающий код должен проверить её отсутствие сам.
+func WithPgxTx(ctx context.Context, db *sqlx.DB, fn withPgxTxFunc, opts ...TxOption) error {
+	o := newTxOptions(opts)
+
+	return retry(ctx, o, func() error {
+		conn, err := db.Conn(ctx)
+		if err != nil {
+			return errors.Wrap(err, "db.Conn()")
+		}
+		defer conn.Close()
+
+		return conn.Raw(func(driverConn interface{}) error {
+			c, ok := driverConn.(*stdlib.Conn)
+			if !ok {
+				return errors.Errorf("WithPgxTx: unsupported driver connection %T", driverConn)
+			}
+			return runPgxTx(ctx, c.Conn(), fn, o)
+		})
+	})
+}
+
+func runPgxTx(ctx context.Context, conn *pgx.Conn, fn withPgxTxFunc, o txOptions) error {
+	txOptions := pgx.TxOptions{IsoLevel: pgxIsolation(o.isolation)}
+	if o.readOnly {
+		txOptions.AccessMode = pgx.ReadOnly
+	}
+
+	t, err := conn.BeginTx(ctx, txOptions)
+	if err != nil {
+		return errors.Wrap(err, "conn.BeginTx()")
+	}
+
+	if err = fn(ctx, t); err != nil {
+		if errRollback := t.Rollback(ctx); errRollback != nil {
+			return errors.Wrap(err, "Tx.Rollback")
+		}
+		return errors.Wrap(err, "Tx.WithPgxTxFunc")
+	}
+
+	if err = t.Commit(ctx); err != nil {
+		return errors.Wrap(err, "Tx.Commit")
+	}
+	return nil
+}
+
+// AcquirePgxLock берёт рекомендательную блокировку транзакции pgx, как AcquireLock.
+func AcquirePgxLock(ctx context.Context, tx pgx.Tx, kind LockKind, key int32) error {
+	start := time.Now()
+	_, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock($1, $2)", kind.id, key)
+	observeLock(kind, start, err == nil)
+	return errors.Wrap(err, "pg_advisory_xact_lock")
+}
+
+func pgxIsolation(level sql.IsolationLevel) pgx.TxIsoLevel {
+	switch level {
+	case sql.LevelReadUncommitted:
+		return pgx.ReadUncommitted
+	case sql.LevelReadCommitted:
+		return pgx.ReadCommitted
+	case sql.LevelRepeatableRead, sql.LevelSnapshot:
+		return pgx.RepeatableRead
+	case sql.LevelSerializable, sql.LevelLinearizable:
+		return pgx.Serializable
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,57 @@
+package database
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Session - время последней записи клиента.
+// Отметку хранит клиент и передаёт её с каждым запросом, поэтому она не зависит
+// от экземпляра сервера, который обрабатывает запрос.
+type Session struct {
+	mu      sync.Mutex
+	wroteAt time.Time
+}
+
+type sessionKey struct{}
+
+// WithSession возвращает контекст запроса клиента, который последний раз выполнял запись в wroteAt
+// (нулевое время - запись не выполнялась). Cluster отмечает в сессии записи запроса.
+func WithSession(ctx context.Context, wroteAt time.Time) (context.Context, *Session) {
+	s := &Session{wroteAt: wroteAt}
+	return context.WithValue(ctx, sessionKey{}, s), s
+}
+
+func sessionFromContext(ctx context.Context) *Session {
+	s, _ := ctx.Value(sessionKey{}).(*Session)
+	return s
+}
+
+// WroteAt возвращает время последней записи клиента с учётом записей текущего запроса.
+func (s *Session) WroteAt() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.wroteAt
+}
+
+func (s *Session) touch(now time.Time) {
+	s.mu.Lock()
+	if now.After(s.wroteAt) {
+		s.wroteAt = now
+	}
+	s.mu.Unlock()
+}
+
+type primaryKey struct{}
+
+// WithPrimary возвращает контекст, чтение в котором выполняется на основном сервере.
+// Нужен, когда запрос должен видеть все зафиксированные изменения, например после NOTIFY.
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+func readsPrimary(ctx context.Context) bool {
+	primary, _ := ctx.Value(primaryKey{}).(bool)
+	return primary
+}
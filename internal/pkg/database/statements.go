@@ -0,0 +1,182 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// Statement - именованный SQL-запрос.
+// Текст запроса фиксируется при регистрации, во время выполнения меняются только параметры.
+type Statement struct {
+	name  string
+	query string
+}
+
+func (s *Statement) String() string {
+	return s.name
+}
+
+// Query возвращает текст запроса, например для пакета pgx.Batch, который не использует database/sql.
+func (s *Statement) Query() string {
+	return s.query
+}
+
+var (
+	statementsMu sync.Mutex
+	statements   = make(map[string]*Statement)
+)
+
+// RegisterStatement регистрирует именованный запрос.
+// Имена должны быть уникальны, повторная регистрация приводит к панике.
+func RegisterStatement(name, query string) *Statement {
+	statementsMu.Lock()
+	defer statementsMu.Unlock()
+
+	if _, ok := statements[name]; ok {
+		panic(fmt.Sprintf("database: statement %q is already registered", name))
+	}
+	s := &Statement{name: name, query: query}
+	statements[name] = s
+	return s
+}
+
+// Page - вариант постраничного запроса: направление сортировки и наличие ключа since.
+// Фрагменты запроса, зависящие от варианта, берутся только из методов Page.
+type Page struct {
+	Desc  bool
+	Since bool
+}
+
+// Order возвращает направление сортировки.
+func (p Page) Order() string {
+	if p.Desc {
+		return "DESC"
+	}
+	return "ASC"
+}
+
+// After возвращает оператор сравнения для строк, следующих за ключом (без него).
+func (p Page) After() string {
+	if p.Desc {
+		return "<"
+	}
+	return ">"
+}
+
+// From возвращает оператор сравнения для строк, начиная с ключа.
+func (p Page) From() string {
+	if p.Desc {
+		return "<="
+	}
+	return ">="
+}
+
+func (p Page) String() string {
+	name := "asc"
+	if p.Desc {
+		name = "desc"
+	}
+	if p.Since {
+		name += "_since"
+	}
+	return name
+}
+
+// PagedStatement - запрос, зарегистрированный во всех вариантах Page.
+type PagedStatement struct {
+	variants map[Page]*Statement
+}
+
+// RegisterPagedStatement регистрирует запрос для каждого варианта Page.
+// build вызывается при регистрации, поэтому во время выполнения текст запросов не строится.
+func RegisterPagedStatement(name string, build func(p Page) string) *PagedStatement {
+	s := &PagedStatement{variants: make(map[Page]*Statement, 4)}
+	for _, desc := range []bool{false, true} {
+		for _, since := range []bool{false, true} {
+			p := Page{Desc: desc, Since: since}
+			s.variants[p] = RegisterStatement(name+":"+p.String(), build(p))
+		}
+	}
+	return s
+}
+
+// Page возвращает вариант запроса.
+func (s *PagedStatement) Page(p Page) *Statement {
+	return s.variants[p]
+}
+
+type preparedKey struct {
+	db   *sqlx.DB
+	name string
+}
+
+// Statements хранит подготовленные запросы.
+// Запрос подготавливается один раз на пул подключений, а database/sql
+// подготавливает его на каждом подключении пула при первом использовании.
+type Statements struct {
+	mu       sync.Mutex
+	prepared map[preparedKey]*sqlx.Stmt
+}
+
+func NewStatements() *Statements {
+	return &Statements{prepared: make(map[preparedKey]*sqlx.Stmt)}
+}
+
+// Prepare возвращает запрос, подготовленный для db.
+func (s *Statements) Prepare(ctx context.Context, db *sqlx.DB, statement *Statement) (*sqlx.Stmt, error) {
+	key := preparedKey{db: db, name: statement.name}
+
+	s.mu.Lock()
+	stmt, ok := s.prepared[key]
+	s.mu.Unlock()
+	if ok {
+		return stmt, nil
+	}
+
+	stmt, err := db.PreparexContext(ctx, statement.query)
+	if err != nil {
+		return nil, errors.Wrapf(err, "prepare %s", statement.name)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// запрос мог быть подготовлен параллельно
+	if prepared, ok := s.prepared[key]; ok {
+		stmt.Close()
+		return prepared, nil
+	}
+	s.prepared[key] = stmt
+	return stmt, nil
+}
+
+// Stmt возвращает запрос, подготовленный для db, а если в ctx есть транзакция - привязанный к ней.
+// Транзакция должна быть открыта на db.
+func (s *Statements) Stmt(ctx context.Context, db *sqlx.DB, statement *Statement) (*sqlx.Stmt, error) {
+	stmt, err := s.Prepare(ctx, db, statement)
+	if err != nil {
+		return nil, err
+	}
+	if tx, ok := TxFromContext(ctx); ok {
+		return tx.StmtxContext(ctx, stmt), nil
+	}
+	return stmt, nil
+}
+
+// Close закрывает подготовленные запросы.
+func (s *Statements) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var result error
+	for key, stmt := range s.prepared {
+		if err := stmt.Close(); err != nil && result == nil {
+			result = errors.Wrapf(err, "close %s", key.name)
+		}
+		delete(s.prepared, key)
+	}
+	return result
+}
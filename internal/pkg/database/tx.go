@@ -2,21 +2,147 @@ package database
 
 import (
 	"context"
+	"database/sql"
+	"math/rand"
+	"time"
+
+	"github.com/jackc/pgconn"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 )
 
-type withTxFunc func(ctx context.Context, tx *sqlx.Tx) error
+const (
+	// DefaultTxAttempts - число попыток выполнить транзакцию при конфликтах сериализации
+	DefaultTxAttempts = 5
+	// DefaultTxBackoff - пауза перед первым повтором, удваивается с каждой попыткой
+	DefaultTxBackoff = 10 * time.Millisecond
+
+	serializationFailure = "40001"
+	deadlockDetected     = "40P01"
+)
+
+type withTxFunc func(ctx context.Context) error
+
+type txKey struct{}
+
+type txOptions struct {
+	isolation sql.IsolationLevel
+	readOnly  bool
+	attempts  int
+	backoff   time.Duration
+}
+
+// TxOption - настройка транзакции WithTx.
+type TxOption func(*txOptions)
+
+// WithIsolation задаёт уровень изоляции транзакции.
+func WithIsolation(level sql.IsolationLevel) TxOption {
+	return func(o *txOptions) {
+		o.isolation = level
+	}
+}
+
+// WithReadOnly открывает транзакцию только для чтения.
+func WithReadOnly() TxOption {
+	return func(o *txOptions) {
+		o.readOnly = true
+	}
+}
+
+// WithRetries задаёт число попыток и начальную паузу между ними
+// при ошибках сериализации и взаимоблокировках.
+func WithRetries(attempts int, backoff time.Duration) TxOption {
+	return func(o *txOptions) {
+		o.attempts = attempts
+		o.backoff = backoff
+	}
+}
+
+func newTxOptions(opts []TxOption) txOptions {
+	o := txOptions{
+		isolation: sql.LevelDefault,
+		attempts:  DefaultTxAttempts,
+		backoff:   DefaultTxBackoff,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// TxFromContext возвращает транзакцию, в которой выполняется запрос.
+func TxFromContext(ctx context.Context) (*sqlx.Tx, bool) {
+	tx, ok := ctx.Value(txKey{}).(*sqlx.Tx)
+	return tx, ok
+}
 
 // WithTx запускает переданную функцию withTxFunc() в транзацкии и делает роллбек транзакции при ошибках
 // удобная обертка для выполнения транзакций
-func WithTx(ctx context.Context, db *sqlx.DB, fn withTxFunc) error {
-	t, err := db.BeginTxx(ctx, nil)
+//
+// Транзакция сохраняется в контексте, и запросы репозиториев с этим контекстом выполняются в ней.
+// Если контекст уже содержит транзакцию, fn выполняется в ней, а опции игнорируются.
+// При ошибках сериализации (40001) и взаимоблокировках (40P01) транзакция повторяется целиком,
+// поэтому fn не должна иметь побочных эффектов вне базы данных.
+func WithTx(ctx context.Context, db *sqlx.DB, fn withTxFunc, opts ...TxOption) error {
+	if _, ok := TxFromContext(ctx); ok {
+		return fn(ctx)
+	}
+
+	o := newTxOptions(opts)
+	return retry(ctx, o, func() error {
+		return runTx(ctx, db, fn, o)
+	})
+}
+
+// WithSavepoint выполняет fn в точке сохранения транзакции из контекста.
+// Если fn возвращает ошибку, её изменения откатываются, а транзакция остаётся пригодной для следующих запросов.
+func WithSavepoint(ctx context.Context, fn withTxFunc) error {
+	tx, ok := TxFromContext(ctx)
+	if !ok {
+		return errors.New("WithSavepoint: no transaction in context")
+	}
+
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT savepoint"); err != nil {
+		return errors.Wrap(err, "Savepoint")
+	}
+	if err := fn(ctx); err != nil {
+		if _, errRollback := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT savepoint"); errRollback != nil {
+			return errors.Wrap(errRollback, "Savepoint.Rollback")
+		}
+		return err
+	}
+	_, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT savepoint")
+	return errors.Wrap(err, "Savepoint.Release")
+}
+
+// retry выполняет транзакцию run, повторяя её при ошибках сериализации и взаимоблокировках.
+func retry(ctx context.Context, o txOptions, run func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := run()
+		if err == nil || attempt >= o.attempts || !isRetryable(err) {
+			return err
+		}
+
+		// экспоненциальная пауза со случайным разбросом, чтобы конкурирующие
+		// транзакции не сталкивались повторно
+		backoff := o.backoff << (attempt - 1)
+		backoff += time.Duration(rand.Int63n(int64(backoff) + 1))
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "Tx.Retry")
+		case <-time.After(backoff):
+		}
+	}
+}
+
+func runTx(ctx context.Context, db *sqlx.DB, fn withTxFunc, o txOptions) error {
+	t, err := db.BeginTxx(ctx, &sql.TxOptions{Isolation: o.isolation, ReadOnly: o.readOnly})
 	if err != nil {
 		return errors.Wrap(err, "db.BeginTxx()")
 	}
 
-	if err = fn(ctx, t); err != nil {
+	if err = fn(context.WithValue(ctx, txKey{}, t)); err != nil {
 		if errRollback := t.Rollback(); errRollback != nil {
 			return errors.Wrap(err, "Tx.Rollback")
 		}
@@ -28,3 +154,16 @@ func WithTx(ctx context.Context, db *sqlx.DB, fn withTxFunc) error {
 	}
 	return nil
 }
+
+// isRetryable сообщает, можно ли повторить транзакцию, завершившуюся ошибкой err.
+func isRetryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == serializationFailure || pgErr.Code == deadlockDetected
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Code == serializationFailure || pqErr.Code == deadlockDetected
+	}
+	return false
+}
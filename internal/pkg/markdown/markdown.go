@@ -0,0 +1,529 @@
+// Package markdown преобразует подмножество Markdown в безопасный HTML.
+//
+// Поддерживаются абзацы, заголовки, цитаты, списки, блоки кода, горизонтальные линии,
+// выделение **жирным**, *курсивом*, ***жирным курсивом*** и ~~зачёркиванием~~, `код`, ссылки [текст](url) и <url>.
+// Исходный текст всегда экранируется, поэтому HTML и скрипты из сообщения выводятся как текст,
+// а в результат попадают только теги, которые создаёт сам рендерер.
+// Ссылки допускаются только http, https, mailto и относительные.
+package markdown
+
+import (
+	"html"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	// maxDepth - вложенность цитат и выделений, глубже которой разметка выводится как текст
+	maxDepth = 8
+	// maxLinkText и maxURLLength ограничивают поиск конца ссылки
+	maxLinkText  = 1024
+	maxURLLength = 2048
+)
+
+// linkAttributes запрещают передачу рейтинга и доступ открытой страницы к форуму.
+const linkAttributes = ` rel="nofollow noopener noreferrer"`
+
+// Render возвращает HTML сообщения src.
+func Render(src string) string {
+	src = strings.ReplaceAll(src, "\r\n", "\n")
+	src = strings.ReplaceAll(src, "\r", "\n")
+
+	var b strings.Builder
+	renderBlocks(&b, strings.Split(src, "\n"), 0)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func renderBlocks(b *strings.Builder, lines []string, depth int) {
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		if isBlank(line) {
+			i++
+			continue
+		}
+		if fence := fenceMarker(line); len(fence) != 0 {
+			i = renderFence(b, lines, i, fence)
+			continue
+		}
+		if isRule(line) {
+			b.WriteString("<hr>\n")
+			i++
+			continue
+		}
+		if level, text := heading(line); level > 0 {
+			tag := "h" + strconv.Itoa(level)
+			b.WriteString("<" + tag + ">")
+			renderInline(b, text, 0, true)
+			b.WriteString("</" + tag + ">\n")
+			i++
+			continue
+		}
+		if depth < maxDepth && isQuote(line) {
+			var quoted []string
+			for ; i < len(lines) && isQuote(lines[i]); i++ {
+				quoted = append(quoted, stripQuote(lines[i]))
+			}
+			b.WriteString("<blockquote>\n")
+			renderBlocks(b, quoted, depth+1)
+			b.WriteString("</blockquote>\n")
+			continue
+		}
+		if _, ok := parseItem(line); ok {
+			i = renderList(b, lines, i, depth)
+			continue
+		}
+		i = renderParagraph(b, lines, i, depth)
+	}
+}
+
+// startsBlock сообщает, что line начинает блок, прерывающий абзац или пункт списка.
+func startsBlock(line string, depth int) bool {
+	if len(fenceMarker(line)) != 0 || isRule(line) || depth < maxDepth && isQuote(line) {
+		return true
+	}
+	if level, _ := heading(line); level > 0 {
+		return true
+	}
+	_, ok := parseItem(line)
+	return ok
+}
+
+func renderParagraph(b *strings.Builder, lines []string, i, depth int) int {
+	text := []string{strings.TrimSpace(lines[i])}
+	for i++; i < len(lines) && !isBlank(lines[i]) && !startsBlock(lines[i], depth); i++ {
+		text = append(text, strings.TrimSpace(lines[i]))
+	}
+	b.WriteString("<p>")
+	renderInline(b, strings.Join(text, "\n"), 0, true)
+	b.WriteString("</p>\n")
+	return i
+}
+
+// fenceMarker возвращает открывающую последовательность ``` или ~~~ блока кода.
+func fenceMarker(line string) string {
+	s, ok := trimIndent(line)
+	if !ok || len(s) < 3 || s[0] != '`' && s[0] != '~' {
+		return ""
+	}
+	n := 0
+	for n < len(s) && s[n] == s[0] {
+		n++
+	}
+	if n < 3 || s[0] == '`' && strings.Contains(s[n:], "`") {
+		return ""
+	}
+	return s[:n]
+}
+
+// renderFence выводит блок кода без разметки, незакрытый блок продолжается до конца сообщения.
+func renderFence(b *strings.Builder, lines []string, i int, fence string) int {
+	b.WriteString("<pre><code>")
+	for i++; i < len(lines); i++ {
+		if s := strings.TrimSpace(lines[i]); strings.HasPrefix(s, fence) && strings.Trim(s, fence[:1]) == "" {
+			i++
+			break
+		}
+		b.WriteString(html.EscapeString(lines[i]))
+		b.WriteByte('\n')
+	}
+	b.WriteString("</code></pre>\n")
+	return i
+}
+
+// isRule сообщает, что line - горизонтальная линия из трёх и более -, * или _.
+func isRule(line string) bool {
+	s, ok := trimIndent(line)
+	if !ok || len(s) == 0 || s[0] != '-' && s[0] != '*' && s[0] != '_' {
+		return false
+	}
+	n := 0
+	for _, c := range s {
+		switch {
+		case c == rune(s[0]):
+			n++
+		case c != ' ' && c != '\t':
+			return false
+		}
+	}
+	return n >= 3
+}
+
+// heading возвращает уровень и текст заголовка # - ######, 0 - если line не заголовок.
+func heading(line string) (int, string) {
+	s, ok := trimIndent(line)
+	if !ok {
+		return 0, ""
+	}
+	level := 0
+	for level < len(s) && s[level] == '#' {
+		level++
+	}
+	if level == 0 || level > 6 || level < len(s) && s[level] != ' ' && s[level] != '\t' {
+		return 0, ""
+	}
+	text := strings.TrimSpace(s[level:])
+	// закрывающие # отделяются пробелом, иначе они часть текста, как в "C#"
+	if t := strings.TrimRight(text, "#"); len(t) == 0 || strings.HasSuffix(t, " ") || strings.HasSuffix(t, "\t") {
+		text = strings.TrimSpace(t)
+	}
+	return level, text
+}
+
+func isQuote(line string) bool {
+	s, ok := trimIndent(line)
+	return ok && strings.HasPrefix(s, ">")
+}
+
+func stripQuote(line string) string {
+	s, _ := trimIndent(line)
+	s = strings.TrimPrefix(s, ">")
+	return strings.TrimPrefix(s, " ")
+}
+
+// listItem - пункт списка: "- текст", "* текст", "+ текст" или "1. текст", "1) текст".
+type listItem struct {
+	ordered bool
+	start   int
+	text    string
+}
+
+func parseItem(line string) (listItem, bool) {
+	s, ok := trimIndent(line)
+	if !ok || len(s) < 2 {
+		return listItem{}, false
+	}
+	if s[0] == '-' || s[0] == '*' || s[0] == '+' {
+		if s[1] != ' ' && s[1] != '\t' {
+			return listItem{}, false
+		}
+		return listItem{text: strings.TrimSpace(s[2:])}, true
+	}
+
+	n := 0
+	for n < len(s) && n < 9 && s[n] >= '0' && s[n] <= '9' {
+		n++
+	}
+	if n == 0 || n+1 >= len(s) || s[n] != '.' && s[n] != ')' || s[n+1] != ' ' && s[n+1] != '\t' {
+		return listItem{}, false
+	}
+	start, _ := strconv.Atoi(s[:n])
+	return listItem{ordered: true, start: start, text: strings.TrimSpace(s[n+2:])}, true
+}
+
+// renderList выводит подряд идущие пункты одного вида. Строки после пункта, не начинающие
+// другой блок, продолжают пункт, пустые строки между пунктами не разрывают список.
+func renderList(b *strings.Builder, lines []string, i, depth int) int {
+	first, _ := parseItem(lines[i])
+	switch {
+	case !first.ordered:
+		b.WriteString("<ul>\n")
+	case first.start != 1:
+		b.WriteString(`<ol start="` + strconv.Itoa(first.start) + `">` + "\n")
+	default:
+		b.WriteString("<ol>\n")
+	}
+
+	for i < len(lines) {
+		item, ok := parseItem(lines[i])
+		if !ok || item.ordered != first.ordered {
+			break
+		}
+		text := []string{item.text}
+		for i++; i < len(lines) && !isBlank(lines[i]) && !startsBlock(lines[i], depth); i++ {
+			text = append(text, strings.TrimSpace(lines[i]))
+		}
+		b.WriteString("<li>")
+		renderInline(b, strings.Join(text, "\n"), 0, true)
+		b.WriteString("</li>\n")
+
+		next := i
+		for next < len(lines) && isBlank(lines[next]) {
+			next++
+		}
+		if next < len(lines) {
+			if item, ok := parseItem(lines[next]); ok && item.ordered == first.ordered {
+				i = next
+			}
+		}
+	}
+
+	if first.ordered {
+		b.WriteString("</ol>\n")
+	} else {
+		b.WriteString("</ul>\n")
+	}
+	return i
+}
+
+// trimIndent убирает отступ до трёх пробелов, ok = false для строк с большим отступом.
+func trimIndent(line string) (string, bool) {
+	s := strings.TrimLeft(line, " ")
+	return s, len(line)-len(s) <= 3
+}
+
+func isBlank(line string) bool {
+	return len(strings.TrimSpace(line)) == 0
+}
+
+// renderInline выводит текст с выделением, кодом и ссылками. Внутри текста ссылки links ложно,
+// так как ссылки не вкладываются друг в друга.
+func renderInline(b *strings.Builder, s string, depth int, links bool) {
+	// разделители и последовательности `, для которых закрывающая пара не нашлась: дальше её тоже не будет
+	unmatched := make(map[string]bool)
+
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s) && isPunct(s[i+1]):
+			b.WriteString(html.EscapeString(s[i+1 : i+2]))
+			i += 2
+
+		case c == '\n':
+			b.WriteString("<br>\n")
+			i++
+
+		case c == '`':
+			n := runLength(s, i, '`')
+			end := -1
+			if !unmatched[s[i:i+n]] {
+				end = findRun(s, i+n, '`', n)
+			}
+			if end < 0 {
+				unmatched[s[i:i+n]] = true
+				b.WriteString(s[i : i+n])
+				i += n
+				continue
+			}
+			code := s[i+n : end]
+			if len(code) > 1 && code[0] == ' ' && code[len(code)-1] == ' ' && strings.TrimSpace(code) != "" {
+				code = code[1 : len(code)-1]
+			}
+			b.WriteString("<code>" + html.EscapeString(code) + "</code>")
+			i = end + n
+
+		case c == '*' || c == '_' || c == '~':
+			i = renderEmphasis(b, s, i, depth, links, unmatched)
+
+		case c == '[' && links:
+			i = renderLink(b, s, i, depth)
+
+		case c == '<' && links:
+			i = renderAutolink(b, s, i)
+
+		default:
+			end := i + 1
+			for end < len(s) && !strings.ContainsRune("\\\n`*_~[<", rune(s[end])) {
+				end++
+			}
+			b.WriteString(html.EscapeString(s[i:end]))
+			i = end
+		}
+	}
+}
+
+var emphasisTags = map[string]string{
+	"**": "strong",
+	"__": "strong",
+	"*":  "em",
+	"_":  "em",
+	"~~": "del",
+}
+
+// renderEmphasis выводит выделение, начинающееся в s[i], или сам разделитель, если пары нет.
+// Разделитель _ не работает внутри слов, чтобы не ломать имена_с_подчёркиваниями.
+func renderEmphasis(b *strings.Builder, s string, i, depth int, links bool, unmatched map[string]bool) int {
+	c := s[i]
+	delim := s[i : i+1]
+	if i+1 < len(s) && s[i+1] == c {
+		delim = s[i : i+2]
+	}
+	tag, ok := emphasisTags[delim]
+	if !ok || depth >= maxDepth || unmatched[delim] ||
+		i+len(delim) >= len(s) || isSpace(s[i+len(delim)]) ||
+		c == '_' && i > 0 && isAlnum(s[i-1]) {
+		b.WriteString(html.EscapeString(delim))
+		return i + len(delim)
+	}
+
+	// *** и ___ - жирный курсив, без пары разбираются как ** и *
+	if c != '~' && len(delim) == 2 && i+3 < len(s) && s[i+2] == c && !isSpace(s[i+3]) {
+		triple := s[i : i+3]
+		if j := closingDelim(s, i+3, triple, unmatched); j >= 0 {
+			b.WriteString("<em><strong>")
+			renderInline(b, s[i+3:j], depth+1, links)
+			b.WriteString("</strong></em>")
+			return j + len(triple)
+		}
+	}
+
+	start := i + len(delim)
+	if j := closingDelim(s, start, delim, unmatched); j >= 0 {
+		b.WriteString("<" + tag + ">")
+		renderInline(b, s[start:j], depth+1, links)
+		b.WriteString("</" + tag + ">")
+		return j + len(delim)
+	}
+	b.WriteString(html.EscapeString(delim))
+	return start
+}
+
+// closingDelim возвращает начало разделителя delim, закрывающего выделение с текстом от start,
+// или -1, запоминая в unmatched, что пары нет.
+func closingDelim(s string, start int, delim string, unmatched map[string]bool) int {
+	if unmatched[delim] {
+		return -1
+	}
+	c := delim[0]
+	for from := start + 1; from <= len(s)-len(delim); {
+		j := strings.Index(s[from:], delim)
+		if j < 0 {
+			break
+		}
+		j += from
+		after := j + len(delim)
+		valid := !isSpace(s[j-1]) &&
+			!(c == '_' && after < len(s) && isAlnum(s[after])) &&
+			// одиночный разделитель не закрывается половиной двойного, тройной - частью более длинного
+			!(len(delim) == 1 && (s[j-1] == c || after < len(s) && s[after] == c)) &&
+			!(len(delim) == 3 && after < len(s) && s[after] == c)
+		if valid {
+			return j
+		}
+		from = j + 1
+	}
+	unmatched[delim] = true
+	return -1
+}
+
+// renderLink выводит ссылку [текст](url). Текст ссылки с небезопасным адресом выводится без ссылки.
+func renderLink(b *strings.Builder, s string, i, depth int) int {
+	textEnd := -1
+	nesting := 0
+	for j := i + 1; j < len(s) && j-i <= maxLinkText; j++ {
+		if s[j] == '\\' {
+			j++
+			continue
+		}
+		if s[j] == '[' {
+			nesting++
+		}
+		if s[j] == ']' {
+			if nesting == 0 {
+				textEnd = j
+				break
+			}
+			nesting--
+		}
+	}
+	if textEnd < 0 || textEnd+1 >= len(s) || s[textEnd+1] != '(' {
+		b.WriteString("[")
+		return i + 1
+	}
+
+	urlEnd := -1
+	nesting = 0
+	for j := textEnd + 2; j < len(s) && j-textEnd <= maxURLLength; j++ {
+		if s[j] == '(' {
+			nesting++
+		}
+		if s[j] == ')' {
+			if nesting == 0 {
+				urlEnd = j
+				break
+			}
+			nesting--
+		}
+	}
+	if urlEnd < 0 {
+		b.WriteString("[")
+		return i + 1
+	}
+
+	text := s[i+1 : textEnd]
+	href, ok := safeURL(s[textEnd+2 : urlEnd])
+	if ok {
+		b.WriteString(`<a href="` + html.EscapeString(href) + `"` + linkAttributes + `>`)
+	}
+	renderInline(b, text, depth+1, false)
+	if ok {
+		b.WriteString("</a>")
+	}
+	return urlEnd + 1
+}
+
+// renderAutolink выводит ссылку <url>, иначе - экранированный символ <.
+func renderAutolink(b *strings.Builder, s string, i int) int {
+	rest := s[i+1:]
+	if len(rest) > maxURLLength+1 {
+		rest = rest[:maxURLLength+1]
+	}
+	if end := strings.IndexByte(rest, '>'); end > 0 {
+		raw := s[i+1 : i+1+end]
+		if href, ok := safeURL(raw); ok && strings.Contains(raw, ":") {
+			b.WriteString(`<a href="` + html.EscapeString(href) + `"` + linkAttributes + `>` + html.EscapeString(raw) + "</a>")
+			return i + end + 2
+		}
+	}
+	b.WriteString("&lt;")
+	return i + 1
+}
+
+// safeURL проверяет адрес ссылки: разрешены схемы http, https, mailto и относительные адреса.
+func safeURL(raw string) (string, bool) {
+	raw = strings.TrimSpace(raw)
+	if len(raw) == 0 || len(raw) > maxURLLength {
+		return "", false
+	}
+	for _, r := range raw {
+		if unicode.IsSpace(r) || unicode.IsControl(r) {
+			return "", false
+		}
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", false
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https", "mailto", "":
+		return raw, true
+	}
+	return "", false
+}
+
+func runLength(s string, i int, c byte) int {
+	n := 0
+	for i+n < len(s) && s[i+n] == c {
+		n++
+	}
+	return n
+}
+
+// findRun возвращает начало следующей последовательности ровно из n символов c.
+func findRun(s string, from int, c byte, n int) int {
+	for i := from; i < len(s); {
+		if s[i] != c {
+			i++
+			continue
+		}
+		run := runLength(s, i, c)
+		if run == n {
+			return i
+		}
+		i += run
+	}
+	return -1
+}
+
+func isPunct(c byte) bool {
+	return c < 0x80 && unicode.IsPunct(rune(c)) || c == '`' || c == '~' || c == '<' || c == '>' || c == '+' || c == '|' || c == '^' || c == '$' || c == '='
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n'
+}
+
+func isAlnum(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80
+}
@@ -0,0 +1,155 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const rel = ` rel="nofollow noopener noreferrer"`
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{"paragraphs", "a\nb\n\nc", "<p>a<br>\nb</p>\n<p>c</p>"},
+		{"crlf", "a\r\nb", "<p>a<br>\nb</p>"},
+		{"headings", "# h1 #\n## C#\n####### h7", "<h1>h1</h1>\n<h2>C#</h2>\n<p>####### h7</p>"},
+		{"quote", "> q1\n> > q2", "<blockquote>\n<p>q1</p>\n<blockquote>\n<p>q2</p>\n</blockquote>\n</blockquote>"},
+		{"quote too deep", strings.Repeat("> ", 10) + "a",
+			strings.Repeat("<blockquote>\n", 8) + "<p>&gt; &gt; a</p>\n" + strings.Repeat("</blockquote>\n", 7) + "</blockquote>"},
+		{"list", "- a\n- b\n\n- c", "<ul>\n<li>a</li>\n<li>b</li>\n<li>c</li>\n</ul>"},
+		{"ordered list", "3. x\n4) y", "<ol start=\"3\">\n<li>x</li>\n<li>y</li>\n</ol>"},
+		{"fence", "```go\n<script>\n**a**\n```\nafter", "<pre><code>&lt;script&gt;\n**a**\n</code></pre>\n<p>after</p>"},
+		{"unclosed fence", "~~~\ncode", "<pre><code>code\n</code></pre>"},
+		{"rule", "a\n\n* * *", "<p>a</p>\n<hr>"},
+		{"code", "`code <b>` and ``a ` b``", "<p><code>code &lt;b&gt;</code> and <code>a ` b</code></p>"},
+		{"unclosed code", "`a", "<p>`a</p>"},
+		{"escape", `\*not em\* \[x\](y)`, "<p>*not em* [x](y)</p>"},
+
+		// выделение
+		{"strong", "**a** __b__", "<p><strong>a</strong> <strong>b</strong></p>"},
+		{"em", "*a* _b_", "<p><em>a</em> <em>b</em></p>"},
+		{"del", "~~a~~ ~b~", "<p><del>a</del> ~b~</p>"},
+		{"nested", "**bold *italic* bold**", "<p><strong>bold <em>italic</em> bold</strong></p>"},
+		{"strong em", "***a*** ___b___", "<p><em><strong>a</strong></em> <em><strong>b</strong></em></p>"},
+		{"strong em unclosed", "***a**", "<p><strong>*a</strong></p>"},
+		{"overlapping", "*a **b* c**", "<p><em>a **b</em> c**</p>"},
+		{"unclosed", "**a", "<p>**a</p>"},
+		{"unclosed then closed", "*a **b**", "<p>*a <strong>b</strong></p>"},
+		{"space after opening", "** a**", "<p>** a**</p>"},
+		{"space before closing", "*a *", "<p>*a *</p>"},
+		{"underscore in words", "snake_case_name __a__b", "<p>snake_case_name __a__b</p>"},
+		{"in heading", "# *a*", "<h1><em>a</em></h1>"},
+
+		// ссылки
+		{"link", "[a](http://x.y/?a=1&b=2)", `<p><a href="http://x.y/?a=1&amp;b=2"` + rel + `>a</a></p>`},
+		{"relative link", "[a](/t/1)", `<p><a href="/t/1"` + rel + `>a</a></p>`},
+		{"mailto link", "[a](mailto:a@b.c)", `<p><a href="mailto:a@b.c"` + rel + `>a</a></p>`},
+		{"link with emphasis", "[**a** b](https://x.y)", `<p><a href="https://x.y"` + rel + `><strong>a</strong> b</a></p>`},
+		{"link in link", "[a [b](http://x) c](http://y)", `<p><a href="http://y"` + rel + `>a [b](http://x) c</a></p>`},
+		{"link with parens", "[a](http://x.y/a_(b))", `<p><a href="http://x.y/a_(b)"` + rel + `>a</a></p>`},
+		{"not a link", "[a] (b) [c", "<p>[a] (b) [c</p>"},
+		{"autolink", "<https://x.y/a?b=1&c=2>", `<p><a href="https://x.y/a?b=1&amp;c=2"` + rel + `>https://x.y/a?b=1&amp;c=2</a></p>`},
+		{"autolink mailto", "<mailto:a@b.c>", `<p><a href="mailto:a@b.c"` + rel + `>mailto:a@b.c</a></p>`},
+		{"autolink without scheme", "<x.y>", "<p>&lt;x.y&gt;</p>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Render(tt.src); got != tt.want {
+				t.Errorf("Render(%q) =\n%q\nwant\n%q", tt.src, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderUnsafe(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		// небезопасные адреса: остаётся только текст ссылки
+		{"javascript", "[x](javascript:alert(1))", "<p>x</p>"},
+		{"javascript mixed case", "[x](JaVaScRiPt:alert(1))", "<p>x</p>"},
+		{"javascript leading space", "[x](  javascript:alert(1))", "<p>x</p>"},
+		{"javascript tab", "[x](java\tscript:alert(1))", "<p>x</p>"},
+		{"javascript newline", "[x](java\nscript:alert(1))", "<p>x</p>"},
+		{"javascript control", "[x](\x01javascript:alert(1))", "<p>x</p>"},
+		{"javascript in brackets", "[x](<javascript:alert(1)>)", "<p>x</p>"},
+		{"data", "[x](data:text/html;base64,PHNjcmlwdD4=)", "<p>x</p>"},
+		{"data upper case", "[x](DATA:text/html,<script>)", "<p>x</p>"},
+		{"vbscript", "[x](vbscript:msgbox)", "<p>x</p>"},
+		{"file", "[x](file:///etc/passwd)", "<p>x</p>"},
+		{"autolink javascript", "<javascript:alert(1)>", "<p>&lt;javascript:alert(1)&gt;</p>"},
+		{"autolink javascript upper case", "<JAVASCRIPT:alert(1)>", "<p>&lt;JAVASCRIPT:alert(1)&gt;</p>"},
+		{"autolink data", "<data:text/html,x>", "<p>&lt;data:text/html,x&gt;</p>"},
+
+		// сущности не раскрываются, поэтому адрес остаётся относительным
+		{"entity scheme", "[x](&#106;avascript:alert(1))", `<p><a href="&amp;#106;avascript:alert(1)"` + rel + `>x</a></p>`},
+		{"entity colon", "[x](javascript&colon;alert(1))", `<p><a href="javascript&amp;colon;alert(1)"` + rel + `>x</a></p>`},
+
+		// HTML сообщения выводится как текст
+		{"script", "<script>alert(1)</script>", "<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>"},
+		{"onerror", `<img src=x onerror="alert(1)">`, "<p>&lt;img src=x onerror=&#34;alert(1)&#34;&gt;</p>"},
+		{"html link", `<a href="javascript:x">y</a>`, "<p>&lt;a href=&#34;javascript:x&#34;&gt;y&lt;/a&gt;</p>"},
+		{"script in heading", "# <script>", "<h1>&lt;script&gt;</h1>"},
+		{"script in code", "`<script>`", "<p><code>&lt;script&gt;</code></p>"},
+
+		// кавычки не выходят за пределы атрибута
+		{"double quote in url", `[x](http://a.b/"onmouseover="alert(1))`,
+			`<p><a href="http://a.b/&#34;onmouseover=&#34;alert(1)"` + rel + `>x</a></p>`},
+		{"single quote in url", `[x](http://a.b/'onmouseover='alert(1))`,
+			`<p><a href="http://a.b/&#39;onmouseover=&#39;alert(1)"` + rel + `>x</a></p>`},
+		{"quote in autolink", `<http://a.b/"onmouseover="alert(1)>`,
+			`<p><a href="http://a.b/&#34;onmouseover=&#34;alert(1)"` + rel + `>http://a.b/&#34;onmouseover=&#34;alert(1)</a></p>`},
+		{"quote in text", `[a"b](/c)`, `<p><a href="/c"` + rel + `>a&#34;b</a></p>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Render(tt.src); got != tt.want {
+				t.Errorf("Render(%q) =\n%q\nwant\n%q", tt.src, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRenderPathological проверяет, что разбор не становится квадратичным на входах без пар разделителей.
+func TestRenderPathological(t *testing.T) {
+	const n = 100000
+	var ticks strings.Builder
+	for i := 1; ticks.Len() < n; i++ {
+		ticks.WriteString(strings.Repeat("`", i) + "a")
+	}
+
+	tests := map[string]string{
+		"stars":           strings.Repeat("*", n),
+		"unclosed stars":  strings.Repeat("*a", n/2),
+		"unclosed strong": strings.Repeat("**a ", n/4),
+		"mixed":           strings.Repeat("*_~", n/3),
+		"nested":          strings.Repeat("*a _b ~~c ", n/10),
+		"underscores":     strings.Repeat("_a", n/2),
+		"brackets":        strings.Repeat("[", n),
+		"links":           strings.Repeat("[](", n/3),
+		"parens":          "[a](" + strings.Repeat("(", n),
+		"angles":          strings.Repeat("<a", n/2),
+		"backticks":       strings.Repeat("`", n),
+		"backtick runs":   ticks.String(),
+		"quotes":          strings.Repeat(">", n),
+		"quote lines":     strings.Repeat("> a\n", n/4),
+		"list":            strings.Repeat("- a\n", n/4),
+		"headings":        strings.Repeat("#", n),
+		"escapes":         strings.Repeat("\\", n),
+	}
+	for name, src := range tests {
+		t.Run(name, func(t *testing.T) {
+			start := time.Now()
+			Render(src)
+			if elapsed := time.Since(start); elapsed > 2*time.Second {
+				t.Errorf("Render took %s", elapsed)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Package pagetoken кодирует позицию в постраничной выборке в непрозрачный подписанный токен.
+package pagetoken
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// ErrInvalid возвращается для повреждённого, подделанного или чужого токена.
+var ErrInvalid = errors.New("invalid page token")
+
+// Cursor - позиция в выборке.
+// List - вид выборки, Scope - объект, внутри которого идёт выборка (форум или ветка),
+// Sort и Desc - сортировка, Key - ключ последней выданной записи.
+type Cursor struct {
+	List  string   `json:"l"`
+	Scope string   `json:"s"`
+	Sort  string   `json:"o,omitempty"`
+	Desc  bool     `json:"d,omitempty"`
+	Key   []string `json:"k"`
+}
+
+// Codec подписывает и проверяет токены ключом HMAC-SHA256.
+type Codec struct {
+	key []byte
+}
+
+// NewCodec возвращает Codec с ключом key.
+// При пустом ключе генерируется случайный, и токены действуют только до перезапуска.
+func NewCodec(key []byte) (*Codec, error) {
+	if len(key) == 0 {
+		key = make([]byte, sha256.Size)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+	}
+	return &Codec{key: key}, nil
+}
+
+// Encode возвращает токен для позиции c.
+func (c *Codec) Encode(cursor Cursor) string {
+	payload, _ := json.Marshal(cursor)
+	return encoding.EncodeToString(payload) + "." + encoding.EncodeToString(c.sign(payload))
+}
+
+// Decode проверяет подпись токена и возвращает позицию.
+func (c *Codec) Decode(token string) (Cursor, error) {
+	encodedPayload, encodedSig, ok := strings.Cut(token, ".")
+	if !ok {
+		return Cursor{}, ErrInvalid
+	}
+	payload, err := encoding.DecodeString(encodedPayload)
+	if err != nil {
+		return Cursor{}, ErrInvalid
+	}
+	sig, err := encoding.DecodeString(encodedSig)
+	if err != nil || !hmac.Equal(sig, c.sign(payload)) {
+		return Cursor{}, ErrInvalid
+	}
+
+	var cursor Cursor
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&cursor); err != nil {
+		return Cursor{}, ErrInvalid
+	}
+	return cursor, nil
+}
+
+// DecodeFor декодирует токен и проверяет, что он выдан для выборки list внутри scope.
+func (c *Codec) DecodeFor(token, list, scope string) (Cursor, error) {
+	cursor, err := c.Decode(token)
+	if err != nil {
+		return Cursor{}, err
+	}
+	if cursor.List != list || cursor.Scope != scope {
+		return Cursor{}, ErrInvalid
+	}
+	return cursor, nil
+}
+
+func (c *Codec) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, c.key)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+var encoding = base64.RawURLEncoding
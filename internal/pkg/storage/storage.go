@@ -0,0 +1,34 @@
+// Package storage содержит хранилища файлов, загружаемых пользователями.
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"strings"
+)
+
+var (
+	// ErrNotFound возвращается, когда файла с ключом нет в хранилище.
+	ErrNotFound = errors.New("file not found")
+	// ErrInvalidKey возвращается для ключей, выходящих за пределы хранилища.
+	ErrInvalidKey = errors.New("invalid key")
+)
+
+// Storage - хранилище файлов по ключам вида "каталог/файл".
+// Реализация на локальном диске - Disk, хранилища вроде S3 реализуют тот же интерфейс.
+type Storage interface {
+	// Put сохраняет файл, заменяя файл с тем же ключом.
+	Put(ctx context.Context, key string, r io.Reader) error
+	// Get открывает файл для чтения, ErrNotFound - если его нет.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete удаляет файлы, отсутствующие файлы пропускаются.
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// validKey проверяет, что ключ относительный и не содержит переходов в родительский каталог.
+func validKey(key string) bool {
+	return len(key) != 0 && !strings.HasPrefix(key, "/") && !strings.Contains(key, "\\") &&
+		path.Clean(key) == key && key != "." && key != ".." && !strings.HasPrefix(key, "../")
+}
@@ -0,0 +1,131 @@
+package tlsutil
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"os"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Config - пути к файлам сертификатов.
+// Пустой Config означает соединение без шифрования.
+type Config struct {
+	// CertFile и KeyFile - собственный сертификат и ключ в формате PEM.
+	CertFile string
+	KeyFile  string
+	// CAFile - сертификаты УЦ, которыми проверяется сертификат другой стороны.
+	// Для клиента заменяет системные корневые сертификаты: сервер с сертификатом
+	// общедоступного УЦ не пройдёт проверку, если этого УЦ нет в CAFile.
+	CAFile string
+	// ClientAuth - политика проверки сертификата клиента на сервере (mutual TLS).
+	ClientAuth tls.ClientAuthType
+	// ServerName - ожидаемое имя в сертификате сервера (только для клиента).
+	ServerName string
+}
+
+// Enabled сообщает, настроен ли TLS.
+func (c Config) Enabled() bool {
+	return len(c.CertFile) > 0 || len(c.CAFile) > 0
+}
+
+// ServerConfig возвращает настройки TLS для сервера.
+// Сертификаты перечитываются при изменении файлов, пока reloader не закрыт.
+func ServerConfig(c Config) (*tls.Config, *Reloader, error) {
+	if len(c.CertFile) == 0 || len(c.KeyFile) == 0 {
+		return nil, nil, errors.New("server certificate and key are required")
+	}
+	r, err := NewReloader(c)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: r.GetCertificate,
+		// конфигурация собирается на каждое подключение, чтобы учитывать
+		// перечитанные сертификаты УЦ клиентов
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return &tls.Config{
+				MinVersion:     tls.VersionTLS12,
+				GetCertificate: r.GetCertificate,
+				ClientAuth:     c.ClientAuth,
+				ClientCAs:      r.CertPool(),
+				NextProtos:     []string{"h2", "http/1.1"},
+			}, nil
+		},
+	}
+	return tlsConfig, r, nil
+}
+
+// ClientConfig возвращает настройки TLS для клиента с текущими сертификатами r.
+// Если указан CertFile, клиент предъявляет свой сертификат серверу.
+// Если указан CAFile, сервер проверяется только по его сертификатам, иначе - по системным.
+// Пул УЦ в tls.Config не меняется, поэтому после перечитывания файлов настройки нужно запросить заново.
+func ClientConfig(c Config, r *Reloader) *tls.Config {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// nil - системные корневые сертификаты
+		RootCAs:    r.CertPool(),
+		ServerName: c.ServerName,
+	}
+	if len(c.CertFile) > 0 {
+		tlsConfig.GetClientCertificate = r.GetClientCertificate
+	}
+	return tlsConfig
+}
+
+// DialOption возвращает опцию подключения к gRPC серверу согласно настройкам.
+// Настройки TLS собираются на каждое подключение, чтобы сервер проверялся по перечитанным сертификатам УЦ.
+// Reloader может быть nil, если TLS не настроен.
+func DialOption(c Config) (grpc.DialOption, *Reloader, error) {
+	if !c.Enabled() {
+		return grpc.WithTransportCredentials(insecure.NewCredentials()), nil, nil
+	}
+	r, err := NewReloader(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	return grpc.WithTransportCredentials(&reloadingCredentials{
+		TransportCredentials: credentials.NewTLS(ClientConfig(c, r)),
+		config:               c,
+		reloader:             r,
+	}), r, nil
+}
+
+// reloadingCredentials выполняет рукопожатие клиента с настройками, собранными при подключении.
+// Остальные методы используют настройки на момент создания.
+type reloadingCredentials struct {
+	credentials.TransportCredentials
+	config   Config
+	reloader *Reloader
+}
+
+func (c *reloadingCredentials) ClientHandshake(ctx context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return credentials.NewTLS(ClientConfig(c.config, c.reloader)).ClientHandshake(ctx, authority, conn)
+}
+
+func (c *reloadingCredentials) Clone() credentials.TransportCredentials {
+	return &reloadingCredentials{
+		TransportCredentials: c.TransportCredentials.Clone(),
+		config:               c.config,
+		reloader:             c.reloader,
+	}
+}
+
+func loadCertPool(path string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "os.ReadFile()")
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, errors.Errorf("no certificates found in %s", path)
+	}
+	return pool, nil
+}
@@ -0,0 +1,154 @@
+package tlsutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"log"
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+)
+
+// Reloader хранит сертификаты и перечитывает их при изменении файлов на диске.
+// При ошибке чтения продолжают использоваться ранее загруженные сертификаты.
+type Reloader struct {
+	config  Config
+	watcher *fsnotify.Watcher
+
+	mu   sync.RWMutex
+	cert *tls.Certificate
+	pool *x509.CertPool
+}
+
+// NewReloader загружает сертификаты и начинает следить за изменением файлов.
+func NewReloader(c Config) (*Reloader, error) {
+	r := &Reloader{config: c}
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, errors.Wrap(err, "fsnotify.NewWatcher()")
+	}
+	// следим за каталогами, а не файлами: при ротации файлы часто
+	// заменяются переименованием или сменой символической ссылки
+	dirs := make(map[string]struct{})
+	for _, file := range r.files() {
+		dirs[filepath.Dir(file)] = struct{}{}
+	}
+	for dir := range dirs {
+		if err := watcher.Add(dir); err != nil {
+			watcher.Close()
+			return nil, errors.Wrap(err, "watcher.Add()")
+		}
+	}
+	r.watcher = watcher
+
+	go r.watch()
+	return r, nil
+}
+
+// GetCertificate подходит для tls.Config.GetCertificate.
+func (r *Reloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return r.certificate()
+}
+
+// GetClientCertificate подходит для tls.Config.GetClientCertificate.
+func (r *Reloader) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return r.certificate()
+}
+
+// CertPool возвращает текущие сертификаты УЦ или nil, если CAFile не указан.
+func (r *Reloader) CertPool() *x509.CertPool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.pool
+}
+
+// Close прекращает слежение за файлами.
+func (r *Reloader) Close() error {
+	return r.watcher.Close()
+}
+
+func (r *Reloader) certificate() (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.cert == nil {
+		return nil, errors.New("certificate is not configured")
+	}
+	return r.cert, nil
+}
+
+func (r *Reloader) files() []string {
+	var files []string
+	for _, file := range []string{r.config.CertFile, r.config.KeyFile, r.config.CAFile} {
+		if len(file) > 0 {
+			files = append(files, file)
+		}
+	}
+	return files
+}
+
+func (r *Reloader) reload() error {
+	var (
+		cert *tls.Certificate
+		pool *x509.CertPool
+	)
+	if len(r.config.CertFile) > 0 {
+		c, err := tls.LoadX509KeyPair(r.config.CertFile, r.config.KeyFile)
+		if err != nil {
+			return errors.Wrap(err, "tls.LoadX509KeyPair()")
+		}
+		cert = &c
+	}
+	if len(r.config.CAFile) > 0 {
+		var err error
+		if pool, err = loadCertPool(r.config.CAFile); err != nil {
+			return err
+		}
+	}
+
+	r.mu.Lock()
+	r.cert, r.pool = cert, pool
+	r.mu.Unlock()
+	return nil
+}
+
+func (r *Reloader) watch() {
+	for {
+		select {
+		case event, ok := <-r.watcher.Events:
+			if !ok {
+				return
+			}
+			if !r.affects(event.Name) {
+				continue
+			}
+			if err := r.reload(); err != nil {
+				log.Println("tls: reload certificates:", err)
+				continue
+			}
+			log.Println("tls: certificates reloaded after change of", event.Name)
+		case err, ok := <-r.watcher.Errors:
+			if !ok {
+				return
+			}
+			log.Println("tls: watch certificates:", err)
+		}
+	}
+}
+
+// affects сообщает, относится ли изменённый файл к сертификатам.
+// Изменение служебных файлов каталога (например, ..data в Kubernetes) тоже учитывается.
+func (r *Reloader) affects(name string) bool {
+	name = filepath.Clean(name)
+	for _, file := range r.files() {
+		if filepath.Clean(file) == name {
+			return true
+		}
+	}
+	return filepath.Base(name) == "..data"
+}
@@ -1,11 +1,11 @@
 -- +goose Up
 -- +goose StatementBegin
-AFTER TABLE users
+ALTER TABLE users
     ADD COLUMN IF NOT EXISTS avatar text;
 -- +goose StatementEnd
 
 -- +goose Down
 -- +goose StatementBegin
-AFTER TABLE users
-    DROP COLUMN IF EXISTS avatar text;
+ALTER TABLE users
+    DROP COLUMN IF EXISTS avatar;
 -- +goose StatementEnd
@@ -0,0 +1,63 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS citext;
+
+CREATE TABLE IF NOT EXISTS public.forums (
+    slug      citext       NOT NULL PRIMARY KEY,
+    title     varchar(255) NOT NULL,
+    user_nick varchar(255) NOT NULL REFERENCES users (nickname),
+    posts     bigint       NOT NULL DEFAULT 0,
+    threads   integer      NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS public.threads (
+    id      serial       NOT NULL PRIMARY KEY,
+    slug    citext       UNIQUE,
+    title   varchar(255) NOT NULL,
+    message text         NOT NULL,
+    forum   citext       NOT NULL REFERENCES forums (slug),
+    author  varchar(255) NOT NULL REFERENCES users (nickname),
+    created timestamptz  NOT NULL DEFAULT now(),
+    votes   integer      NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS threads_forum_created_idx ON public.threads (forum, created);
+
+CREATE TABLE IF NOT EXISTS public.posts (
+    id       bigserial    NOT NULL PRIMARY KEY,
+    parent   bigint       NOT NULL DEFAULT 0,
+    path     bigint[]     NOT NULL DEFAULT '{}',
+    thread   integer      NOT NULL REFERENCES threads (id),
+    forum    citext       NOT NULL REFERENCES forums (slug),
+    author   varchar(255) NOT NULL REFERENCES users (nickname),
+    message  text         NOT NULL,
+    isedited boolean      NOT NULL DEFAULT false,
+    created  timestamptz  NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS posts_thread_id_idx ON public.posts (thread, id);
+CREATE INDEX IF NOT EXISTS posts_thread_path_idx ON public.posts (thread, path);
+CREATE INDEX IF NOT EXISTS posts_root_path_idx ON public.posts ((path[1]), path);
+
+CREATE TABLE IF NOT EXISTS public.votes (
+    thread integer      NOT NULL REFERENCES threads (id),
+    author varchar(255) NOT NULL REFERENCES users (nickname),
+    vote   smallint     NOT NULL CONSTRAINT vote_right CHECK (vote IN (-1, 1)),
+    CONSTRAINT votes_thread_author_key UNIQUE (thread, author)
+);
+
+CREATE TABLE IF NOT EXISTS public.users_in_forum (
+    forum    citext       NOT NULL REFERENCES forums (slug),
+    nickname varchar(255) NOT NULL REFERENCES users (nickname),
+    PRIMARY KEY (forum, nickname)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS public.users_in_forum;
+DROP TABLE IF EXISTS public.votes;
+DROP TABLE IF EXISTS public.posts;
+DROP TABLE IF EXISTS public.threads;
+DROP TABLE IF EXISTS public.forums;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS public.user_roles (
+    nickname varchar(255) NOT NULL REFERENCES users (nickname) ON DELETE CASCADE,
+    role     varchar(32)  NOT NULL CONSTRAINT role_right CHECK (role IN ('user', 'moderator', 'forum_owner', 'admin')),
+    -- пустая строка означает глобальную роль, иначе роль действует только в указанном форуме
+    forum    citext       NOT NULL DEFAULT '',
+    PRIMARY KEY (nickname, role, forum)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS public.user_roles;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- path - идентификаторы всех предков сообщения и его собственный, используется для сортировки дерева
+CREATE OR REPLACE FUNCTION public.set_post_path() RETURNS trigger AS $$
+BEGIN
+    NEW.path = COALESCE((SELECT path FROM posts WHERE id = NEW.parent), '{}') || NEW.id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER posts_path
+    BEFORE INSERT ON public.posts
+    FOR EACH ROW EXECUTE PROCEDURE public.set_post_path();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS posts_path ON public.posts;
+DROP FUNCTION IF EXISTS public.set_post_path();
+-- +goose StatementEnd
@@ -0,0 +1,62 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Полнотекстовый поиск: векторы для русской и английской конфигураций,
+-- заголовок ветки весомее её текста
+ALTER TABLE public.threads
+    ADD COLUMN IF NOT EXISTS search_ru tsvector,
+    ADD COLUMN IF NOT EXISTS search_en tsvector;
+
+ALTER TABLE public.posts
+    ADD COLUMN IF NOT EXISTS search_ru tsvector,
+    ADD COLUMN IF NOT EXISTS search_en tsvector;
+
+CREATE OR REPLACE FUNCTION public.set_thread_search() RETURNS trigger AS $$
+BEGIN
+    NEW.search_ru = setweight(to_tsvector('russian', NEW.title), 'A') ||
+                    setweight(to_tsvector('russian', NEW.message), 'B');
+    NEW.search_en = setweight(to_tsvector('english', NEW.title), 'A') ||
+                    setweight(to_tsvector('english', NEW.message), 'B');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION public.set_post_search() RETURNS trigger AS $$
+BEGIN
+    NEW.search_ru = to_tsvector('russian', NEW.message);
+    NEW.search_en = to_tsvector('english', NEW.message);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER threads_search
+    BEFORE INSERT OR UPDATE OF title, message ON public.threads
+    FOR EACH ROW EXECUTE PROCEDURE public.set_thread_search();
+
+CREATE TRIGGER posts_search
+    BEFORE INSERT OR UPDATE OF message ON public.posts
+    FOR EACH ROW EXECUTE PROCEDURE public.set_post_search();
+
+UPDATE public.threads SET title = title;
+UPDATE public.posts SET message = message;
+
+CREATE INDEX IF NOT EXISTS threads_search_ru_idx ON public.threads USING GIN (search_ru);
+CREATE INDEX IF NOT EXISTS threads_search_en_idx ON public.threads USING GIN (search_en);
+CREATE INDEX IF NOT EXISTS posts_search_ru_idx ON public.posts USING GIN (search_ru);
+CREATE INDEX IF NOT EXISTS posts_search_en_idx ON public.posts USING GIN (search_en);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS posts_search ON public.posts;
+DROP TRIGGER IF EXISTS threads_search ON public.threads;
+DROP FUNCTION IF EXISTS public.set_post_search();
+DROP FUNCTION IF EXISTS public.set_thread_search();
+
+ALTER TABLE public.posts
+    DROP COLUMN IF EXISTS search_ru,
+    DROP COLUMN IF EXISTS search_en;
+
+ALTER TABLE public.threads
+    DROP COLUMN IF EXISTS search_ru,
+    DROP COLUMN IF EXISTS search_en;
+-- +goose StatementEnd
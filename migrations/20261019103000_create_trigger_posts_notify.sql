@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Уведомление о новых сообщениях: одно на ветку за запрос, с наибольшим id.
+-- NOTIFY доставляется слушателям при фиксации транзакции.
+CREATE OR REPLACE FUNCTION public.notify_posts_created() RETURNS trigger AS $$
+DECLARE
+    created record;
+BEGIN
+    FOR created IN SELECT thread, MAX(id) AS id FROM new_posts GROUP BY thread LOOP
+        PERFORM pg_notify('forum_events', json_build_object(
+            'thread', created.thread,
+            'kind', 'posts',
+            'id', created.id
+        )::text);
+    END LOOP;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER posts_notify
+    AFTER INSERT ON public.posts
+    REFERENCING NEW TABLE AS new_posts
+    FOR EACH STATEMENT EXECUTE PROCEDURE public.notify_posts_created();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS posts_notify ON public.posts;
+DROP FUNCTION IF EXISTS public.notify_posts_created();
+-- +goose StatementEnd
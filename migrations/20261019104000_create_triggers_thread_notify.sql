@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Уведомления об изменении сообщений и голосов за ветку, в том же канале, что и о новых сообщениях
+CREATE OR REPLACE FUNCTION public.notify_post_edited() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('forum_events', json_build_object(
+        'thread', NEW.thread,
+        'kind', 'post_edited',
+        'id', NEW.id
+    )::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION public.notify_thread_votes() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('forum_events', json_build_object(
+        'thread', NEW.id,
+        'kind', 'votes',
+        'id', NEW.id,
+        'votes', NEW.votes
+    )::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER posts_notify_edited
+    AFTER UPDATE OF message ON public.posts
+    FOR EACH ROW
+    WHEN (OLD.message IS DISTINCT FROM NEW.message)
+    EXECUTE PROCEDURE public.notify_post_edited();
+
+CREATE TRIGGER threads_notify_votes
+    AFTER UPDATE OF votes ON public.threads
+    FOR EACH ROW
+    WHEN (OLD.votes IS DISTINCT FROM NEW.votes)
+    EXECUTE PROCEDURE public.notify_thread_votes();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS threads_notify_votes ON public.threads;
+DROP TRIGGER IF EXISTS posts_notify_edited ON public.posts;
+DROP FUNCTION IF EXISTS public.notify_thread_votes();
+DROP FUNCTION IF EXISTS public.notify_post_edited();
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Доменные события, записанные в одной транзакции с изменением.
+-- Публикуются по порядку id, опубликованные отмечаются published_at.
+CREATE TABLE IF NOT EXISTS public.outbox (
+    id           bigserial PRIMARY KEY,
+    type         text NOT NULL,
+    forum        citext NOT NULL DEFAULT '',
+    payload      jsonb NOT NULL,
+    created      timestamptz NOT NULL DEFAULT now(),
+    published_at timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS outbox_unpublished_idx ON public.outbox (id) WHERE published_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS public.outbox;
+-- +goose StatementEnd
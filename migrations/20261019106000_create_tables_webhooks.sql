@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Адреса, на которые отправляются события форума.
+-- Пустой events означает все события, для которых поддерживаются веб-хуки.
+CREATE TABLE IF NOT EXISTS public.webhooks (
+    id      serial      NOT NULL PRIMARY KEY,
+    forum   citext      NOT NULL REFERENCES forums (slug),
+    url     text        NOT NULL,
+    secret  text        NOT NULL,
+    events  text[]      NOT NULL DEFAULT '{}',
+    created timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS webhooks_forum_idx ON public.webhooks (forum);
+
+-- Доставки событий outbox на веб-хуки.
+-- Доставки со статусом dead исчерпали попытки и составляют список недоставленных.
+CREATE TABLE IF NOT EXISTS public.webhook_deliveries (
+    id            bigserial   NOT NULL PRIMARY KEY,
+    webhook       integer     NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    event         bigint      NOT NULL REFERENCES outbox (id),
+    status        varchar(16) NOT NULL DEFAULT 'pending' CONSTRAINT status_right CHECK (status IN ('pending', 'delivered', 'dead')),
+    attempts      integer     NOT NULL DEFAULT 0,
+    next_attempt  timestamptz NOT NULL DEFAULT now(),
+    response_code integer     NOT NULL DEFAULT 0,
+    last_error    text        NOT NULL DEFAULT '',
+    created       timestamptz NOT NULL DEFAULT now(),
+    delivered     timestamptz,
+    UNIQUE (webhook, event)
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_pending_idx ON public.webhook_deliveries (next_attempt) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS webhook_deliveries_webhook_idx ON public.webhook_deliveries (webhook, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS public.webhook_deliveries;
+DROP TABLE IF EXISTS public.webhooks;
+-- +goose StatementEnd
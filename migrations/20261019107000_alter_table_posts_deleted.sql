@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Удалённые сообщения остаются в дереве ветки, пока не будут удалены окончательно
+ALTER TABLE public.posts
+    ADD COLUMN IF NOT EXISTS deleted_at    timestamptz,
+    ADD COLUMN IF NOT EXISTS deleted_by    varchar(255),
+    ADD COLUMN IF NOT EXISTS delete_reason text NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS posts_deleted_at_idx ON public.posts (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS posts_parent_idx ON public.posts (parent) WHERE parent <> 0;
+
+-- подписчики ветки получают заглушку вместо удалённого сообщения и сообщение после восстановления
+CREATE TRIGGER posts_notify_deleted
+    AFTER UPDATE OF deleted_at ON public.posts
+    FOR EACH ROW
+    WHEN (OLD.deleted_at IS DISTINCT FROM NEW.deleted_at)
+    EXECUTE PROCEDURE public.notify_post_edited();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS posts_notify_deleted ON public.posts;
+DROP INDEX IF EXISTS posts_parent_idx;
+DROP INDEX IF EXISTS posts_deleted_at_idx;
+ALTER TABLE public.posts
+    DROP COLUMN IF EXISTS delete_reason,
+    DROP COLUMN IF EXISTS deleted_by,
+    DROP COLUMN IF EXISTS deleted_at;
+-- +goose StatementEnd
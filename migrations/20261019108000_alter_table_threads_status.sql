@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+-- open - открыта, closed - закрыта для новых сообщений, archived - только для чтения
+ALTER TABLE public.threads
+    ADD COLUMN IF NOT EXISTS status varchar(16) NOT NULL DEFAULT 'open' CONSTRAINT status_right CHECK (status IN ('open', 'closed', 'archived')),
+    ADD COLUMN IF NOT EXISTS pinned boolean     NOT NULL DEFAULT false;
+
+-- закреплённые ветки выводятся в списке форума первыми
+CREATE INDEX IF NOT EXISTS threads_forum_pinned_created_idx ON public.threads (forum, pinned, created, id);
+
+CREATE OR REPLACE FUNCTION public.notify_thread_updated() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('forum_events', json_build_object(
+        'thread', NEW.id,
+        'kind', 'thread_updated',
+        'id', NEW.id
+    )::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER threads_notify_updated
+    AFTER UPDATE OF status, pinned ON public.threads
+    FOR EACH ROW
+    WHEN (OLD.status IS DISTINCT FROM NEW.status OR OLD.pinned IS DISTINCT FROM NEW.pinned)
+    EXECUTE PROCEDURE public.notify_thread_updated();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS threads_notify_updated ON public.threads;
+DROP FUNCTION IF EXISTS public.notify_thread_updated();
+DROP INDEX IF EXISTS threads_forum_pinned_created_idx;
+ALTER TABLE public.threads
+    DROP COLUMN IF EXISTS pinned,
+    DROP COLUMN IF EXISTS status;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Блокировки пользователей. Пустой forum означает блокировку во всех форумах.
+-- Теневая блокировка не запрещает писать, но скрывает новые ветки и сообщения от всех, кроме автора.
+CREATE TABLE IF NOT EXISTS public.bans (
+    id        serial       NOT NULL PRIMARY KEY,
+    nickname  varchar(255) NOT NULL REFERENCES users (nickname) ON DELETE CASCADE,
+    forum     citext       NOT NULL DEFAULT '',
+    shadow    boolean      NOT NULL DEFAULT false,
+    reason    text         NOT NULL DEFAULT '',
+    moderator varchar(255) NOT NULL,
+    created   timestamptz  NOT NULL DEFAULT now(),
+    expires   timestamptz,
+    lifted    timestamptz,
+    lifted_by varchar(255)
+);
+
+CREATE INDEX IF NOT EXISTS bans_nickname_idx ON public.bans (nickname, forum) WHERE lifted IS NULL;
+CREATE INDEX IF NOT EXISTS bans_forum_idx ON public.bans (forum, id);
+
+ALTER TABLE public.threads ADD COLUMN IF NOT EXISTS shadow boolean NOT NULL DEFAULT false;
+ALTER TABLE public.posts ADD COLUMN IF NOT EXISTS shadow boolean NOT NULL DEFAULT false;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE public.posts DROP COLUMN IF EXISTS shadow;
+ALTER TABLE public.threads DROP COLUMN IF EXISTS shadow;
+DROP TABLE IF EXISTS public.bans;
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Решения модераторов по жалобам и ссылки на их результат:
+-- удалённое сообщение, ветку в архиве или блокировку автора.
+CREATE TABLE IF NOT EXISTS public.report_resolutions (
+    id        serial       NOT NULL PRIMARY KEY,
+    forum     citext       NOT NULL REFERENCES forums (slug),
+    action    varchar(16)  NOT NULL CONSTRAINT action_right CHECK (action IN ('dismiss', 'delete', 'archive', 'ban')),
+    moderator varchar(255) NOT NULL,
+    comment   text         NOT NULL DEFAULT '',
+    thread    integer      NOT NULL REFERENCES threads (id) ON DELETE CASCADE,
+    post      bigint       REFERENCES posts (id) ON DELETE SET NULL,
+    ban       integer      REFERENCES bans (id) ON DELETE SET NULL,
+    created   timestamptz  NOT NULL DEFAULT now()
+);
+
+-- Жалобы пользователей. Пустой post означает жалобу на ветку.
+CREATE TABLE IF NOT EXISTS public.reports (
+    id         serial       NOT NULL PRIMARY KEY,
+    forum      citext       NOT NULL REFERENCES forums (slug),
+    thread     integer      NOT NULL REFERENCES threads (id) ON DELETE CASCADE,
+    post       bigint       REFERENCES posts (id) ON DELETE CASCADE,
+    reporter   varchar(255) NOT NULL REFERENCES users (nickname) ON DELETE CASCADE,
+    reason     text         NOT NULL,
+    created    timestamptz  NOT NULL DEFAULT now(),
+    resolution integer      REFERENCES report_resolutions (id)
+);
+
+-- пользователь может держать открытой только одну жалобу на сообщение или ветку
+CREATE UNIQUE INDEX IF NOT EXISTS reports_open_reporter_idx ON public.reports (reporter, thread, COALESCE(post, 0)) WHERE resolution IS NULL;
+CREATE INDEX IF NOT EXISTS reports_open_target_idx ON public.reports (forum, thread, post) WHERE resolution IS NULL;
+CREATE INDEX IF NOT EXISTS reports_resolution_idx ON public.reports (resolution);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS public.reports;
+DROP TABLE IF EXISTS public.report_resolutions;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Реакции пользователей на сообщения, не больше одной на каждый эмодзи.
+CREATE TABLE IF NOT EXISTS public.post_reactions (
+    post    bigint       NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+    author  varchar(255) NOT NULL REFERENCES users (nickname) ON DELETE CASCADE,
+    emoji   text         NOT NULL,
+    created timestamptz  NOT NULL DEFAULT now(),
+    PRIMARY KEY (post, author, emoji)
+);
+
+-- кол-во реакций по эмодзи хранится в сообщении, чтобы не считать их при выводе ветки
+ALTER TABLE public.posts ADD COLUMN IF NOT EXISTS reactions jsonb NOT NULL DEFAULT '{}';
+
+-- подписчики ветки получают сообщение с новым кол-вом реакций
+CREATE TRIGGER posts_notify_reactions
+    AFTER UPDATE OF reactions ON public.posts
+    FOR EACH ROW
+    WHEN (OLD.reactions IS DISTINCT FROM NEW.reactions)
+    EXECUTE PROCEDURE public.notify_post_edited();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS posts_notify_reactions ON public.posts;
+ALTER TABLE public.posts DROP COLUMN IF EXISTS reactions;
+DROP TABLE IF EXISTS public.post_reactions;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Голоса за сообщения, как и за ветки: один голос пользователя, который можно изменить.
+CREATE TABLE IF NOT EXISTS public.post_votes (
+    post   bigint       NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+    author varchar(255) NOT NULL REFERENCES users (nickname),
+    vote   smallint     NOT NULL CONSTRAINT vote_right CHECK (vote IN (-1, 1)),
+    CONSTRAINT post_votes_post_author_key UNIQUE (post, author)
+);
+
+ALTER TABLE public.posts ADD COLUMN IF NOT EXISTS score integer NOT NULL DEFAULT 0;
+
+-- сортировка top: корневые сообщения ветки по убыванию рейтинга
+CREATE INDEX IF NOT EXISTS posts_thread_top_idx ON public.posts (thread, (-score), id) WHERE parent = 0;
+
+-- подписчики ветки получают сообщение с новым рейтингом
+CREATE TRIGGER posts_notify_score
+    AFTER UPDATE OF score ON public.posts
+    FOR EACH ROW
+    WHEN (OLD.score IS DISTINCT FROM NEW.score)
+    EXECUTE PROCEDURE public.notify_post_edited();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS posts_notify_score ON public.posts;
+DROP INDEX IF EXISTS posts_thread_top_idx;
+ALTER TABLE public.posts DROP COLUMN IF EXISTS score;
+DROP TABLE IF EXISTS public.post_votes;
+-- +goose StatementEnd
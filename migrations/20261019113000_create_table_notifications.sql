@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Уведомления пользователей об упоминаниях и ответах на их сообщения.
+-- Пустой post означает упоминание в сообщении ветки.
+CREATE TABLE IF NOT EXISTS public.notifications (
+    id       bigserial    NOT NULL PRIMARY KEY,
+    nickname varchar(255) NOT NULL REFERENCES users (nickname) ON DELETE CASCADE,
+    kind     varchar(16)  NOT NULL CONSTRAINT kind_right CHECK (kind IN ('mention', 'reply')),
+    actor    varchar(255) NOT NULL,
+    forum    citext       NOT NULL REFERENCES forums (slug),
+    thread   integer      NOT NULL REFERENCES threads (id) ON DELETE CASCADE,
+    post     bigint       REFERENCES posts (id) ON DELETE CASCADE,
+    created  timestamptz  NOT NULL DEFAULT now(),
+    read     timestamptz
+);
+
+-- одно уведомление на сообщение: ответ с упоминанием уведомляет только об ответе
+CREATE UNIQUE INDEX IF NOT EXISTS notifications_target_idx ON public.notifications (nickname, thread, COALESCE(post, 0));
+CREATE INDEX IF NOT EXISTS notifications_nickname_idx ON public.notifications (nickname, id DESC);
+CREATE INDEX IF NOT EXISTS notifications_unread_idx ON public.notifications (nickname) WHERE read IS NULL;
+
+-- упоминания ищут пользователей без учёта регистра
+CREATE INDEX IF NOT EXISTS users_nickname_lower_idx ON public.users (lower(nickname));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS public.users_nickname_lower_idx;
+DROP TABLE IF EXISTS public.notifications;
+-- +goose StatementEnd
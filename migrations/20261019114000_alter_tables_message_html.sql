@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- HTML сообщений, отрисованный из Markdown при записи.
+-- Пустое значение у сообщений, созданных до отрисовки, означает отрисовку при чтении.
+ALTER TABLE public.threads
+    ADD COLUMN IF NOT EXISTS message_html text NOT NULL DEFAULT '';
+ALTER TABLE public.posts
+    ADD COLUMN IF NOT EXISTS message_html text NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE public.posts
+    DROP COLUMN IF EXISTS message_html;
+ALTER TABLE public.threads
+    DROP COLUMN IF EXISTS message_html;
+-- +goose StatementEnd
@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Файлы, загруженные пользователями для сообщений. Содержимое хранится в хранилище файлов по storage_key.
+-- Пустой post означает, что файл ещё не прикреплён к сообщению или сообщение удалено окончательно,
+-- такие файлы удаляются фоновой задачей.
+CREATE TABLE IF NOT EXISTS public.attachments (
+    id           bigserial    NOT NULL PRIMARY KEY,
+    owner        varchar(255) NOT NULL REFERENCES users (nickname) ON DELETE CASCADE,
+    post         bigint       REFERENCES posts (id) ON DELETE SET NULL,
+    filename     text         NOT NULL,
+    content_type varchar(255) NOT NULL,
+    size         bigint       NOT NULL CONSTRAINT size_right CHECK (size > 0),
+    storage_key  text         NOT NULL UNIQUE,
+    created      timestamptz  NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS attachments_owner_idx ON public.attachments (owner);
+CREATE INDEX IF NOT EXISTS attachments_post_idx ON public.attachments (post) WHERE post IS NOT NULL;
+CREATE INDEX IF NOT EXISTS attachments_orphan_idx ON public.attachments (created) WHERE post IS NULL;
+
+-- файлы сообщения в jsonb для вывода вместе с сообщением, NULL - если файлов нет
+CREATE OR REPLACE FUNCTION public.post_attachments(post_id bigint) RETURNS jsonb AS $$
+    SELECT jsonb_agg(jsonb_build_object(
+        'id', a.id,
+        'owner', a.owner,
+        'post', a.post,
+        'filename', a.filename,
+        'content_type', a.content_type,
+        'size', a.size,
+        'created', a.created
+    ) ORDER BY a.id)
+    FROM attachments a
+    WHERE a.post = post_id;
+$$ LANGUAGE sql STABLE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP FUNCTION IF EXISTS public.post_attachments(bigint);
+DROP TABLE IF EXISTS public.attachments;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Метки веток сравниваются без учёта регистра, как slug.
+ALTER TABLE public.threads ADD COLUMN IF NOT EXISTS tags citext[] NOT NULL DEFAULT '{}';
+CREATE INDEX IF NOT EXISTS threads_tags_idx ON public.threads USING gin (tags);
+
+-- Метки, из которых выбираются метки веток форума. Пустой список - метки веток произвольные.
+ALTER TABLE public.forums ADD COLUMN IF NOT EXISTS tags citext[] NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE public.forums DROP COLUMN IF EXISTS tags;
+DROP INDEX IF EXISTS public.threads_tags_idx;
+ALTER TABLE public.threads DROP COLUMN IF EXISTS tags;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Опубликованные события удаляются из outbox вместе с их доставками на веб-хуки.
+ALTER TABLE public.webhook_deliveries
+    DROP CONSTRAINT IF EXISTS webhook_deliveries_event_fkey,
+    ADD CONSTRAINT webhook_deliveries_event_fkey FOREIGN KEY (event) REFERENCES outbox (id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_event_idx ON public.webhook_deliveries (event);
+CREATE INDEX IF NOT EXISTS outbox_published_idx ON public.outbox (published_at) WHERE published_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS public.outbox_published_idx;
+DROP INDEX IF EXISTS public.webhook_deliveries_event_idx;
+ALTER TABLE public.webhook_deliveries
+    DROP CONSTRAINT IF EXISTS webhook_deliveries_event_fkey,
+    ADD CONSTRAINT webhook_deliveries_event_fkey FOREIGN KEY (event) REFERENCES outbox (id);
+-- +goose StatementEnd
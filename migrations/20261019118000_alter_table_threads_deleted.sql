@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Удалённые модератором ветки остаются, пока на них ссылаются решения по жалобам,
+-- но не находятся по id и slug. Сообщения ветки удаляются вместе с ней.
+ALTER TABLE public.threads
+    ADD COLUMN IF NOT EXISTS deleted_at    timestamptz,
+    ADD COLUMN IF NOT EXISTS deleted_by    varchar(255),
+    ADD COLUMN IF NOT EXISTS delete_reason text NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE public.threads
+    DROP COLUMN IF EXISTS delete_reason,
+    DROP COLUMN IF EXISTS deleted_by,
+    DROP COLUMN IF EXISTS deleted_at;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Неудачные попытки публикации события. После нескольких неудач событие отмечается failed_at
+-- и больше не публикуется, чтобы не задерживать следующие события.
+ALTER TABLE public.outbox
+    ADD COLUMN IF NOT EXISTS attempts   integer NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS last_error text,
+    ADD COLUMN IF NOT EXISTS failed_at  timestamptz;
+
+DROP INDEX IF EXISTS public.outbox_unpublished_idx;
+CREATE INDEX IF NOT EXISTS outbox_unpublished_idx ON public.outbox (id) WHERE published_at IS NULL AND failed_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS public.outbox_unpublished_idx;
+CREATE INDEX IF NOT EXISTS outbox_unpublished_idx ON public.outbox (id) WHERE published_at IS NULL;
+ALTER TABLE public.outbox
+    DROP COLUMN IF EXISTS failed_at,
+    DROP COLUMN IF EXISTS last_error,
+    DROP COLUMN IF EXISTS attempts;
+-- +goose StatementEnd
@@ -0,0 +1,359 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        (unknown)
+// source: api/attachment.proto
+
+package api
+
+import (
+	models "github.com/storm5758/Forum-test/pkg/api/models"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AttachmentUploadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Имя файла.
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Содержимое файла.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *AttachmentUploadRequest) Reset() {
+	*x = AttachmentUploadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_attachment_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachmentUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentUploadRequest) ProtoMessage() {}
+
+func (x *AttachmentUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_attachment_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentUploadRequest.ProtoReflect.Descriptor instead.
+func (*AttachmentUploadRequest) Descriptor() ([]byte, []int) {
+	return file_api_attachment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AttachmentUploadRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *AttachmentUploadRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type AttachmentDownloadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Идентификатор файла.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AttachmentDownloadRequest) Reset() {
+	*x = AttachmentDownloadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_attachment_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachmentDownloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentDownloadRequest) ProtoMessage() {}
+
+func (x *AttachmentDownloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_attachment_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentDownloadRequest.ProtoReflect.Descriptor instead.
+func (*AttachmentDownloadRequest) Descriptor() ([]byte, []int) {
+	return file_api_attachment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AttachmentDownloadRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AttachmentChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Chunk:
+	//	*AttachmentChunk_Attachment
+	//	*AttachmentChunk_Data
+	Chunk isAttachmentChunk_Chunk `protobuf_oneof:"chunk"`
+}
+
+func (x *AttachmentChunk) Reset() {
+	*x = AttachmentChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_attachment_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachmentChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentChunk) ProtoMessage() {}
+
+func (x *AttachmentChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_api_attachment_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentChunk.ProtoReflect.Descriptor instead.
+func (*AttachmentChunk) Descriptor() ([]byte, []int) {
+	return file_api_attachment_proto_rawDescGZIP(), []int{2}
+}
+
+func (m *AttachmentChunk) GetChunk() isAttachmentChunk_Chunk {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
+}
+
+func (x *AttachmentChunk) GetAttachment() *models.Attachment {
+	if x, ok := x.GetChunk().(*AttachmentChunk_Attachment); ok {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *AttachmentChunk) GetData() []byte {
+	if x, ok := x.GetChunk().(*AttachmentChunk_Data); ok {
+		return x.Data
+	}
+	return nil
+}
+
+type isAttachmentChunk_Chunk interface {
+	isAttachmentChunk_Chunk()
+}
+
+type AttachmentChunk_Attachment struct {
+	// Сведения о файле, первое сообщение потока.
+	Attachment *models.Attachment `protobuf:"bytes,1,opt,name=attachment,proto3,oneof"`
+}
+
+type AttachmentChunk_Data struct {
+	// Очередная часть содержимого файла.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
+}
+
+func (*AttachmentChunk_Attachment) isAttachmentChunk_Chunk() {}
+
+func (*AttachmentChunk_Data) isAttachmentChunk_Chunk() {}
+
+var File_api_attachment_proto protoreflect.FileDescriptor
+
+var file_api_attachment_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69,
+	0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x4f, 0x0a, 0x17, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x31, 0x0a, 0x19, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x54, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46,
+	0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x48,
+	0x00, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x42, 0x07, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x32, 0x96, 0x02, 0x0a,
+	0x0a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x80, 0x01, 0x0a, 0x10,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0x38, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35,
+	0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f,
+	0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x84,
+	0x01, 0x0a, 0x12, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x30, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d,
+	0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68,
+	0x75, 0x6e, 0x6b, 0x30, 0x01, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2f, 0x46, 0x6f,
+	0x72, 0x75, 0x6d, 0x2d, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69,
+	0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_attachment_proto_rawDescOnce sync.Once
+	file_api_attachment_proto_rawDescData = file_api_attachment_proto_rawDesc
+)
+
+func file_api_attachment_proto_rawDescGZIP() []byte {
+	file_api_attachment_proto_rawDescOnce.Do(func() {
+		file_api_attachment_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_attachment_proto_rawDescData)
+	})
+	return file_api_attachment_proto_rawDescData
+}
+
+var file_api_attachment_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_api_attachment_proto_goTypes = []interface{}{
+	(*AttachmentUploadRequest)(nil),   // 0: github.storm5758.Forum_test.api.AttachmentUploadRequest
+	(*AttachmentDownloadRequest)(nil), // 1: github.storm5758.Forum_test.api.AttachmentDownloadRequest
+	(*AttachmentChunk)(nil),           // 2: github.storm5758.Forum_test.api.AttachmentChunk
+	(*models.Attachment)(nil),         // 3: github.storm5758.Forum_test.api.models.Attachment
+}
+var file_api_attachment_proto_depIdxs = []int32{
+	3, // 0: github.storm5758.Forum_test.api.AttachmentChunk.attachment:type_name -> github.storm5758.Forum_test.api.models.Attachment
+	0, // 1: github.storm5758.Forum_test.api.Attachment.AttachmentUpload:input_type -> github.storm5758.Forum_test.api.AttachmentUploadRequest
+	1, // 2: github.storm5758.Forum_test.api.Attachment.AttachmentDownload:input_type -> github.storm5758.Forum_test.api.AttachmentDownloadRequest
+	3, // 3: github.storm5758.Forum_test.api.Attachment.AttachmentUpload:output_type -> github.storm5758.Forum_test.api.models.Attachment
+	2, // 4: github.storm5758.Forum_test.api.Attachment.AttachmentDownload:output_type -> github.storm5758.Forum_test.api.AttachmentChunk
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_api_attachment_proto_init() }
+func file_api_attachment_proto_init() {
+	if File_api_attachment_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_attachment_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttachmentUploadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_attachment_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttachmentDownloadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_attachment_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttachmentChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_api_attachment_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*AttachmentChunk_Attachment)(nil),
+		(*AttachmentChunk_Data)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_attachment_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_attachment_proto_goTypes,
+		DependencyIndexes: file_api_attachment_proto_depIdxs,
+		MessageInfos:      file_api_attachment_proto_msgTypes,
+	}.Build()
+	File_api_attachment_proto = out.File
+	file_api_attachment_proto_rawDesc = nil
+	file_api_attachment_proto_goTypes = nil
+	file_api_attachment_proto_depIdxs = nil
+}
@@ -0,0 +1,192 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: api/attachment.proto
+
+package api
+
+import (
+	context "context"
+	models "github.com/storm5758/Forum-test/pkg/api/models"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// AttachmentClient is the client API for Attachment service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AttachmentClient interface {
+	// Загрузка файла
+	//
+	// Загрузка файла до 8 МБ, который затем прикрепляется к сообщению через PostsCreate.
+	// Файлы пользователя учитываются в его квоте, файлы, не прикреплённые к сообщению
+	// в течение суток, удаляются. Через HTTP шлюз загружается формой multipart/form-data
+	// с файлом в поле file: POST /api/attachments.
+	AttachmentUpload(ctx context.Context, in *AttachmentUploadRequest, opts ...grpc.CallOption) (*models.Attachment, error)
+	// Скачивание файла
+	//
+	// Первым отправляются сведения о файле, затем его содержимое частями.
+	// Файл, ещё не прикреплённый к сообщению, доступен только загрузившему его пользователю.
+	// Через HTTP шлюз скачивается с типом содержимого файла: GET /api/attachments/{id}.
+	AttachmentDownload(ctx context.Context, in *AttachmentDownloadRequest, opts ...grpc.CallOption) (Attachment_AttachmentDownloadClient, error)
+}
+
+type attachmentClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttachmentClient(cc grpc.ClientConnInterface) AttachmentClient {
+	return &attachmentClient{cc}
+}
+
+func (c *attachmentClient) AttachmentUpload(ctx context.Context, in *AttachmentUploadRequest, opts ...grpc.CallOption) (*models.Attachment, error) {
+	out := new(models.Attachment)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Attachment/AttachmentUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentClient) AttachmentDownload(ctx context.Context, in *AttachmentDownloadRequest, opts ...grpc.CallOption) (Attachment_AttachmentDownloadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Attachment_ServiceDesc.Streams[0], "/github.storm5758.Forum_test.api.Attachment/AttachmentDownload", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &attachmentAttachmentDownloadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Attachment_AttachmentDownloadClient interface {
+	Recv() (*AttachmentChunk, error)
+	grpc.ClientStream
+}
+
+type attachmentAttachmentDownloadClient struct {
+	grpc.ClientStream
+}
+
+func (x *attachmentAttachmentDownloadClient) Recv() (*AttachmentChunk, error) {
+	m := new(AttachmentChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// AttachmentServer is the server API for Attachment service.
+// All implementations must embed UnimplementedAttachmentServer
+// for forward compatibility
+type AttachmentServer interface {
+	// Загрузка файла
+	//
+	// Загрузка файла до 8 МБ, который затем прикрепляется к сообщению через PostsCreate.
+	// Файлы пользователя учитываются в его квоте, файлы, не прикреплённые к сообщению
+	// в течение суток, удаляются. Через HTTP шлюз загружается формой multipart/form-data
+	// с файлом в поле file: POST /api/attachments.
+	AttachmentUpload(context.Context, *AttachmentUploadRequest) (*models.Attachment, error)
+	// Скачивание файла
+	//
+	// Первым отправляются сведения о файле, затем его содержимое частями.
+	// Файл, ещё не прикреплённый к сообщению, доступен только загрузившему его пользователю.
+	// Через HTTP шлюз скачивается с типом содержимого файла: GET /api/attachments/{id}.
+	AttachmentDownload(*AttachmentDownloadRequest, Attachment_AttachmentDownloadServer) error
+	mustEmbedUnimplementedAttachmentServer()
+}
+
+// UnimplementedAttachmentServer must be embedded to have forward compatible implementations.
+type UnimplementedAttachmentServer struct {
+}
+
+func (UnimplementedAttachmentServer) AttachmentUpload(context.Context, *AttachmentUploadRequest) (*models.Attachment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachmentUpload not implemented")
+}
+func (UnimplementedAttachmentServer) AttachmentDownload(*AttachmentDownloadRequest, Attachment_AttachmentDownloadServer) error {
+	return status.Errorf(codes.Unimplemented, "method AttachmentDownload not implemented")
+}
+func (UnimplementedAttachmentServer) mustEmbedUnimplementedAttachmentServer() {}
+
+// UnsafeAttachmentServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttachmentServer will
+// result in compilation errors.
+type UnsafeAttachmentServer interface {
+	mustEmbedUnimplementedAttachmentServer()
+}
+
+func RegisterAttachmentServer(s grpc.ServiceRegistrar, srv AttachmentServer) {
+	s.RegisterService(&Attachment_ServiceDesc, srv)
+}
+
+func _Attachment_AttachmentUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachmentUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServer).AttachmentUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.Attachment/AttachmentUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServer).AttachmentUpload(ctx, req.(*AttachmentUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Attachment_AttachmentDownload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AttachmentDownloadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AttachmentServer).AttachmentDownload(m, &attachmentAttachmentDownloadServer{stream})
+}
+
+type Attachment_AttachmentDownloadServer interface {
+	Send(*AttachmentChunk) error
+	grpc.ServerStream
+}
+
+type attachmentAttachmentDownloadServer struct {
+	grpc.ServerStream
+}
+
+func (x *attachmentAttachmentDownloadServer) Send(m *AttachmentChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Attachment_ServiceDesc is the grpc.ServiceDesc for Attachment service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Attachment_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "github.storm5758.Forum_test.api.Attachment",
+	HandlerType: (*AttachmentServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AttachmentUpload",
+			Handler:    _Attachment_AttachmentUpload_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AttachmentDownload",
+			Handler:       _Attachment_AttachmentDownload_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/attachment.proto",
+}
@@ -0,0 +1,619 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        (unknown)
+// source: api/ban.proto
+
+package api
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BanInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Заблокированный пользователь.
+	Nickname string `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	// Форум, пустой для блокировки во всех форумах.
+	Forum string `protobuf:"bytes,3,opt,name=forum,proto3" json:"forum,omitempty"`
+	// Истина для теневой блокировки.
+	Shadow bool `protobuf:"varint,4,opt,name=shadow,proto3" json:"shadow,omitempty"`
+	// Причина блокировки.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Модератор, заблокировавший пользователя.
+	Moderator string `protobuf:"bytes,6,opt,name=moderator,proto3" json:"moderator,omitempty"`
+	// Дата блокировки.
+	Created string `protobuf:"bytes,7,opt,name=created,proto3" json:"created,omitempty"`
+	// Дата окончания блокировки, пустая для бессрочной.
+	Expires string `protobuf:"bytes,8,opt,name=expires,proto3" json:"expires,omitempty"`
+	// Дата досрочного снятия блокировки.
+	Lifted string `protobuf:"bytes,9,opt,name=lifted,proto3" json:"lifted,omitempty"`
+	// Модератор, снявший блокировку.
+	LiftedBy string `protobuf:"bytes,10,opt,name=lifted_by,json=liftedBy,proto3" json:"lifted_by,omitempty"`
+	// Истина, если блокировка действует.
+	Active bool `protobuf:"varint,11,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *BanInfo) Reset() {
+	*x = BanInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ban_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BanInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanInfo) ProtoMessage() {}
+
+func (x *BanInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ban_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanInfo.ProtoReflect.Descriptor instead.
+func (*BanInfo) Descriptor() ([]byte, []int) {
+	return file_api_ban_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BanInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BanInfo) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *BanInfo) GetForum() string {
+	if x != nil {
+		return x.Forum
+	}
+	return ""
+}
+
+func (x *BanInfo) GetShadow() bool {
+	if x != nil {
+		return x.Shadow
+	}
+	return false
+}
+
+func (x *BanInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BanInfo) GetModerator() string {
+	if x != nil {
+		return x.Moderator
+	}
+	return ""
+}
+
+func (x *BanInfo) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+func (x *BanInfo) GetExpires() string {
+	if x != nil {
+		return x.Expires
+	}
+	return ""
+}
+
+func (x *BanInfo) GetLifted() string {
+	if x != nil {
+		return x.Lifted
+	}
+	return ""
+}
+
+func (x *BanInfo) GetLiftedBy() string {
+	if x != nil {
+		return x.LiftedBy
+	}
+	return ""
+}
+
+func (x *BanInfo) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type BanCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Блокируемый пользователь.
+	Nickname string `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	// Форум. Если не указан, пользователь блокируется во всех форумах.
+	Forum string `protobuf:"bytes,2,opt,name=forum,proto3" json:"forum,omitempty"`
+	// Теневая блокировка.
+	Shadow bool `protobuf:"varint,3,opt,name=shadow,proto3" json:"shadow,omitempty"`
+	// Причина блокировки.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Дата окончания блокировки. Если не указана, блокировка бессрочная.
+	Expires string `protobuf:"bytes,5,opt,name=expires,proto3" json:"expires,omitempty"`
+}
+
+func (x *BanCreateRequest) Reset() {
+	*x = BanCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ban_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BanCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanCreateRequest) ProtoMessage() {}
+
+func (x *BanCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ban_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanCreateRequest.ProtoReflect.Descriptor instead.
+func (*BanCreateRequest) Descriptor() ([]byte, []int) {
+	return file_api_ban_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BanCreateRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *BanCreateRequest) GetForum() string {
+	if x != nil {
+		return x.Forum
+	}
+	return ""
+}
+
+func (x *BanCreateRequest) GetShadow() bool {
+	if x != nil {
+		return x.Shadow
+	}
+	return false
+}
+
+func (x *BanCreateRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BanCreateRequest) GetExpires() string {
+	if x != nil {
+		return x.Expires
+	}
+	return ""
+}
+
+type BanListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Форум. Если не указан, выводятся блокировки во всех форумах.
+	Forum string `protobuf:"bytes,1,opt,name=forum,proto3" json:"forum,omitempty"`
+	// Пользователь, блокировки которого нужно вывести.
+	Nickname string `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	// Выводить также снятые и истёкшие блокировки.
+	IncludeInactive bool `protobuf:"varint,3,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	// Максимальное кол-во возвращаемых записей, по умолчанию 20, не больше 100.
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *BanListRequest) Reset() {
+	*x = BanListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ban_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BanListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanListRequest) ProtoMessage() {}
+
+func (x *BanListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ban_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanListRequest.ProtoReflect.Descriptor instead.
+func (*BanListRequest) Descriptor() ([]byte, []int) {
+	return file_api_ban_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BanListRequest) GetForum() string {
+	if x != nil {
+		return x.Forum
+	}
+	return ""
+}
+
+func (x *BanListRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *BanListRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+func (x *BanListRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type BanListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bans []*BanInfo `protobuf:"bytes,1,rep,name=bans,proto3" json:"bans,omitempty"`
+}
+
+func (x *BanListResponse) Reset() {
+	*x = BanListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ban_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BanListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanListResponse) ProtoMessage() {}
+
+func (x *BanListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ban_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanListResponse.ProtoReflect.Descriptor instead.
+func (*BanListResponse) Descriptor() ([]byte, []int) {
+	return file_api_ban_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BanListResponse) GetBans() []*BanInfo {
+	if x != nil {
+		return x.Bans
+	}
+	return nil
+}
+
+type BanLiftRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Идентификатор блокировки.
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *BanLiftRequest) Reset() {
+	*x = BanLiftRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ban_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BanLiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanLiftRequest) ProtoMessage() {}
+
+func (x *BanLiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ban_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanLiftRequest.ProtoReflect.Descriptor instead.
+func (*BanLiftRequest) Descriptor() ([]byte, []int) {
+	return file_api_ban_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BanLiftRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+var File_api_ban_proto protoreflect.FileDescriptor
+
+var file_api_ban_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x1f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35,
+	0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x9a, 0x02, 0x0a, 0x07, 0x42, 0x61, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6e,
+	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
+	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x75, 0x6d,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73,
+	0x68, 0x61, 0x64, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6c, 0x69, 0x66, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x66, 0x74, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x66, 0x74,
+	0x65, 0x64, 0x42, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x94, 0x01, 0x0a,
+	0x10, 0x42, 0x61, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x20, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61,
+	0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x68, 0x61, 0x64, 0x6f,
+	0x77, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x0e, 0x42, 0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x1a, 0x0a, 0x08,
+	0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x49, 0x6e, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x4f, 0x0a, 0x0f, 0x42, 0x61, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x04,
+	0x62, 0x61, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f,
+	0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x62, 0x61, 0x6e, 0x73, 0x22, 0x26, 0x0a, 0x0e, 0x42, 0x61,
+	0x6e, 0x4c, 0x69, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x02,
+	0x69, 0x64, 0x32, 0x91, 0x03, 0x0a, 0x03, 0x42, 0x61, 0x6e, 0x12, 0x7e, 0x0a, 0x09, 0x42, 0x61,
+	0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x31, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d,
+	0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x6e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f,
+	0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x22,
+	0x09, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x73, 0x12, 0x85, 0x01, 0x0a, 0x07, 0x42,
+	0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d,
+	0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x11, 0x12, 0x09, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x73, 0x62, 0x04, 0x62, 0x61,
+	0x6e, 0x73, 0x12, 0x81, 0x01, 0x0a, 0x07, 0x42, 0x61, 0x6e, 0x4c, 0x69, 0x66, 0x74, 0x12, 0x2f,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35,
+	0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x42, 0x61, 0x6e, 0x4c, 0x69, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37,
+	0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x42, 0x61, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x15, 0x22, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x61, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x2f, 0x6c, 0x69, 0x66, 0x74, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2f, 0x46,
+	0x6f, 0x72, 0x75, 0x6d, 0x2d, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70,
+	0x69, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_ban_proto_rawDescOnce sync.Once
+	file_api_ban_proto_rawDescData = file_api_ban_proto_rawDesc
+)
+
+func file_api_ban_proto_rawDescGZIP() []byte {
+	file_api_ban_proto_rawDescOnce.Do(func() {
+		file_api_ban_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_ban_proto_rawDescData)
+	})
+	return file_api_ban_proto_rawDescData
+}
+
+var file_api_ban_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_api_ban_proto_goTypes = []interface{}{
+	(*BanInfo)(nil),          // 0: github.storm5758.Forum_test.api.BanInfo
+	(*BanCreateRequest)(nil), // 1: github.storm5758.Forum_test.api.BanCreateRequest
+	(*BanListRequest)(nil),   // 2: github.storm5758.Forum_test.api.BanListRequest
+	(*BanListResponse)(nil),  // 3: github.storm5758.Forum_test.api.BanListResponse
+	(*BanLiftRequest)(nil),   // 4: github.storm5758.Forum_test.api.BanLiftRequest
+}
+var file_api_ban_proto_depIdxs = []int32{
+	0, // 0: github.storm5758.Forum_test.api.BanListResponse.bans:type_name -> github.storm5758.Forum_test.api.BanInfo
+	1, // 1: github.storm5758.Forum_test.api.Ban.BanCreate:input_type -> github.storm5758.Forum_test.api.BanCreateRequest
+	2, // 2: github.storm5758.Forum_test.api.Ban.BanList:input_type -> github.storm5758.Forum_test.api.BanListRequest
+	4, // 3: github.storm5758.Forum_test.api.Ban.BanLift:input_type -> github.storm5758.Forum_test.api.BanLiftRequest
+	0, // 4: github.storm5758.Forum_test.api.Ban.BanCreate:output_type -> github.storm5758.Forum_test.api.BanInfo
+	3, // 5: github.storm5758.Forum_test.api.Ban.BanList:output_type -> github.storm5758.Forum_test.api.BanListResponse
+	0, // 6: github.storm5758.Forum_test.api.Ban.BanLift:output_type -> github.storm5758.Forum_test.api.BanInfo
+	4, // [4:7] is the sub-list for method output_type
+	1, // [1:4] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_api_ban_proto_init() }
+func file_api_ban_proto_init() {
+	if File_api_ban_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_ban_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BanInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ban_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BanCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ban_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BanListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ban_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BanListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ban_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BanLiftRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_ban_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_ban_proto_goTypes,
+		DependencyIndexes: file_api_ban_proto_depIdxs,
+		MessageInfos:      file_api_ban_proto_msgTypes,
+	}.Build()
+	File_api_ban_proto = out.File
+	file_api_ban_proto_rawDesc = nil
+	file_api_ban_proto_goTypes = nil
+	file_api_ban_proto_depIdxs = nil
+}
@@ -0,0 +1,203 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: api/ban.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// BanClient is the client API for Ban service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BanClient interface {
+	// Блокировка пользователя
+	//
+	// Блокировка пользователя в форуме или во всех форумах.
+	//
+	// Заблокированный пользователь не может создавать ветки и сообщения и голосовать.
+	// При теневой блокировке новые ветки и сообщения пользователя видны только ему самому,
+	// а его голоса не учитываются.
+	BanCreate(ctx context.Context, in *BanCreateRequest, opts ...grpc.CallOption) (*BanInfo, error)
+	// Список блокировок
+	//
+	// Получение блокировок форума или блокировок во всех форумах, начиная с последних.
+	BanList(ctx context.Context, in *BanListRequest, opts ...grpc.CallOption) (*BanListResponse, error)
+	// Снятие блокировки
+	//
+	// Досрочное снятие блокировки.
+	BanLift(ctx context.Context, in *BanLiftRequest, opts ...grpc.CallOption) (*BanInfo, error)
+}
+
+type banClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBanClient(cc grpc.ClientConnInterface) BanClient {
+	return &banClient{cc}
+}
+
+func (c *banClient) BanCreate(ctx context.Context, in *BanCreateRequest, opts ...grpc.CallOption) (*BanInfo, error) {
+	out := new(BanInfo)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Ban/BanCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *banClient) BanList(ctx context.Context, in *BanListRequest, opts ...grpc.CallOption) (*BanListResponse, error) {
+	out := new(BanListResponse)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Ban/BanList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *banClient) BanLift(ctx context.Context, in *BanLiftRequest, opts ...grpc.CallOption) (*BanInfo, error) {
+	out := new(BanInfo)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Ban/BanLift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BanServer is the server API for Ban service.
+// All implementations must embed UnimplementedBanServer
+// for forward compatibility
+type BanServer interface {
+	// Блокировка пользователя
+	//
+	// Блокировка пользователя в форуме или во всех форумах.
+	//
+	// Заблокированный пользователь не может создавать ветки и сообщения и голосовать.
+	// При теневой блокировке новые ветки и сообщения пользователя видны только ему самому,
+	// а его голоса не учитываются.
+	BanCreate(context.Context, *BanCreateRequest) (*BanInfo, error)
+	// Список блокировок
+	//
+	// Получение блокировок форума или блокировок во всех форумах, начиная с последних.
+	BanList(context.Context, *BanListRequest) (*BanListResponse, error)
+	// Снятие блокировки
+	//
+	// Досрочное снятие блокировки.
+	BanLift(context.Context, *BanLiftRequest) (*BanInfo, error)
+	mustEmbedUnimplementedBanServer()
+}
+
+// UnimplementedBanServer must be embedded to have forward compatible implementations.
+type UnimplementedBanServer struct {
+}
+
+func (UnimplementedBanServer) BanCreate(context.Context, *BanCreateRequest) (*BanInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanCreate not implemented")
+}
+func (UnimplementedBanServer) BanList(context.Context, *BanListRequest) (*BanListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanList not implemented")
+}
+func (UnimplementedBanServer) BanLift(context.Context, *BanLiftRequest) (*BanInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanLift not implemented")
+}
+func (UnimplementedBanServer) mustEmbedUnimplementedBanServer() {}
+
+// UnsafeBanServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BanServer will
+// result in compilation errors.
+type UnsafeBanServer interface {
+	mustEmbedUnimplementedBanServer()
+}
+
+func RegisterBanServer(s grpc.ServiceRegistrar, srv BanServer) {
+	s.RegisterService(&Ban_ServiceDesc, srv)
+}
+
+func _Ban_BanCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BanServer).BanCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.Ban/BanCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BanServer).BanCreate(ctx, req.(*BanCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ban_BanList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BanServer).BanList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.Ban/BanList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BanServer).BanList(ctx, req.(*BanListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ban_BanLift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanLiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BanServer).BanLift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.Ban/BanLift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BanServer).BanLift(ctx, req.(*BanLiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Ban_ServiceDesc is the grpc.ServiceDesc for Ban service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Ban_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "github.storm5758.Forum_test.api.Ban",
+	HandlerType: (*BanServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BanCreate",
+			Handler:    _Ban_BanCreate_Handler,
+		},
+		{
+			MethodName: "BanList",
+			Handler:    _Ban_BanList_Handler,
+		},
+		{
+			MethodName: "BanLift",
+			Handler:    _Ban_BanLift_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/ban.proto",
+}
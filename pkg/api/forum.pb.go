@@ -132,6 +132,11 @@ type ForumGetThreadsRequest struct {
 	Since string `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
 	// Идентификатор форума.
 	Slug string `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug,omitempty"`
+	// Токен страницы из next_page_token предыдущего ответа.
+	// Сортировка берётся из токена, since игнорируется.
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Метка, ветви обсуждения с которой выводятся. Сравнивается без учёта регистра.
+	Tag string `protobuf:"bytes,6,opt,name=tag,proto3" json:"tag,omitempty"`
 }
 
 func (x *ForumGetThreadsRequest) Reset() {
@@ -194,6 +199,77 @@ func (x *ForumGetThreadsRequest) GetSlug() string {
 	return ""
 }
 
+func (x *ForumGetThreadsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ForumGetThreadsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type ForumGetThreadsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Threads []*models.Thread `protobuf:"bytes,1,rep,name=threads,proto3" json:"threads,omitempty"`
+	// Токен следующей страницы, пустой на последней странице.
+	// В HTTP-ответе передаётся в заголовке X-Next-Page-Token.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ForumGetThreadsResponse) Reset() {
+	*x = ForumGetThreadsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_forum_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForumGetThreadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForumGetThreadsResponse) ProtoMessage() {}
+
+func (x *ForumGetThreadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_forum_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForumGetThreadsResponse.ProtoReflect.Descriptor instead.
+func (*ForumGetThreadsResponse) Descriptor() ([]byte, []int) {
+	return file_api_forum_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ForumGetThreadsResponse) GetThreads() []*models.Thread {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+func (x *ForumGetThreadsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type ForumGetUsersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -208,12 +284,15 @@ type ForumGetUsersRequest struct {
 	Since string `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
 	// Идентификатор форума.
 	Slug string `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug,omitempty"`
+	// Токен страницы из next_page_token предыдущего ответа.
+	// Сортировка берётся из токена, since игнорируется.
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
 func (x *ForumGetUsersRequest) Reset() {
 	*x = ForumGetUsersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_forum_proto_msgTypes[3]
+		mi := &file_api_forum_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -226,7 +305,7 @@ func (x *ForumGetUsersRequest) String() string {
 func (*ForumGetUsersRequest) ProtoMessage() {}
 
 func (x *ForumGetUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_forum_proto_msgTypes[3]
+	mi := &file_api_forum_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -239,7 +318,7 @@ func (x *ForumGetUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForumGetUsersRequest.ProtoReflect.Descriptor instead.
 func (*ForumGetUsersRequest) Descriptor() ([]byte, []int) {
-	return file_api_forum_proto_rawDescGZIP(), []int{3}
+	return file_api_forum_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ForumGetUsersRequest) GetDesc() bool {
@@ -270,18 +349,27 @@ func (x *ForumGetUsersRequest) GetSlug() string {
 	return ""
 }
 
+func (x *ForumGetUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type ForumGetUsersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Users []*models.User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// Токен следующей страницы, пустой на последней странице.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (x *ForumGetUsersResponse) Reset() {
 	*x = ForumGetUsersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_forum_proto_msgTypes[4]
+		mi := &file_api_forum_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -294,7 +382,7 @@ func (x *ForumGetUsersResponse) String() string {
 func (*ForumGetUsersResponse) ProtoMessage() {}
 
 func (x *ForumGetUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_forum_proto_msgTypes[4]
+	mi := &file_api_forum_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -307,7 +395,7 @@ func (x *ForumGetUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForumGetUsersResponse.ProtoReflect.Descriptor instead.
 func (*ForumGetUsersResponse) Descriptor() ([]byte, []int) {
-	return file_api_forum_proto_rawDescGZIP(), []int{4}
+	return file_api_forum_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ForumGetUsersResponse) GetUsers() []*models.User {
@@ -317,91 +405,381 @@ func (x *ForumGetUsersResponse) GetUsers() []*models.User {
 	return nil
 }
 
+func (x *ForumGetUsersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Идентификатор форума.
+	Slug string `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	// Максимальное кол-во возвращаемых меток.
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_forum_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_forum_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_api_forum_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListTagsRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *ListTagsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Метка и кол-во ветвей обсуждения с ней.
+type TagCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag   string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Count int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *TagCount) Reset() {
+	*x = TagCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_forum_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TagCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagCount) ProtoMessage() {}
+
+func (x *TagCount) ProtoReflect() protoreflect.Message {
+	mi := &file_api_forum_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagCount.ProtoReflect.Descriptor instead.
+func (*TagCount) Descriptor() ([]byte, []int) {
+	return file_api_forum_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TagCount) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TagCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListTagsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags []*TagCount `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Истина, если метки ветвей выбираются из списка меток форума.
+	Curated bool `protobuf:"varint,2,opt,name=curated,proto3" json:"curated,omitempty"`
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_forum_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_forum_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_api_forum_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListTagsResponse) GetTags() []*TagCount {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListTagsResponse) GetCurated() bool {
+	if x != nil {
+		return x.Curated
+	}
+	return false
+}
+
+type ForumSetTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Идентификатор форума.
+	Slug string `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	// Метки форума.
+	Tags []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *ForumSetTagsRequest) Reset() {
+	*x = ForumSetTagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_forum_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForumSetTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForumSetTagsRequest) ProtoMessage() {}
+
+func (x *ForumSetTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_forum_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForumSetTagsRequest.ProtoReflect.Descriptor instead.
+func (*ForumSetTagsRequest) Descriptor() ([]byte, []int) {
+	return file_api_forum_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ForumSetTagsRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *ForumSetTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 var File_api_forum_proto protoreflect.FileDescriptor
 
 var file_api_forum_proto_rawDesc = []byte{
 	0x0a, 0x0f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x1c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68,
-	0x65, 0x76, 0x2e, 0x42, 0x44, 0x5f, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x1a,
-	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f,
-	0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16,
-	0x61, 0x70, 0x69, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x66, 0x6f, 0x72, 0x75, 0x6d,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x15, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x75, 0x73, 0x65, 0x72,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5c, 0x0a, 0x12, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x05,
-	0x66, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68, 0x65, 0x76, 0x2e, 0x42, 0x44,
-	0x5f, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x05, 0x66,
-	0x6f, 0x72, 0x75, 0x6d, 0x22, 0x2e, 0x0a, 0x12, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x47, 0x65, 0x74,
-	0x4f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x04, 0x73, 0x6c,
-	0x75, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x04,
-	0x73, 0x6c, 0x75, 0x67, 0x22, 0x72, 0x0a, 0x16, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x47, 0x65, 0x74,
-	0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x65,
-	0x73, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x18,
-	0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41,
-	0x01, 0x02, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x22, 0x70, 0x0a, 0x14, 0x46, 0x6f, 0x72, 0x75,
-	0x6d, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
-	0x64, 0x65, 0x73, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69,
-	0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65,
-	0x12, 0x18, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
-	0xe2, 0x41, 0x01, 0x02, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x22, 0x58, 0x0a, 0x15, 0x46, 0x6f,
-	0x72, 0x75, 0x6d, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x6d, 0x6f, 0x67, 0x75,
-	0x63, 0x68, 0x65, 0x76, 0x2e, 0x42, 0x44, 0x5f, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75,
-	0x73, 0x65, 0x72, 0x73, 0x32, 0xde, 0x04, 0x0a, 0x05, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x8d,
-	0x01, 0x0a, 0x0b, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x30,
-	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68, 0x65, 0x76,
-	0x2e, 0x42, 0x44, 0x5f, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x6f,
-	0x72, 0x75, 0x6d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68,
-	0x65, 0x76, 0x2e, 0x42, 0x44, 0x5f, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x22, 0x20, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1a, 0x22, 0x11, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x6f, 0x72, 0x75, 0x6d,
-	0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x3a, 0x05, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x8e,
-	0x01, 0x0a, 0x0b, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x47, 0x65, 0x74, 0x4f, 0x6e, 0x65, 0x12, 0x30,
-	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68, 0x65, 0x76,
-	0x2e, 0x42, 0x44, 0x5f, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x6f,
+	0x6f, 0x12, 0x1f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35,
+	0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x16, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x66, 0x6f,
+	0x72, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x61, 0x70, 0x69, 0x2f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x15, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5f, 0x0a, 0x12, 0x46, 0x6f, 0x72,
+	0x75, 0x6d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x49, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35,
+	0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x42, 0x04, 0xe2,
+	0x41, 0x01, 0x02, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x22, 0x2e, 0x0a, 0x12, 0x46, 0x6f,
 	0x72, 0x75, 0x6d, 0x47, 0x65, 0x74, 0x4f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68,
-	0x65, 0x76, 0x2e, 0x42, 0x44, 0x5f, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x22, 0x21, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x6f, 0x72, 0x75, 0x6d,
-	0x2f, 0x7b, 0x73, 0x6c, 0x75, 0x67, 0x7d, 0x2f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12,
-	0x97, 0x01, 0x0a, 0x0f, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65,
-	0x61, 0x64, 0x73, 0x12, 0x34, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x6d, 0x6f, 0x67,
-	0x75, 0x63, 0x68, 0x65, 0x76, 0x2e, 0x42, 0x44, 0x5f, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61,
-	0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68, 0x65, 0x76, 0x2e, 0x42, 0x44, 0x5f, 0x46,
-	0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
-	0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x2f, 0x7b, 0x73, 0x6c, 0x75, 0x67,
-	0x7d, 0x2f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12, 0x99, 0x01, 0x0a, 0x0d, 0x46, 0x6f,
-	0x72, 0x75, 0x6d, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x32, 0x2e, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68, 0x65, 0x76, 0x2e, 0x42, 0x44,
-	0x5f, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x33, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68, 0x65,
-	0x76, 0x2e, 0x42, 0x44, 0x5f, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46,
-	0x6f, 0x72, 0x75, 0x6d, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x2f, 0x7b, 0x73, 0x6c, 0x75, 0x67, 0x7d, 0x2f,
-	0x75, 0x73, 0x65, 0x72, 0x73, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68, 0x65, 0x76, 0x2f, 0x42, 0x44, 0x2d,
-	0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x3b, 0x61, 0x70,
-	0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x18, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
+	0xe2, 0x41, 0x01, 0x02, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x22, 0xa3, 0x01, 0x0a, 0x16, 0x46,
+	0x6f, 0x72, 0x75, 0x6d, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x10,
+	0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67,
+	0x22, 0x8b, 0x01, 0x0a, 0x17, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x07,
+	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38,
+	0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x07, 0x74,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8f,
+	0x01, 0x0a, 0x14, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x04, 0x73, 0x6c, 0x75,
+	0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x83, 0x01, 0x0a, 0x15, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x05, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72,
+	0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x41, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x04, 0x73, 0x6c, 0x75,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x04, 0x73,
+	0x6c, 0x75, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x32, 0x0a, 0x08, 0x54, 0x61, 0x67,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6b, 0x0a,
+	0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3d, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37,
+	0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x54, 0x61, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x63, 0x75, 0x72, 0x61, 0x74, 0x65, 0x64, 0x22, 0x43, 0x0a, 0x13, 0x46, 0x6f,
+	0x72, 0x75, 0x6d, 0x53, 0x65, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x32,
+	0xb4, 0x07, 0x0a, 0x05, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x93, 0x01, 0x0a, 0x0b, 0x46, 0x6f,
+	0x72, 0x75, 0x6d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x33, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72,
+	0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x6f, 0x72, 0x75,
+	0x6d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35,
+	0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x22, 0x20, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x11, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x6f, 0x72, 0x75,
+	0x6d, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x3a, 0x05, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x12,
+	0x94, 0x01, 0x0a, 0x0b, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x47, 0x65, 0x74, 0x4f, 0x6e, 0x65, 0x12,
+	0x33, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37,
+	0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x47, 0x65, 0x74, 0x4f, 0x6e, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x46, 0x6f,
+	0x72, 0x75, 0x6d, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x2f, 0x7b, 0x73, 0x6c, 0x75, 0x67, 0x7d, 0x2f, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0xb0, 0x01, 0x0a, 0x0f, 0x46, 0x6f, 0x72, 0x75, 0x6d,
+	0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12, 0x37, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f,
+	0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x6f, 0x72,
+	0x75, 0x6d, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x47, 0x65, 0x74, 0x54, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x6f, 0x72, 0x75,
+	0x6d, 0x2f, 0x7b, 0x73, 0x6c, 0x75, 0x67, 0x7d, 0x2f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73,
+	0x62, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12, 0x9f, 0x01, 0x0a, 0x0d, 0x46, 0x6f,
+	0x72, 0x75, 0x6d, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x35, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46,
+	0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x6f,
+	0x72, 0x75, 0x6d, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x36, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x2f, 0x7b,
+	0x73, 0x6c, 0x75, 0x67, 0x7d, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x8f, 0x01, 0x0a, 0x08,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12, 0x30, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75,
+	0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f,
+	0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x6f, 0x72, 0x75,
+	0x6d, 0x2f, 0x7b, 0x73, 0x6c, 0x75, 0x67, 0x7d, 0x2f, 0x74, 0x61, 0x67, 0x73, 0x12, 0x96, 0x01,
+	0x0a, 0x0c, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x53, 0x65, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12, 0x34,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35,
+	0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x53, 0x65, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x46, 0x6f,
+	0x72, 0x75, 0x6d, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x2f, 0x7b, 0x73, 0x6c, 0x75, 0x67, 0x7d, 0x2f, 0x74,
+	0x61, 0x67, 0x73, 0x3a, 0x01, 0x2a, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2f, 0x46,
+	0x6f, 0x72, 0x75, 0x6d, 0x2d, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70,
+	0x69, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -416,33 +794,44 @@ func file_api_forum_proto_rawDescGZIP() []byte {
 	return file_api_forum_proto_rawDescData
 }
 
-var file_api_forum_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_api_forum_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_api_forum_proto_goTypes = []interface{}{
-	(*ForumCreateRequest)(nil),     // 0: github.moguchev.BD_Forum.api.ForumCreateRequest
-	(*ForumGetOneRequest)(nil),     // 1: github.moguchev.BD_Forum.api.ForumGetOneRequest
-	(*ForumGetThreadsRequest)(nil), // 2: github.moguchev.BD_Forum.api.ForumGetThreadsRequest
-	(*ForumGetUsersRequest)(nil),   // 3: github.moguchev.BD_Forum.api.ForumGetUsersRequest
-	(*ForumGetUsersResponse)(nil),  // 4: github.moguchev.BD_Forum.api.ForumGetUsersResponse
-	(*models.Forum)(nil),           // 5: github.moguchev.BD_Forum.api.models.Forum
-	(*models.User)(nil),            // 6: github.moguchev.BD_Forum.api.models.User
-	(*models.Thread)(nil),          // 7: github.moguchev.BD_Forum.api.models.Thread
+	(*ForumCreateRequest)(nil),      // 0: github.storm5758.Forum_test.api.ForumCreateRequest
+	(*ForumGetOneRequest)(nil),      // 1: github.storm5758.Forum_test.api.ForumGetOneRequest
+	(*ForumGetThreadsRequest)(nil),  // 2: github.storm5758.Forum_test.api.ForumGetThreadsRequest
+	(*ForumGetThreadsResponse)(nil), // 3: github.storm5758.Forum_test.api.ForumGetThreadsResponse
+	(*ForumGetUsersRequest)(nil),    // 4: github.storm5758.Forum_test.api.ForumGetUsersRequest
+	(*ForumGetUsersResponse)(nil),   // 5: github.storm5758.Forum_test.api.ForumGetUsersResponse
+	(*ListTagsRequest)(nil),         // 6: github.storm5758.Forum_test.api.ListTagsRequest
+	(*TagCount)(nil),                // 7: github.storm5758.Forum_test.api.TagCount
+	(*ListTagsResponse)(nil),        // 8: github.storm5758.Forum_test.api.ListTagsResponse
+	(*ForumSetTagsRequest)(nil),     // 9: github.storm5758.Forum_test.api.ForumSetTagsRequest
+	(*models.Forum)(nil),            // 10: github.storm5758.Forum_test.api.models.Forum
+	(*models.Thread)(nil),           // 11: github.storm5758.Forum_test.api.models.Thread
+	(*models.User)(nil),             // 12: github.storm5758.Forum_test.api.models.User
 }
 var file_api_forum_proto_depIdxs = []int32{
-	5, // 0: github.moguchev.BD_Forum.api.ForumCreateRequest.forum:type_name -> github.moguchev.BD_Forum.api.models.Forum
-	6, // 1: github.moguchev.BD_Forum.api.ForumGetUsersResponse.users:type_name -> github.moguchev.BD_Forum.api.models.User
-	0, // 2: github.moguchev.BD_Forum.api.Forum.ForumCreate:input_type -> github.moguchev.BD_Forum.api.ForumCreateRequest
-	1, // 3: github.moguchev.BD_Forum.api.Forum.ForumGetOne:input_type -> github.moguchev.BD_Forum.api.ForumGetOneRequest
-	2, // 4: github.moguchev.BD_Forum.api.Forum.ForumGetThreads:input_type -> github.moguchev.BD_Forum.api.ForumGetThreadsRequest
-	3, // 5: github.moguchev.BD_Forum.api.Forum.ForumGetUsers:input_type -> github.moguchev.BD_Forum.api.ForumGetUsersRequest
-	5, // 6: github.moguchev.BD_Forum.api.Forum.ForumCreate:output_type -> github.moguchev.BD_Forum.api.models.Forum
-	5, // 7: github.moguchev.BD_Forum.api.Forum.ForumGetOne:output_type -> github.moguchev.BD_Forum.api.models.Forum
-	7, // 8: github.moguchev.BD_Forum.api.Forum.ForumGetThreads:output_type -> github.moguchev.BD_Forum.api.models.Thread
-	4, // 9: github.moguchev.BD_Forum.api.Forum.ForumGetUsers:output_type -> github.moguchev.BD_Forum.api.ForumGetUsersResponse
-	6, // [6:10] is the sub-list for method output_type
-	2, // [2:6] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	10, // 0: github.storm5758.Forum_test.api.ForumCreateRequest.forum:type_name -> github.storm5758.Forum_test.api.models.Forum
+	11, // 1: github.storm5758.Forum_test.api.ForumGetThreadsResponse.threads:type_name -> github.storm5758.Forum_test.api.models.Thread
+	12, // 2: github.storm5758.Forum_test.api.ForumGetUsersResponse.users:type_name -> github.storm5758.Forum_test.api.models.User
+	7,  // 3: github.storm5758.Forum_test.api.ListTagsResponse.tags:type_name -> github.storm5758.Forum_test.api.TagCount
+	0,  // 4: github.storm5758.Forum_test.api.Forum.ForumCreate:input_type -> github.storm5758.Forum_test.api.ForumCreateRequest
+	1,  // 5: github.storm5758.Forum_test.api.Forum.ForumGetOne:input_type -> github.storm5758.Forum_test.api.ForumGetOneRequest
+	2,  // 6: github.storm5758.Forum_test.api.Forum.ForumGetThreads:input_type -> github.storm5758.Forum_test.api.ForumGetThreadsRequest
+	4,  // 7: github.storm5758.Forum_test.api.Forum.ForumGetUsers:input_type -> github.storm5758.Forum_test.api.ForumGetUsersRequest
+	6,  // 8: github.storm5758.Forum_test.api.Forum.ListTags:input_type -> github.storm5758.Forum_test.api.ListTagsRequest
+	9,  // 9: github.storm5758.Forum_test.api.Forum.ForumSetTags:input_type -> github.storm5758.Forum_test.api.ForumSetTagsRequest
+	10, // 10: github.storm5758.Forum_test.api.Forum.ForumCreate:output_type -> github.storm5758.Forum_test.api.models.Forum
+	10, // 11: github.storm5758.Forum_test.api.Forum.ForumGetOne:output_type -> github.storm5758.Forum_test.api.models.Forum
+	3,  // 12: github.storm5758.Forum_test.api.Forum.ForumGetThreads:output_type -> github.storm5758.Forum_test.api.ForumGetThreadsResponse
+	5,  // 13: github.storm5758.Forum_test.api.Forum.ForumGetUsers:output_type -> github.storm5758.Forum_test.api.ForumGetUsersResponse
+	8,  // 14: github.storm5758.Forum_test.api.Forum.ListTags:output_type -> github.storm5758.Forum_test.api.ListTagsResponse
+	10, // 15: github.storm5758.Forum_test.api.Forum.ForumSetTags:output_type -> github.storm5758.Forum_test.api.models.Forum
+	10, // [10:16] is the sub-list for method output_type
+	4,  // [4:10] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_api_forum_proto_init() }
@@ -488,7 +877,7 @@ func file_api_forum_proto_init() {
 			}
 		}
 		file_api_forum_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ForumGetUsersRequest); i {
+			switch v := v.(*ForumGetThreadsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -500,6 +889,18 @@ func file_api_forum_proto_init() {
 			}
 		}
 		file_api_forum_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForumGetUsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_forum_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ForumGetUsersResponse); i {
 			case 0:
 				return &v.state
@@ -511,6 +912,54 @@ func file_api_forum_proto_init() {
 				return nil
 			}
 		}
+		file_api_forum_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTagsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_forum_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_forum_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTagsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_forum_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForumSetTagsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -518,7 +967,7 @@ func file_api_forum_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_forum_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
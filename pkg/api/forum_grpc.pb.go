@@ -36,7 +36,7 @@ type ForumClient interface {
 	// Получение списка ветвей обсужления данного форума.
 	//
 	// Ветви обсуждения выводятся отсортированные по дате создания.
-	ForumGetThreads(ctx context.Context, in *ForumGetThreadsRequest, opts ...grpc.CallOption) (*models.Thread, error)
+	ForumGetThreads(ctx context.Context, in *ForumGetThreadsRequest, opts ...grpc.CallOption) (*ForumGetThreadsResponse, error)
 	// Пользователи данного форума
 	//
 	// Получение списка пользователей, у которых есть пост или ветка обсуждения в данном форуме.
@@ -44,6 +44,16 @@ type ForumClient interface {
 	// Пользователи выводятся отсортированные по nickname в порядке возрастания.
 	// Порядок сотрировки должен соответсвовать побайтовому сравнение в нижнем регистре.
 	ForumGetUsers(ctx context.Context, in *ForumGetUsersRequest, opts ...grpc.CallOption) (*ForumGetUsersResponse, error)
+	// Метки ветвей обсуждения форума
+	//
+	// Получение меток ветвей обсуждения форума с кол-вом ветвей, начиная с самых частых.
+	// Для форума с заданным списком меток выводятся все метки списка.
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	// Изменение списка меток форума
+	//
+	// Замена списка меток, из которых выбираются метки ветвей обсуждения форума.
+	// Пустой список разрешает произвольные метки. Метки существующих ветвей не меняются.
+	ForumSetTags(ctx context.Context, in *ForumSetTagsRequest, opts ...grpc.CallOption) (*models.Forum, error)
 }
 
 type forumClient struct {
@@ -56,7 +66,7 @@ func NewForumClient(cc grpc.ClientConnInterface) ForumClient {
 
 func (c *forumClient) ForumCreate(ctx context.Context, in *ForumCreateRequest, opts ...grpc.CallOption) (*models.Forum, error) {
 	out := new(models.Forum)
-	err := c.cc.Invoke(ctx, "/github.moguchev.BD_Forum.api.Forum/ForumCreate", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Forum/ForumCreate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -65,16 +75,16 @@ func (c *forumClient) ForumCreate(ctx context.Context, in *ForumCreateRequest, o
 
 func (c *forumClient) ForumGetOne(ctx context.Context, in *ForumGetOneRequest, opts ...grpc.CallOption) (*models.Forum, error) {
 	out := new(models.Forum)
-	err := c.cc.Invoke(ctx, "/github.moguchev.BD_Forum.api.Forum/ForumGetOne", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Forum/ForumGetOne", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *forumClient) ForumGetThreads(ctx context.Context, in *ForumGetThreadsRequest, opts ...grpc.CallOption) (*models.Thread, error) {
-	out := new(models.Thread)
-	err := c.cc.Invoke(ctx, "/github.moguchev.BD_Forum.api.Forum/ForumGetThreads", in, out, opts...)
+func (c *forumClient) ForumGetThreads(ctx context.Context, in *ForumGetThreadsRequest, opts ...grpc.CallOption) (*ForumGetThreadsResponse, error) {
+	out := new(ForumGetThreadsResponse)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Forum/ForumGetThreads", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +93,25 @@ func (c *forumClient) ForumGetThreads(ctx context.Context, in *ForumGetThreadsRe
 
 func (c *forumClient) ForumGetUsers(ctx context.Context, in *ForumGetUsersRequest, opts ...grpc.CallOption) (*ForumGetUsersResponse, error) {
 	out := new(ForumGetUsersResponse)
-	err := c.cc.Invoke(ctx, "/github.moguchev.BD_Forum.api.Forum/ForumGetUsers", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Forum/ForumGetUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forumClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Forum/ListTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forumClient) ForumSetTags(ctx context.Context, in *ForumSetTagsRequest, opts ...grpc.CallOption) (*models.Forum, error) {
+	out := new(models.Forum)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Forum/ForumSetTags", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -107,7 +135,7 @@ type ForumServer interface {
 	// Получение списка ветвей обсужления данного форума.
 	//
 	// Ветви обсуждения выводятся отсортированные по дате создания.
-	ForumGetThreads(context.Context, *ForumGetThreadsRequest) (*models.Thread, error)
+	ForumGetThreads(context.Context, *ForumGetThreadsRequest) (*ForumGetThreadsResponse, error)
 	// Пользователи данного форума
 	//
 	// Получение списка пользователей, у которых есть пост или ветка обсуждения в данном форуме.
@@ -115,6 +143,16 @@ type ForumServer interface {
 	// Пользователи выводятся отсортированные по nickname в порядке возрастания.
 	// Порядок сотрировки должен соответсвовать побайтовому сравнение в нижнем регистре.
 	ForumGetUsers(context.Context, *ForumGetUsersRequest) (*ForumGetUsersResponse, error)
+	// Метки ветвей обсуждения форума
+	//
+	// Получение меток ветвей обсуждения форума с кол-вом ветвей, начиная с самых частых.
+	// Для форума с заданным списком меток выводятся все метки списка.
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	// Изменение списка меток форума
+	//
+	// Замена списка меток, из которых выбираются метки ветвей обсуждения форума.
+	// Пустой список разрешает произвольные метки. Метки существующих ветвей не меняются.
+	ForumSetTags(context.Context, *ForumSetTagsRequest) (*models.Forum, error)
 	mustEmbedUnimplementedForumServer()
 }
 
@@ -128,12 +166,18 @@ func (UnimplementedForumServer) ForumCreate(context.Context, *ForumCreateRequest
 func (UnimplementedForumServer) ForumGetOne(context.Context, *ForumGetOneRequest) (*models.Forum, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForumGetOne not implemented")
 }
-func (UnimplementedForumServer) ForumGetThreads(context.Context, *ForumGetThreadsRequest) (*models.Thread, error) {
+func (UnimplementedForumServer) ForumGetThreads(context.Context, *ForumGetThreadsRequest) (*ForumGetThreadsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForumGetThreads not implemented")
 }
 func (UnimplementedForumServer) ForumGetUsers(context.Context, *ForumGetUsersRequest) (*ForumGetUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForumGetUsers not implemented")
 }
+func (UnimplementedForumServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedForumServer) ForumSetTags(context.Context, *ForumSetTagsRequest) (*models.Forum, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForumSetTags not implemented")
+}
 func (UnimplementedForumServer) mustEmbedUnimplementedForumServer() {}
 
 // UnsafeForumServer may be embedded to opt out of forward compatibility for this service.
@@ -157,7 +201,7 @@ func _Forum_ForumCreate_Handler(srv interface{}, ctx context.Context, dec func(i
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/github.moguchev.BD_Forum.api.Forum/ForumCreate",
+		FullMethod: "/github.storm5758.Forum_test.api.Forum/ForumCreate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ForumServer).ForumCreate(ctx, req.(*ForumCreateRequest))
@@ -175,7 +219,7 @@ func _Forum_ForumGetOne_Handler(srv interface{}, ctx context.Context, dec func(i
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/github.moguchev.BD_Forum.api.Forum/ForumGetOne",
+		FullMethod: "/github.storm5758.Forum_test.api.Forum/ForumGetOne",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ForumServer).ForumGetOne(ctx, req.(*ForumGetOneRequest))
@@ -193,7 +237,7 @@ func _Forum_ForumGetThreads_Handler(srv interface{}, ctx context.Context, dec fu
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/github.moguchev.BD_Forum.api.Forum/ForumGetThreads",
+		FullMethod: "/github.storm5758.Forum_test.api.Forum/ForumGetThreads",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ForumServer).ForumGetThreads(ctx, req.(*ForumGetThreadsRequest))
@@ -211,7 +255,7 @@ func _Forum_ForumGetUsers_Handler(srv interface{}, ctx context.Context, dec func
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/github.moguchev.BD_Forum.api.Forum/ForumGetUsers",
+		FullMethod: "/github.storm5758.Forum_test.api.Forum/ForumGetUsers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ForumServer).ForumGetUsers(ctx, req.(*ForumGetUsersRequest))
@@ -219,11 +263,47 @@ func _Forum_ForumGetUsers_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Forum_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForumServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.Forum/ListTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForumServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Forum_ForumSetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForumSetTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForumServer).ForumSetTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.Forum/ForumSetTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForumServer).ForumSetTags(ctx, req.(*ForumSetTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Forum_ServiceDesc is the grpc.ServiceDesc for Forum service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var Forum_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "github.moguchev.BD_Forum.api.Forum",
+	ServiceName: "github.storm5758.Forum_test.api.Forum",
 	HandlerType: (*ForumServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
@@ -242,6 +322,14 @@ var Forum_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ForumGetUsers",
 			Handler:    _Forum_ForumGetUsers_Handler,
 		},
+		{
+			MethodName: "ListTags",
+			Handler:    _Forum_ListTags_Handler,
+		},
+		{
+			MethodName: "ForumSetTags",
+			Handler:    _Forum_ForumSetTags_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/forum.proto",
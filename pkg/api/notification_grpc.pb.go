@@ -0,0 +1,193 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: api/notification.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// NotificationClient is the client API for Notification service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NotificationClient interface {
+	// Уведомления пользователя
+	//
+	// Уведомления об упоминаниях @nickname и ответах на сообщения пользователя,
+	// начиная с последних.
+	NotificationList(ctx context.Context, in *NotificationListRequest, opts ...grpc.CallOption) (*NotificationListResponse, error)
+	// Отметка уведомлений прочитанными
+	//
+	// Отметка перечисленных уведомлений или всех уведомлений пользователя.
+	NotificationMarkRead(ctx context.Context, in *NotificationMarkReadRequest, opts ...grpc.CallOption) (*NotificationMarkReadResponse, error)
+	// Кол-во непрочитанных уведомлений
+	NotificationUnreadCount(ctx context.Context, in *NotificationUnreadCountRequest, opts ...grpc.CallOption) (*NotificationUnreadCountResponse, error)
+}
+
+type notificationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotificationClient(cc grpc.ClientConnInterface) NotificationClient {
+	return &notificationClient{cc}
+}
+
+func (c *notificationClient) NotificationList(ctx context.Context, in *NotificationListRequest, opts ...grpc.CallOption) (*NotificationListResponse, error) {
+	out := new(NotificationListResponse)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Notification/NotificationList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationClient) NotificationMarkRead(ctx context.Context, in *NotificationMarkReadRequest, opts ...grpc.CallOption) (*NotificationMarkReadResponse, error) {
+	out := new(NotificationMarkReadResponse)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Notification/NotificationMarkRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationClient) NotificationUnreadCount(ctx context.Context, in *NotificationUnreadCountRequest, opts ...grpc.CallOption) (*NotificationUnreadCountResponse, error) {
+	out := new(NotificationUnreadCountResponse)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Notification/NotificationUnreadCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotificationServer is the server API for Notification service.
+// All implementations must embed UnimplementedNotificationServer
+// for forward compatibility
+type NotificationServer interface {
+	// Уведомления пользователя
+	//
+	// Уведомления об упоминаниях @nickname и ответах на сообщения пользователя,
+	// начиная с последних.
+	NotificationList(context.Context, *NotificationListRequest) (*NotificationListResponse, error)
+	// Отметка уведомлений прочитанными
+	//
+	// Отметка перечисленных уведомлений или всех уведомлений пользователя.
+	NotificationMarkRead(context.Context, *NotificationMarkReadRequest) (*NotificationMarkReadResponse, error)
+	// Кол-во непрочитанных уведомлений
+	NotificationUnreadCount(context.Context, *NotificationUnreadCountRequest) (*NotificationUnreadCountResponse, error)
+	mustEmbedUnimplementedNotificationServer()
+}
+
+// UnimplementedNotificationServer must be embedded to have forward compatible implementations.
+type UnimplementedNotificationServer struct {
+}
+
+func (UnimplementedNotificationServer) NotificationList(context.Context, *NotificationListRequest) (*NotificationListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotificationList not implemented")
+}
+func (UnimplementedNotificationServer) NotificationMarkRead(context.Context, *NotificationMarkReadRequest) (*NotificationMarkReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotificationMarkRead not implemented")
+}
+func (UnimplementedNotificationServer) NotificationUnreadCount(context.Context, *NotificationUnreadCountRequest) (*NotificationUnreadCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotificationUnreadCount not implemented")
+}
+func (UnimplementedNotificationServer) mustEmbedUnimplementedNotificationServer() {}
+
+// UnsafeNotificationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotificationServer will
+// result in compilation errors.
+type UnsafeNotificationServer interface {
+	mustEmbedUnimplementedNotificationServer()
+}
+
+func RegisterNotificationServer(s grpc.ServiceRegistrar, srv NotificationServer) {
+	s.RegisterService(&Notification_ServiceDesc, srv)
+}
+
+func _Notification_NotificationList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServer).NotificationList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.Notification/NotificationList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServer).NotificationList(ctx, req.(*NotificationListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notification_NotificationMarkRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationMarkReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServer).NotificationMarkRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.Notification/NotificationMarkRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServer).NotificationMarkRead(ctx, req.(*NotificationMarkReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notification_NotificationUnreadCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationUnreadCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServer).NotificationUnreadCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.Notification/NotificationUnreadCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServer).NotificationUnreadCount(ctx, req.(*NotificationUnreadCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Notification_ServiceDesc is the grpc.ServiceDesc for Notification service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Notification_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "github.storm5758.Forum_test.api.Notification",
+	HandlerType: (*NotificationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "NotificationList",
+			Handler:    _Notification_NotificationList_Handler,
+		},
+		{
+			MethodName: "NotificationMarkRead",
+			Handler:    _Notification_NotificationMarkRead_Handler,
+		},
+		{
+			MethodName: "NotificationUnreadCount",
+			Handler:    _Notification_NotificationUnreadCount_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/notification.proto",
+}
@@ -68,7 +68,7 @@ func (x PostGetOneRequest_Related) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PostGetOneRequest_Related.Descriptor instead.
 func (PostGetOneRequest_Related) EnumDescriptor() ([]byte, []int) {
-	return file_api_post_proto_rawDescGZIP(), []int{1, 0}
+	return file_api_post_proto_rawDescGZIP(), []int{2, 0}
 }
 
 type PostsCreateRequest struct {
@@ -77,7 +77,7 @@ type PostsCreateRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Список создаваемых постов.
-	Posts *models.Post `protobuf:"bytes,1,opt,name=posts,proto3" json:"posts,omitempty"`
+	Posts []*models.Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
 	// Идентификатор ветки обсуждения.
 	SlugOrId string `protobuf:"bytes,2,opt,name=slug_or_id,json=slugOrId,proto3" json:"slug_or_id,omitempty"`
 }
@@ -114,7 +114,7 @@ func (*PostsCreateRequest) Descriptor() ([]byte, []int) {
 	return file_api_post_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *PostsCreateRequest) GetPosts() *models.Post {
+func (x *PostsCreateRequest) GetPosts() []*models.Post {
 	if x != nil {
 		return x.Posts
 	}
@@ -128,6 +128,54 @@ func (x *PostsCreateRequest) GetSlugOrId() string {
 	return ""
 }
 
+type PostsCreateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Созданные посты в том же порядке, что и в запросе.
+	Posts []*models.Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+}
+
+func (x *PostsCreateResponse) Reset() {
+	*x = PostsCreateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_post_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostsCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostsCreateResponse) ProtoMessage() {}
+
+func (x *PostsCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_post_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostsCreateResponse.ProtoReflect.Descriptor instead.
+func (*PostsCreateResponse) Descriptor() ([]byte, []int) {
+	return file_api_post_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PostsCreateResponse) GetPosts() []*models.Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
 type PostGetOneRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -139,13 +187,13 @@ type PostGetOneRequest struct {
 	//
 	// Если тип объекта не указан, то полная информация об этих объектах не
 	// передаётся.
-	Related []PostGetOneRequest_Related `protobuf:"varint,2,rep,packed,name=related,proto3,enum=github.moguchev.BD_Forum.api.PostGetOneRequest_Related" json:"related,omitempty"`
+	Related []PostGetOneRequest_Related `protobuf:"varint,2,rep,packed,name=related,proto3,enum=github.storm5758.Forum_test.api.PostGetOneRequest_Related" json:"related,omitempty"`
 }
 
 func (x *PostGetOneRequest) Reset() {
 	*x = PostGetOneRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_post_proto_msgTypes[1]
+		mi := &file_api_post_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -158,7 +206,7 @@ func (x *PostGetOneRequest) String() string {
 func (*PostGetOneRequest) ProtoMessage() {}
 
 func (x *PostGetOneRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_post_proto_msgTypes[1]
+	mi := &file_api_post_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -171,7 +219,7 @@ func (x *PostGetOneRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PostGetOneRequest.ProtoReflect.Descriptor instead.
 func (*PostGetOneRequest) Descriptor() ([]byte, []int) {
-	return file_api_post_proto_rawDescGZIP(), []int{1}
+	return file_api_post_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *PostGetOneRequest) GetId() int64 {
@@ -202,7 +250,7 @@ type PostUpdateRequest struct {
 func (x *PostUpdateRequest) Reset() {
 	*x = PostUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_post_proto_msgTypes[2]
+		mi := &file_api_post_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -215,7 +263,7 @@ func (x *PostUpdateRequest) String() string {
 func (*PostUpdateRequest) ProtoMessage() {}
 
 func (x *PostUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_post_proto_msgTypes[2]
+	mi := &file_api_post_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -228,7 +276,7 @@ func (x *PostUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PostUpdateRequest.ProtoReflect.Descriptor instead.
 func (*PostUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_api_post_proto_rawDescGZIP(), []int{2}
+	return file_api_post_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *PostUpdateRequest) GetId() int64 {
@@ -245,6 +293,464 @@ func (x *PostUpdateRequest) GetPost() *PostUpdateRequest_PostUpdate {
 	return nil
 }
 
+type PostDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Идентификатор сообщения.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Причина удаления.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *PostDeleteRequest) Reset() {
+	*x = PostDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_post_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostDeleteRequest) ProtoMessage() {}
+
+func (x *PostDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_post_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostDeleteRequest.ProtoReflect.Descriptor instead.
+func (*PostDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_api_post_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PostDeleteRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PostDeleteRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type PostRestoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Идентификатор сообщения.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *PostRestoreRequest) Reset() {
+	*x = PostRestoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_post_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostRestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostRestoreRequest) ProtoMessage() {}
+
+func (x *PostRestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_post_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostRestoreRequest.ProtoReflect.Descriptor instead.
+func (*PostRestoreRequest) Descriptor() ([]byte, []int) {
+	return file_api_post_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PostRestoreRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PostVoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Идентификатор сообщения.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Информация о голосовании пользователя.
+	Vote *models.Vote `protobuf:"bytes,2,opt,name=vote,proto3" json:"vote,omitempty"`
+}
+
+func (x *PostVoteRequest) Reset() {
+	*x = PostVoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_post_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostVoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostVoteRequest) ProtoMessage() {}
+
+func (x *PostVoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_post_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostVoteRequest.ProtoReflect.Descriptor instead.
+func (*PostVoteRequest) Descriptor() ([]byte, []int) {
+	return file_api_post_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PostVoteRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PostVoteRequest) GetVote() *models.Vote {
+	if x != nil {
+		return x.Vote
+	}
+	return nil
+}
+
+type PostReactRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Идентификатор сообщения.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Эмодзи реакции.
+	Emoji string `protobuf:"bytes,2,opt,name=emoji,proto3" json:"emoji,omitempty"`
+}
+
+func (x *PostReactRequest) Reset() {
+	*x = PostReactRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_post_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostReactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostReactRequest) ProtoMessage() {}
+
+func (x *PostReactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_post_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostReactRequest.ProtoReflect.Descriptor instead.
+func (*PostReactRequest) Descriptor() ([]byte, []int) {
+	return file_api_post_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PostReactRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PostReactRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type PostUnreactRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Идентификатор сообщения.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Эмодзи реакции.
+	Emoji string `protobuf:"bytes,2,opt,name=emoji,proto3" json:"emoji,omitempty"`
+}
+
+func (x *PostUnreactRequest) Reset() {
+	*x = PostUnreactRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_post_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostUnreactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostUnreactRequest) ProtoMessage() {}
+
+func (x *PostUnreactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_post_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostUnreactRequest.ProtoReflect.Descriptor instead.
+func (*PostUnreactRequest) Descriptor() ([]byte, []int) {
+	return file_api_post_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PostUnreactRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PostUnreactRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type ReactionListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReactionListRequest) Reset() {
+	*x = ReactionListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_post_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReactionListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionListRequest) ProtoMessage() {}
+
+func (x *ReactionListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_post_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionListRequest.ProtoReflect.Descriptor instead.
+func (*ReactionListRequest) Descriptor() ([]byte, []int) {
+	return file_api_post_proto_rawDescGZIP(), []int{9}
+}
+
+type ReactionListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Эмодзи в порядке вывода.
+	Emoji []string `protobuf:"bytes,1,rep,name=emoji,proto3" json:"emoji,omitempty"`
+}
+
+func (x *ReactionListResponse) Reset() {
+	*x = ReactionListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_post_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReactionListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionListResponse) ProtoMessage() {}
+
+func (x *ReactionListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_post_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionListResponse.ProtoReflect.Descriptor instead.
+func (*ReactionListResponse) Descriptor() ([]byte, []int) {
+	return file_api_post_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReactionListResponse) GetEmoji() []string {
+	if x != nil {
+		return x.Emoji
+	}
+	return nil
+}
+
+type PreviewMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Сообщение в Markdown.
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *PreviewMessageRequest) Reset() {
+	*x = PreviewMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_post_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewMessageRequest) ProtoMessage() {}
+
+func (x *PreviewMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_post_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewMessageRequest.ProtoReflect.Descriptor instead.
+func (*PreviewMessageRequest) Descriptor() ([]byte, []int) {
+	return file_api_post_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PreviewMessageRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type PreviewMessageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Сообщение, отрисованное в HTML.
+	MessageHtml string `protobuf:"bytes,1,opt,name=message_html,json=messageHtml,proto3" json:"message_html,omitempty"`
+}
+
+func (x *PreviewMessageResponse) Reset() {
+	*x = PreviewMessageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_post_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewMessageResponse) ProtoMessage() {}
+
+func (x *PreviewMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_post_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewMessageResponse.ProtoReflect.Descriptor instead.
+func (*PreviewMessageResponse) Descriptor() ([]byte, []int) {
+	return file_api_post_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PreviewMessageResponse) GetMessageHtml() string {
+	if x != nil {
+		return x.MessageHtml
+	}
+	return ""
+}
+
 // Сообщение для обновления сообщения внутри ветки на форуме.
 // Пустые параметры остаются без изменений.
 type PostUpdateRequest_PostUpdate struct {
@@ -259,7 +765,7 @@ type PostUpdateRequest_PostUpdate struct {
 func (x *PostUpdateRequest_PostUpdate) Reset() {
 	*x = PostUpdateRequest_PostUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_post_proto_msgTypes[3]
+		mi := &file_api_post_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -272,7 +778,7 @@ func (x *PostUpdateRequest_PostUpdate) String() string {
 func (*PostUpdateRequest_PostUpdate) ProtoMessage() {}
 
 func (x *PostUpdateRequest_PostUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_api_post_proto_msgTypes[3]
+	mi := &file_api_post_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -285,7 +791,7 @@ func (x *PostUpdateRequest_PostUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PostUpdateRequest_PostUpdate.ProtoReflect.Descriptor instead.
 func (*PostUpdateRequest_PostUpdate) Descriptor() ([]byte, []int) {
-	return file_api_post_proto_rawDescGZIP(), []int{2, 0}
+	return file_api_post_proto_rawDescGZIP(), []int{3, 0}
 }
 
 func (x *PostUpdateRequest_PostUpdate) GetMessage() string {
@@ -299,79 +805,189 @@ var File_api_post_proto protoreflect.FileDescriptor
 
 var file_api_post_proto_rawDesc = []byte{
 	0x0a, 0x0e, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x1c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68, 0x65,
-	0x76, 0x2e, 0x42, 0x44, 0x5f, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x1a, 0x1c,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62,
-	0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x61,
-	0x70, 0x69, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x61, 0x70,
-	0x69, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x7f, 0x0a, 0x12, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x05, 0x70, 0x6f, 0x73,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68, 0x65, 0x76, 0x2e, 0x42, 0x44, 0x5f, 0x46, 0x6f,
-	0x72, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
+	0x12, 0x1f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37,
+	0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x16, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x66, 0x6f, 0x72,
+	0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x17, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x74, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x82, 0x01, 0x0a, 0x12, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
 	0x6f, 0x73, 0x74, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73,
 	0x12, 0x22, 0x0a, 0x0a, 0x73, 0x6c, 0x75, 0x67, 0x5f, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x73, 0x6c, 0x75, 0x67,
-	0x4f, 0x72, 0x49, 0x64, 0x22, 0xc0, 0x01, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x74, 0x47, 0x65, 0x74,
-	0x4f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x51, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0e, 0x32, 0x37, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x6d, 0x6f, 0x67, 0x75, 0x63,
-	0x68, 0x65, 0x76, 0x2e, 0x42, 0x44, 0x5f, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x47, 0x65, 0x74, 0x4f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x61,
-	0x74, 0x65, 0x64, 0x22, 0x42, 0x0a, 0x07, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x12, 0x10,
-	0x0a, 0x0c, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x00,
-	0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x46, 0x4f, 0x52, 0x55,
-	0x4d, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x54,
-	0x48, 0x52, 0x45, 0x41, 0x44, 0x10, 0x02, 0x22, 0xa7, 0x01, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x74,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x54, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x3a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x6d, 0x6f, 0x67, 0x75, 0x63,
-	0x68, 0x65, 0x76, 0x2e, 0x42, 0x44, 0x5f, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x04, 0xe2,
-	0x41, 0x01, 0x02, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x1a, 0x26, 0x0a, 0x0a, 0x50, 0x6f, 0x73,
-	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x32, 0xc3, 0x03, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x9a, 0x01, 0x0a, 0x0b, 0x50,
-	0x6f, 0x73, 0x74, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x30, 0x2e, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68, 0x65, 0x76, 0x2e, 0x42, 0x44, 0x5f,
-	0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68, 0x65, 0x76, 0x2e, 0x42,
-	0x44, 0x5f, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x22,
-	0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2f, 0x7b, 0x73, 0x6c,
-	0x75, 0x67, 0x5f, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x3a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x8c, 0x01, 0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x74,
-	0x47, 0x65, 0x74, 0x4f, 0x6e, 0x65, 0x12, 0x2f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68, 0x65, 0x76, 0x2e, 0x42, 0x44, 0x5f, 0x46, 0x6f, 0x72, 0x75,
-	0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x47, 0x65, 0x74, 0x4f, 0x6e, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68, 0x65, 0x76, 0x2e, 0x42, 0x44, 0x5f, 0x46, 0x6f, 0x72,
-	0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x6f,
-	0x73, 0x74, 0x46, 0x75, 0x6c, 0x6c, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x64,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x8e, 0x01, 0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x74, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x6d,
-	0x6f, 0x67, 0x75, 0x63, 0x68, 0x65, 0x76, 0x2e, 0x42, 0x44, 0x5f, 0x46, 0x6f, 0x72, 0x75, 0x6d,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68, 0x65, 0x76, 0x2e, 0x42, 0x44, 0x5f, 0x46, 0x6f, 0x72, 0x75,
-	0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x6f, 0x73,
-	0x74, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x70, 0x6f, 0x73, 0x74, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x73, 0x3a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68, 0x65, 0x76, 0x2f, 0x42,
-	0x44, 0x2d, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x3b,
-	0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4f, 0x72, 0x49, 0x64, 0x22, 0x59, 0x0a, 0x13, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x05, 0x70,
+	0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f,
+	0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22,
+	0xc3, 0x01, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x74, 0x47, 0x65, 0x74, 0x4f, 0x6e, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x02, 0x69, 0x64, 0x12, 0x54, 0x0a, 0x07, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x3a, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e,
+	0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50,
+	0x6f, 0x73, 0x74, 0x47, 0x65, 0x74, 0x4f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65,
+	0x64, 0x22, 0x42, 0x0a, 0x07, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x0c,
+	0x52, 0x45, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x00, 0x12, 0x11,
+	0x0a, 0x0d, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x46, 0x4f, 0x52, 0x55, 0x4d, 0x10,
+	0x01, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x54, 0x48, 0x52,
+	0x45, 0x41, 0x44, 0x10, 0x02, 0x22, 0xaa, 0x01, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x74, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x57, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x3d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37,
+	0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x04,
+	0xe2, 0x41, 0x01, 0x02, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x1a, 0x26, 0x0a, 0x0a, 0x50, 0x6f,
+	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x41, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x2a, 0x0a, 0x12, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x6f, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x02, 0x69, 0x64, 0x12, 0x46, 0x0a, 0x04, 0x76, 0x6f,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75,
+	0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x04, 0x76, 0x6f,
+	0x74, 0x65, 0x22, 0x44, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x05,
+	0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01,
+	0x02, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x22, 0x46, 0x0a, 0x12, 0x50, 0x6f, 0x73, 0x74,
+	0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69,
+	0x22, 0x15, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2c, 0x0a, 0x14, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x22, 0x37, 0x0a, 0x15, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3b,
+	0x0a, 0x16, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x5f, 0x68, 0x74, 0x6d, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x74, 0x6d, 0x6c, 0x32, 0xfb, 0x0b, 0x0a, 0x04,
+	0x50, 0x6f, 0x73, 0x74, 0x12, 0xaf, 0x01, 0x0a, 0x0b, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x12, 0x33, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72,
+	0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x2f, 0x7b, 0x73, 0x6c, 0x75, 0x67, 0x5f, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x3a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x62,
+	0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x92, 0x01, 0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x74, 0x47,
+	0x65, 0x74, 0x4f, 0x6e, 0x65, 0x12, 0x32, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x47, 0x65, 0x74, 0x4f,
+	0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72,
+	0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x46, 0x75, 0x6c, 0x6c, 0x22, 0x1e, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x94, 0x01, 0x0a, 0x0a,
+	0x50, 0x6f, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x32, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f,
+	0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6f, 0x73,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35,
+	0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x22, 0x24, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1e, 0x22, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x3a, 0x04, 0x70, 0x6f,
+	0x73, 0x74, 0x12, 0x86, 0x01, 0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x12, 0x32, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d,
+	0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
+	0x6f, 0x73, 0x74, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x2a, 0x0e, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x90, 0x01, 0x0a, 0x0b,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x33, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46,
+	0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2c, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35,
+	0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x22, 0x1e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f, 0x73,
+	0x74, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x8d,
+	0x01, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x30, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46,
+	0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6f,
+	0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38,
+	0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x22, 0x21, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1b, 0x22, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x2f, 0x76, 0x6f, 0x74, 0x65, 0x3a, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x12, 0x91,
+	0x01, 0x0a, 0x09, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x12, 0x31, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e,
+	0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2c, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37,
+	0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x22, 0x23, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f, 0x73, 0x74,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a,
+	0x01, 0x2a, 0x12, 0x9a, 0x01, 0x0a, 0x0b, 0x50, 0x6f, 0x73, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61,
+	0x63, 0x74, 0x12, 0x33, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d,
+	0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x2a, 0x20, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x7d, 0x12,
+	0x9a, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x34, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35,
+	0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x0e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x62, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x12, 0x9a, 0x01, 0x0a,
+	0x0e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x36, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37,
+	0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d,
+	0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x22, 0x0c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x3a, 0x01, 0x2a, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35,
+	0x38, 0x2f, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2d, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x61, 0x70, 0x69, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -387,31 +1003,58 @@ func file_api_post_proto_rawDescGZIP() []byte {
 }
 
 var file_api_post_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_post_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_api_post_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_api_post_proto_goTypes = []interface{}{
-	(PostGetOneRequest_Related)(0),       // 0: github.moguchev.BD_Forum.api.PostGetOneRequest.Related
-	(*PostsCreateRequest)(nil),           // 1: github.moguchev.BD_Forum.api.PostsCreateRequest
-	(*PostGetOneRequest)(nil),            // 2: github.moguchev.BD_Forum.api.PostGetOneRequest
-	(*PostUpdateRequest)(nil),            // 3: github.moguchev.BD_Forum.api.PostUpdateRequest
-	(*PostUpdateRequest_PostUpdate)(nil), // 4: github.moguchev.BD_Forum.api.PostUpdateRequest.PostUpdate
-	(*models.Post)(nil),                  // 5: github.moguchev.BD_Forum.api.models.Post
-	(*models.PostFull)(nil),              // 6: github.moguchev.BD_Forum.api.models.PostFull
+	(PostGetOneRequest_Related)(0),       // 0: github.storm5758.Forum_test.api.PostGetOneRequest.Related
+	(*PostsCreateRequest)(nil),           // 1: github.storm5758.Forum_test.api.PostsCreateRequest
+	(*PostsCreateResponse)(nil),          // 2: github.storm5758.Forum_test.api.PostsCreateResponse
+	(*PostGetOneRequest)(nil),            // 3: github.storm5758.Forum_test.api.PostGetOneRequest
+	(*PostUpdateRequest)(nil),            // 4: github.storm5758.Forum_test.api.PostUpdateRequest
+	(*PostDeleteRequest)(nil),            // 5: github.storm5758.Forum_test.api.PostDeleteRequest
+	(*PostRestoreRequest)(nil),           // 6: github.storm5758.Forum_test.api.PostRestoreRequest
+	(*PostVoteRequest)(nil),              // 7: github.storm5758.Forum_test.api.PostVoteRequest
+	(*PostReactRequest)(nil),             // 8: github.storm5758.Forum_test.api.PostReactRequest
+	(*PostUnreactRequest)(nil),           // 9: github.storm5758.Forum_test.api.PostUnreactRequest
+	(*ReactionListRequest)(nil),          // 10: github.storm5758.Forum_test.api.ReactionListRequest
+	(*ReactionListResponse)(nil),         // 11: github.storm5758.Forum_test.api.ReactionListResponse
+	(*PreviewMessageRequest)(nil),        // 12: github.storm5758.Forum_test.api.PreviewMessageRequest
+	(*PreviewMessageResponse)(nil),       // 13: github.storm5758.Forum_test.api.PreviewMessageResponse
+	(*PostUpdateRequest_PostUpdate)(nil), // 14: github.storm5758.Forum_test.api.PostUpdateRequest.PostUpdate
+	(*models.Post)(nil),                  // 15: github.storm5758.Forum_test.api.models.Post
+	(*models.Vote)(nil),                  // 16: github.storm5758.Forum_test.api.models.Vote
+	(*models.PostFull)(nil),              // 17: github.storm5758.Forum_test.api.models.PostFull
 }
 var file_api_post_proto_depIdxs = []int32{
-	5, // 0: github.moguchev.BD_Forum.api.PostsCreateRequest.posts:type_name -> github.moguchev.BD_Forum.api.models.Post
-	0, // 1: github.moguchev.BD_Forum.api.PostGetOneRequest.related:type_name -> github.moguchev.BD_Forum.api.PostGetOneRequest.Related
-	4, // 2: github.moguchev.BD_Forum.api.PostUpdateRequest.post:type_name -> github.moguchev.BD_Forum.api.PostUpdateRequest.PostUpdate
-	1, // 3: github.moguchev.BD_Forum.api.Post.PostsCreate:input_type -> github.moguchev.BD_Forum.api.PostsCreateRequest
-	2, // 4: github.moguchev.BD_Forum.api.Post.PostGetOne:input_type -> github.moguchev.BD_Forum.api.PostGetOneRequest
-	3, // 5: github.moguchev.BD_Forum.api.Post.PostUpdate:input_type -> github.moguchev.BD_Forum.api.PostUpdateRequest
-	5, // 6: github.moguchev.BD_Forum.api.Post.PostsCreate:output_type -> github.moguchev.BD_Forum.api.models.Post
-	6, // 7: github.moguchev.BD_Forum.api.Post.PostGetOne:output_type -> github.moguchev.BD_Forum.api.models.PostFull
-	5, // 8: github.moguchev.BD_Forum.api.Post.PostUpdate:output_type -> github.moguchev.BD_Forum.api.models.Post
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	15, // 0: github.storm5758.Forum_test.api.PostsCreateRequest.posts:type_name -> github.storm5758.Forum_test.api.models.Post
+	15, // 1: github.storm5758.Forum_test.api.PostsCreateResponse.posts:type_name -> github.storm5758.Forum_test.api.models.Post
+	0,  // 2: github.storm5758.Forum_test.api.PostGetOneRequest.related:type_name -> github.storm5758.Forum_test.api.PostGetOneRequest.Related
+	14, // 3: github.storm5758.Forum_test.api.PostUpdateRequest.post:type_name -> github.storm5758.Forum_test.api.PostUpdateRequest.PostUpdate
+	16, // 4: github.storm5758.Forum_test.api.PostVoteRequest.vote:type_name -> github.storm5758.Forum_test.api.models.Vote
+	1,  // 5: github.storm5758.Forum_test.api.Post.PostsCreate:input_type -> github.storm5758.Forum_test.api.PostsCreateRequest
+	3,  // 6: github.storm5758.Forum_test.api.Post.PostGetOne:input_type -> github.storm5758.Forum_test.api.PostGetOneRequest
+	4,  // 7: github.storm5758.Forum_test.api.Post.PostUpdate:input_type -> github.storm5758.Forum_test.api.PostUpdateRequest
+	5,  // 8: github.storm5758.Forum_test.api.Post.PostDelete:input_type -> github.storm5758.Forum_test.api.PostDeleteRequest
+	6,  // 9: github.storm5758.Forum_test.api.Post.PostRestore:input_type -> github.storm5758.Forum_test.api.PostRestoreRequest
+	7,  // 10: github.storm5758.Forum_test.api.Post.PostVote:input_type -> github.storm5758.Forum_test.api.PostVoteRequest
+	8,  // 11: github.storm5758.Forum_test.api.Post.PostReact:input_type -> github.storm5758.Forum_test.api.PostReactRequest
+	9,  // 12: github.storm5758.Forum_test.api.Post.PostUnreact:input_type -> github.storm5758.Forum_test.api.PostUnreactRequest
+	10, // 13: github.storm5758.Forum_test.api.Post.ReactionList:input_type -> github.storm5758.Forum_test.api.ReactionListRequest
+	12, // 14: github.storm5758.Forum_test.api.Post.PreviewMessage:input_type -> github.storm5758.Forum_test.api.PreviewMessageRequest
+	2,  // 15: github.storm5758.Forum_test.api.Post.PostsCreate:output_type -> github.storm5758.Forum_test.api.PostsCreateResponse
+	17, // 16: github.storm5758.Forum_test.api.Post.PostGetOne:output_type -> github.storm5758.Forum_test.api.models.PostFull
+	15, // 17: github.storm5758.Forum_test.api.Post.PostUpdate:output_type -> github.storm5758.Forum_test.api.models.Post
+	15, // 18: github.storm5758.Forum_test.api.Post.PostDelete:output_type -> github.storm5758.Forum_test.api.models.Post
+	15, // 19: github.storm5758.Forum_test.api.Post.PostRestore:output_type -> github.storm5758.Forum_test.api.models.Post
+	15, // 20: github.storm5758.Forum_test.api.Post.PostVote:output_type -> github.storm5758.Forum_test.api.models.Post
+	15, // 21: github.storm5758.Forum_test.api.Post.PostReact:output_type -> github.storm5758.Forum_test.api.models.Post
+	15, // 22: github.storm5758.Forum_test.api.Post.PostUnreact:output_type -> github.storm5758.Forum_test.api.models.Post
+	11, // 23: github.storm5758.Forum_test.api.Post.ReactionList:output_type -> github.storm5758.Forum_test.api.ReactionListResponse
+	13, // 24: github.storm5758.Forum_test.api.Post.PreviewMessage:output_type -> github.storm5758.Forum_test.api.PreviewMessageResponse
+	15, // [15:25] is the sub-list for method output_type
+	5,  // [5:15] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_api_post_proto_init() }
@@ -433,7 +1076,7 @@ func file_api_post_proto_init() {
 			}
 		}
 		file_api_post_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PostGetOneRequest); i {
+			switch v := v.(*PostsCreateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -445,7 +1088,7 @@ func file_api_post_proto_init() {
 			}
 		}
 		file_api_post_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PostUpdateRequest); i {
+			switch v := v.(*PostGetOneRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -457,6 +1100,126 @@ func file_api_post_proto_init() {
 			}
 		}
 		file_api_post_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_post_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_post_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostRestoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_post_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostVoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_post_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostReactRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_post_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostUnreactRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_post_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReactionListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_post_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReactionListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_post_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewMessageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_post_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewMessageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_post_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PostUpdateRequest_PostUpdate); i {
 			case 0:
 				return &v.state
@@ -475,7 +1238,7 @@ func file_api_post_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_post_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   4,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -28,7 +28,7 @@ type PostClient interface {
 	// Добавление новых постов в ветку обсуждения на форум.
 	//
 	// Все посты, созданные в рамках одного вызова данного метода должны иметь одинаковую дату создания (Post.Created).
-	PostsCreate(ctx context.Context, in *PostsCreateRequest, opts ...grpc.CallOption) (*models.Post, error)
+	PostsCreate(ctx context.Context, in *PostsCreateRequest, opts ...grpc.CallOption) (*PostsCreateResponse, error)
 	// Получение информации о ветке обсуждения
 	//
 	// Получение информации о ветке обсуждения по его имени.
@@ -39,6 +39,40 @@ type PostClient interface {
 	//
 	// Если сообщение поменяло текст, то оно должно получить отметку `isEdited`.
 	PostUpdate(ctx context.Context, in *PostUpdateRequest, opts ...grpc.CallOption) (*models.Post, error)
+	// Удаление сообщения
+	//
+	// Сообщение заменяется заглушкой, ответы на него остаются в ветке.
+	// Удалённое сообщение можно восстановить, пока оно не удалено окончательно
+	// по истечении срока хранения.
+	PostDelete(ctx context.Context, in *PostDeleteRequest, opts ...grpc.CallOption) (*models.Post, error)
+	// Восстановление сообщения
+	//
+	// Восстановление удалённого сообщения.
+	PostRestore(ctx context.Context, in *PostRestoreRequest, opts ...grpc.CallOption) (*models.Post, error)
+	// Проголосовать за сообщение
+	//
+	// Изменение голоса за сообщение.
+	//
+	// Один пользователь учитывается только один раз и может изменить своё
+	// мнение.
+	PostVote(ctx context.Context, in *PostVoteRequest, opts ...grpc.CallOption) (*models.Post, error)
+	// Реакция на сообщение
+	//
+	// Добавление реакции пользователя на сообщение.
+	// Пользователь может оставить по одной реакции каждым эмодзи из списка ReactionList.
+	PostReact(ctx context.Context, in *PostReactRequest, opts ...grpc.CallOption) (*models.Post, error)
+	// Удаление реакции
+	//
+	// Удаление реакции пользователя на сообщение.
+	PostUnreact(ctx context.Context, in *PostUnreactRequest, opts ...grpc.CallOption) (*models.Post, error)
+	// Доступные реакции
+	//
+	// Список эмодзи, которыми можно реагировать на сообщения.
+	ReactionList(ctx context.Context, in *ReactionListRequest, opts ...grpc.CallOption) (*ReactionListResponse, error)
+	// Предпросмотр сообщения
+	//
+	// Отрисовка сообщения из Markdown в HTML так же, как при сохранении, без создания сообщения.
+	PreviewMessage(ctx context.Context, in *PreviewMessageRequest, opts ...grpc.CallOption) (*PreviewMessageResponse, error)
 }
 
 type postClient struct {
@@ -49,9 +83,9 @@ func NewPostClient(cc grpc.ClientConnInterface) PostClient {
 	return &postClient{cc}
 }
 
-func (c *postClient) PostsCreate(ctx context.Context, in *PostsCreateRequest, opts ...grpc.CallOption) (*models.Post, error) {
-	out := new(models.Post)
-	err := c.cc.Invoke(ctx, "/github.moguchev.BD_Forum.api.Post/PostsCreate", in, out, opts...)
+func (c *postClient) PostsCreate(ctx context.Context, in *PostsCreateRequest, opts ...grpc.CallOption) (*PostsCreateResponse, error) {
+	out := new(PostsCreateResponse)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Post/PostsCreate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +94,7 @@ func (c *postClient) PostsCreate(ctx context.Context, in *PostsCreateRequest, op
 
 func (c *postClient) PostGetOne(ctx context.Context, in *PostGetOneRequest, opts ...grpc.CallOption) (*models.PostFull, error) {
 	out := new(models.PostFull)
-	err := c.cc.Invoke(ctx, "/github.moguchev.BD_Forum.api.Post/PostGetOne", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Post/PostGetOne", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +103,70 @@ func (c *postClient) PostGetOne(ctx context.Context, in *PostGetOneRequest, opts
 
 func (c *postClient) PostUpdate(ctx context.Context, in *PostUpdateRequest, opts ...grpc.CallOption) (*models.Post, error) {
 	out := new(models.Post)
-	err := c.cc.Invoke(ctx, "/github.moguchev.BD_Forum.api.Post/PostUpdate", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Post/PostUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postClient) PostDelete(ctx context.Context, in *PostDeleteRequest, opts ...grpc.CallOption) (*models.Post, error) {
+	out := new(models.Post)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Post/PostDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postClient) PostRestore(ctx context.Context, in *PostRestoreRequest, opts ...grpc.CallOption) (*models.Post, error) {
+	out := new(models.Post)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Post/PostRestore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postClient) PostVote(ctx context.Context, in *PostVoteRequest, opts ...grpc.CallOption) (*models.Post, error) {
+	out := new(models.Post)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Post/PostVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postClient) PostReact(ctx context.Context, in *PostReactRequest, opts ...grpc.CallOption) (*models.Post, error) {
+	out := new(models.Post)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Post/PostReact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postClient) PostUnreact(ctx context.Context, in *PostUnreactRequest, opts ...grpc.CallOption) (*models.Post, error) {
+	out := new(models.Post)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Post/PostUnreact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postClient) ReactionList(ctx context.Context, in *ReactionListRequest, opts ...grpc.CallOption) (*ReactionListResponse, error) {
+	out := new(ReactionListResponse)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Post/ReactionList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postClient) PreviewMessage(ctx context.Context, in *PreviewMessageRequest, opts ...grpc.CallOption) (*PreviewMessageResponse, error) {
+	out := new(PreviewMessageResponse)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Post/PreviewMessage", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -85,7 +182,7 @@ type PostServer interface {
 	// Добавление новых постов в ветку обсуждения на форум.
 	//
 	// Все посты, созданные в рамках одного вызова данного метода должны иметь одинаковую дату создания (Post.Created).
-	PostsCreate(context.Context, *PostsCreateRequest) (*models.Post, error)
+	PostsCreate(context.Context, *PostsCreateRequest) (*PostsCreateResponse, error)
 	// Получение информации о ветке обсуждения
 	//
 	// Получение информации о ветке обсуждения по его имени.
@@ -96,6 +193,40 @@ type PostServer interface {
 	//
 	// Если сообщение поменяло текст, то оно должно получить отметку `isEdited`.
 	PostUpdate(context.Context, *PostUpdateRequest) (*models.Post, error)
+	// Удаление сообщения
+	//
+	// Сообщение заменяется заглушкой, ответы на него остаются в ветке.
+	// Удалённое сообщение можно восстановить, пока оно не удалено окончательно
+	// по истечении срока хранения.
+	PostDelete(context.Context, *PostDeleteRequest) (*models.Post, error)
+	// Восстановление сообщения
+	//
+	// Восстановление удалённого сообщения.
+	PostRestore(context.Context, *PostRestoreRequest) (*models.Post, error)
+	// Проголосовать за сообщение
+	//
+	// Изменение голоса за сообщение.
+	//
+	// Один пользователь учитывается только один раз и может изменить своё
+	// мнение.
+	PostVote(context.Context, *PostVoteRequest) (*models.Post, error)
+	// Реакция на сообщение
+	//
+	// Добавление реакции пользователя на сообщение.
+	// Пользователь может оставить по одной реакции каждым эмодзи из списка ReactionList.
+	PostReact(context.Context, *PostReactRequest) (*models.Post, error)
+	// Удаление реакции
+	//
+	// Удаление реакции пользователя на сообщение.
+	PostUnreact(context.Context, *PostUnreactRequest) (*models.Post, error)
+	// Доступные реакции
+	//
+	// Список эмодзи, которыми можно реагировать на сообщения.
+	ReactionList(context.Context, *ReactionListRequest) (*ReactionListResponse, error)
+	// Предпросмотр сообщения
+	//
+	// Отрисовка сообщения из Markdown в HTML так же, как при сохранении, без создания сообщения.
+	PreviewMessage(context.Context, *PreviewMessageRequest) (*PreviewMessageResponse, error)
 	mustEmbedUnimplementedPostServer()
 }
 
@@ -103,7 +234,7 @@ type PostServer interface {
 type UnimplementedPostServer struct {
 }
 
-func (UnimplementedPostServer) PostsCreate(context.Context, *PostsCreateRequest) (*models.Post, error) {
+func (UnimplementedPostServer) PostsCreate(context.Context, *PostsCreateRequest) (*PostsCreateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PostsCreate not implemented")
 }
 func (UnimplementedPostServer) PostGetOne(context.Context, *PostGetOneRequest) (*models.PostFull, error) {
@@ -112,6 +243,27 @@ func (UnimplementedPostServer) PostGetOne(context.Context, *PostGetOneRequest) (
 func (UnimplementedPostServer) PostUpdate(context.Context, *PostUpdateRequest) (*models.Post, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PostUpdate not implemented")
 }
+func (UnimplementedPostServer) PostDelete(context.Context, *PostDeleteRequest) (*models.Post, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostDelete not implemented")
+}
+func (UnimplementedPostServer) PostRestore(context.Context, *PostRestoreRequest) (*models.Post, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostRestore not implemented")
+}
+func (UnimplementedPostServer) PostVote(context.Context, *PostVoteRequest) (*models.Post, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostVote not implemented")
+}
+func (UnimplementedPostServer) PostReact(context.Context, *PostReactRequest) (*models.Post, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostReact not implemented")
+}
+func (UnimplementedPostServer) PostUnreact(context.Context, *PostUnreactRequest) (*models.Post, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostUnreact not implemented")
+}
+func (UnimplementedPostServer) ReactionList(context.Context, *ReactionListRequest) (*ReactionListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactionList not implemented")
+}
+func (UnimplementedPostServer) PreviewMessage(context.Context, *PreviewMessageRequest) (*PreviewMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewMessage not implemented")
+}
 func (UnimplementedPostServer) mustEmbedUnimplementedPostServer() {}
 
 // UnsafePostServer may be embedded to opt out of forward compatibility for this service.
@@ -135,7 +287,7 @@ func _Post_PostsCreate_Handler(srv interface{}, ctx context.Context, dec func(in
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/github.moguchev.BD_Forum.api.Post/PostsCreate",
+		FullMethod: "/github.storm5758.Forum_test.api.Post/PostsCreate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(PostServer).PostsCreate(ctx, req.(*PostsCreateRequest))
@@ -153,7 +305,7 @@ func _Post_PostGetOne_Handler(srv interface{}, ctx context.Context, dec func(int
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/github.moguchev.BD_Forum.api.Post/PostGetOne",
+		FullMethod: "/github.storm5758.Forum_test.api.Post/PostGetOne",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(PostServer).PostGetOne(ctx, req.(*PostGetOneRequest))
@@ -171,7 +323,7 @@ func _Post_PostUpdate_Handler(srv interface{}, ctx context.Context, dec func(int
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/github.moguchev.BD_Forum.api.Post/PostUpdate",
+		FullMethod: "/github.storm5758.Forum_test.api.Post/PostUpdate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(PostServer).PostUpdate(ctx, req.(*PostUpdateRequest))
@@ -179,11 +331,137 @@ func _Post_PostUpdate_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Post_PostDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServer).PostDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.Post/PostDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServer).PostDelete(ctx, req.(*PostDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Post_PostRestore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostRestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServer).PostRestore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.Post/PostRestore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServer).PostRestore(ctx, req.(*PostRestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Post_PostVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServer).PostVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.Post/PostVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServer).PostVote(ctx, req.(*PostVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Post_PostReact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostReactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServer).PostReact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.Post/PostReact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServer).PostReact(ctx, req.(*PostReactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Post_PostUnreact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostUnreactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServer).PostUnreact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.Post/PostUnreact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServer).PostUnreact(ctx, req.(*PostUnreactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Post_ReactionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactionListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServer).ReactionList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.Post/ReactionList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServer).ReactionList(ctx, req.(*ReactionListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Post_PreviewMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServer).PreviewMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.Post/PreviewMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServer).PreviewMessage(ctx, req.(*PreviewMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Post_ServiceDesc is the grpc.ServiceDesc for Post service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var Post_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "github.moguchev.BD_Forum.api.Post",
+	ServiceName: "github.storm5758.Forum_test.api.Post",
 	HandlerType: (*PostServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
@@ -198,6 +476,34 @@ var Post_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PostUpdate",
 			Handler:    _Post_PostUpdate_Handler,
 		},
+		{
+			MethodName: "PostDelete",
+			Handler:    _Post_PostDelete_Handler,
+		},
+		{
+			MethodName: "PostRestore",
+			Handler:    _Post_PostRestore_Handler,
+		},
+		{
+			MethodName: "PostVote",
+			Handler:    _Post_PostVote_Handler,
+		},
+		{
+			MethodName: "PostReact",
+			Handler:    _Post_PostReact_Handler,
+		},
+		{
+			MethodName: "PostUnreact",
+			Handler:    _Post_PostUnreact_Handler,
+		},
+		{
+			MethodName: "ReactionList",
+			Handler:    _Post_ReactionList_Handler,
+		},
+		{
+			MethodName: "PreviewMessage",
+			Handler:    _Post_PreviewMessage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/post.proto",
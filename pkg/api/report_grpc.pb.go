@@ -0,0 +1,245 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: api/report.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ReportClient is the client API for Report service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReportClient interface {
+	// Жалоба на сообщение
+	//
+	// Отметка сообщения как нарушающего правила форума.
+	// Пользователь может держать открытой только одну жалобу на сообщение.
+	ReportPost(ctx context.Context, in *ReportPostRequest, opts ...grpc.CallOption) (*ReportInfo, error)
+	// Жалоба на ветку
+	//
+	// Отметка ветки обсуждения как нарушающей правила форума.
+	// Пользователь может держать открытой только одну жалобу на ветку.
+	ReportThread(ctx context.Context, in *ReportThreadRequest, opts ...grpc.CallOption) (*ReportInfo, error)
+	// Очередь модерации
+	//
+	// Открытые жалобы форума, сгруппированные по сообщениям и веткам,
+	// начиная с сообщений и веток с самыми давними жалобами.
+	ReportQueue(ctx context.Context, in *ReportQueueRequest, opts ...grpc.CallOption) (*ReportQueueResponse, error)
+	// Решение по жалобе
+	//
+	// Закрытие жалобы и остальных открытых жалоб на то же сообщение или ветку.
+	// Решение выполняется вместе с закрытием жалоб и сохраняется со ссылкой на результат.
+	ReportResolve(ctx context.Context, in *ReportResolveRequest, opts ...grpc.CallOption) (*ReportResolution, error)
+}
+
+type reportClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReportClient(cc grpc.ClientConnInterface) ReportClient {
+	return &reportClient{cc}
+}
+
+func (c *reportClient) ReportPost(ctx context.Context, in *ReportPostRequest, opts ...grpc.CallOption) (*ReportInfo, error) {
+	out := new(ReportInfo)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Report/ReportPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportClient) ReportThread(ctx context.Context, in *ReportThreadRequest, opts ...grpc.CallOption) (*ReportInfo, error) {
+	out := new(ReportInfo)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Report/ReportThread", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportClient) ReportQueue(ctx context.Context, in *ReportQueueRequest, opts ...grpc.CallOption) (*ReportQueueResponse, error) {
+	out := new(ReportQueueResponse)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Report/ReportQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportClient) ReportResolve(ctx context.Context, in *ReportResolveRequest, opts ...grpc.CallOption) (*ReportResolution, error) {
+	out := new(ReportResolution)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Report/ReportResolve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReportServer is the server API for Report service.
+// All implementations must embed UnimplementedReportServer
+// for forward compatibility
+type ReportServer interface {
+	// Жалоба на сообщение
+	//
+	// Отметка сообщения как нарушающего правила форума.
+	// Пользователь может держать открытой только одну жалобу на сообщение.
+	ReportPost(context.Context, *ReportPostRequest) (*ReportInfo, error)
+	// Жалоба на ветку
+	//
+	// Отметка ветки обсуждения как нарушающей правила форума.
+	// Пользователь может держать открытой только одну жалобу на ветку.
+	ReportThread(context.Context, *ReportThreadRequest) (*ReportInfo, error)
+	// Очередь модерации
+	//
+	// Открытые жалобы форума, сгруппированные по сообщениям и веткам,
+	// начиная с сообщений и веток с самыми давними жалобами.
+	ReportQueue(context.Context, *ReportQueueRequest) (*ReportQueueResponse, error)
+	// Решение по жалобе
+	//
+	// Закрытие жалобы и остальных открытых жалоб на то же сообщение или ветку.
+	// Решение выполняется вместе с закрытием жалоб и сохраняется со ссылкой на результат.
+	ReportResolve(context.Context, *ReportResolveRequest) (*ReportResolution, error)
+	mustEmbedUnimplementedReportServer()
+}
+
+// UnimplementedReportServer must be embedded to have forward compatible implementations.
+type UnimplementedReportServer struct {
+}
+
+func (UnimplementedReportServer) ReportPost(context.Context, *ReportPostRequest) (*ReportInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportPost not implemented")
+}
+func (UnimplementedReportServer) ReportThread(context.Context, *ReportThreadRequest) (*ReportInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportThread not implemented")
+}
+func (UnimplementedReportServer) ReportQueue(context.Context, *ReportQueueRequest) (*ReportQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportQueue not implemented")
+}
+func (UnimplementedReportServer) ReportResolve(context.Context, *ReportResolveRequest) (*ReportResolution, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportResolve not implemented")
+}
+func (UnimplementedReportServer) mustEmbedUnimplementedReportServer() {}
+
+// UnsafeReportServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReportServer will
+// result in compilation errors.
+type UnsafeReportServer interface {
+	mustEmbedUnimplementedReportServer()
+}
+
+func RegisterReportServer(s grpc.ServiceRegistrar, srv ReportServer) {
+	s.RegisterService(&Report_ServiceDesc, srv)
+}
+
+func _Report_ReportPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServer).ReportPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.Report/ReportPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServer).ReportPost(ctx, req.(*ReportPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Report_ReportThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServer).ReportThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.Report/ReportThread",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServer).ReportThread(ctx, req.(*ReportThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Report_ReportQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServer).ReportQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.Report/ReportQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServer).ReportQueue(ctx, req.(*ReportQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Report_ReportResolve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportResolveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServer).ReportResolve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.Report/ReportResolve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServer).ReportResolve(ctx, req.(*ReportResolveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Report_ServiceDesc is the grpc.ServiceDesc for Report service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Report_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "github.storm5758.Forum_test.api.Report",
+	HandlerType: (*ReportServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReportPost",
+			Handler:    _Report_ReportPost_Handler,
+		},
+		{
+			MethodName: "ReportThread",
+			Handler:    _Report_ReportThread_Handler,
+		},
+		{
+			MethodName: "ReportQueue",
+			Handler:    _Report_ReportQueue_Handler,
+		},
+		{
+			MethodName: "ReportResolve",
+			Handler:    _Report_ReportResolve_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/report.proto",
+}
@@ -0,0 +1,644 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        (unknown)
+// source: api/search.proto
+
+package api
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SearchRequest_Language int32
+
+const (
+	SearchRequest_LANGUAGE_RUSSIAN SearchRequest_Language = 0
+	SearchRequest_LANGUAGE_ENGLISH SearchRequest_Language = 1
+)
+
+// Enum value maps for SearchRequest_Language.
+var (
+	SearchRequest_Language_name = map[int32]string{
+		0: "LANGUAGE_RUSSIAN",
+		1: "LANGUAGE_ENGLISH",
+	}
+	SearchRequest_Language_value = map[string]int32{
+		"LANGUAGE_RUSSIAN": 0,
+		"LANGUAGE_ENGLISH": 1,
+	}
+)
+
+func (x SearchRequest_Language) Enum() *SearchRequest_Language {
+	p := new(SearchRequest_Language)
+	*p = x
+	return p
+}
+
+func (x SearchRequest_Language) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchRequest_Language) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_search_proto_enumTypes[0].Descriptor()
+}
+
+func (SearchRequest_Language) Type() protoreflect.EnumType {
+	return &file_api_search_proto_enumTypes[0]
+}
+
+func (x SearchRequest_Language) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchRequest_Language.Descriptor instead.
+func (SearchRequest_Language) EnumDescriptor() ([]byte, []int) {
+	return file_api_search_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type SearchRequest_Target int32
+
+const (
+	SearchRequest_TARGET_ALL     SearchRequest_Target = 0
+	SearchRequest_TARGET_THREADS SearchRequest_Target = 1
+	SearchRequest_TARGET_POSTS   SearchRequest_Target = 2
+)
+
+// Enum value maps for SearchRequest_Target.
+var (
+	SearchRequest_Target_name = map[int32]string{
+		0: "TARGET_ALL",
+		1: "TARGET_THREADS",
+		2: "TARGET_POSTS",
+	}
+	SearchRequest_Target_value = map[string]int32{
+		"TARGET_ALL":     0,
+		"TARGET_THREADS": 1,
+		"TARGET_POSTS":   2,
+	}
+)
+
+func (x SearchRequest_Target) Enum() *SearchRequest_Target {
+	p := new(SearchRequest_Target)
+	*p = x
+	return p
+}
+
+func (x SearchRequest_Target) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchRequest_Target) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_search_proto_enumTypes[1].Descriptor()
+}
+
+func (SearchRequest_Target) Type() protoreflect.EnumType {
+	return &file_api_search_proto_enumTypes[1]
+}
+
+func (x SearchRequest_Target) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchRequest_Target.Descriptor instead.
+func (SearchRequest_Target) EnumDescriptor() ([]byte, []int) {
+	return file_api_search_proto_rawDescGZIP(), []int{0, 1}
+}
+
+type SearchResponse_Hit_Kind int32
+
+const (
+	SearchResponse_Hit_KIND_THREAD SearchResponse_Hit_Kind = 0
+	SearchResponse_Hit_KIND_POST   SearchResponse_Hit_Kind = 1
+)
+
+// Enum value maps for SearchResponse_Hit_Kind.
+var (
+	SearchResponse_Hit_Kind_name = map[int32]string{
+		0: "KIND_THREAD",
+		1: "KIND_POST",
+	}
+	SearchResponse_Hit_Kind_value = map[string]int32{
+		"KIND_THREAD": 0,
+		"KIND_POST":   1,
+	}
+)
+
+func (x SearchResponse_Hit_Kind) Enum() *SearchResponse_Hit_Kind {
+	p := new(SearchResponse_Hit_Kind)
+	*p = x
+	return p
+}
+
+func (x SearchResponse_Hit_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchResponse_Hit_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_search_proto_enumTypes[2].Descriptor()
+}
+
+func (SearchResponse_Hit_Kind) Type() protoreflect.EnumType {
+	return &file_api_search_proto_enumTypes[2]
+}
+
+func (x SearchResponse_Hit_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchResponse_Hit_Kind.Descriptor instead.
+func (SearchResponse_Hit_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_api_search_proto_rawDescGZIP(), []int{1, 0, 0}
+}
+
+type SearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Поисковый запрос в синтаксисе websearch_to_tsquery:
+	// слова, "фразы в кавычках", or, -исключения.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Язык запроса, определяет словари и стемминг.
+	Language SearchRequest_Language `protobuf:"varint,2,opt,name=language,proto3,enum=github.storm5758.Forum_test.api.SearchRequest_Language" json:"language,omitempty"`
+	// Где искать.
+	Target SearchRequest_Target `protobuf:"varint,3,opt,name=target,proto3,enum=github.storm5758.Forum_test.api.SearchRequest_Target" json:"target,omitempty"`
+	// Идентификатор форума, в котором ведётся поиск.
+	Forum string `protobuf:"bytes,4,opt,name=forum,proto3" json:"forum,omitempty"`
+	// Автор ветки или сообщения.
+	Author string `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	// Дата создания, начиная с которой ищутся записи (включительно).
+	Since string `protobuf:"bytes,6,opt,name=since,proto3" json:"since,omitempty"`
+	// Дата создания, до которой ищутся записи (не включительно).
+	Until string `protobuf:"bytes,7,opt,name=until,proto3" json:"until,omitempty"`
+	// Максимальное кол-во возвращаемых записей, по умолчанию 20, не больше 100.
+	Limit int32 `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Токен страницы из next_page_token предыдущего ответа.
+	// Должен использоваться с теми же запросом и фильтрами.
+	PageToken string `protobuf:"bytes,9,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_search_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_search_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_api_search_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetLanguage() SearchRequest_Language {
+	if x != nil {
+		return x.Language
+	}
+	return SearchRequest_LANGUAGE_RUSSIAN
+}
+
+func (x *SearchRequest) GetTarget() SearchRequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return SearchRequest_TARGET_ALL
+}
+
+func (x *SearchRequest) GetForum() string {
+	if x != nil {
+		return x.Forum
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetUntil() string {
+	if x != nil {
+		return x.Until
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hits []*SearchResponse_Hit `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	// Токен следующей страницы, пустой на последней странице.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_search_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_search_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_api_search_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchResponse) GetHits() []*SearchResponse_Hit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SearchResponse_Hit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Вид найденной записи.
+	Kind SearchResponse_Hit_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=github.storm5758.Forum_test.api.SearchResponse_Hit_Kind" json:"kind,omitempty"`
+	// Идентификатор ветки или сообщения.
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Ветка обсуждения, в которой найдена запись.
+	Thread int32 `protobuf:"varint,3,opt,name=thread,proto3" json:"thread,omitempty"`
+	// Форум, в котором найдена запись.
+	Forum string `protobuf:"bytes,4,opt,name=forum,proto3" json:"forum,omitempty"`
+	// Автор записи.
+	Author string `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	// Дата создания записи.
+	Created string `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`
+	// Заголовок ветки, пустой для сообщений.
+	Title string `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
+	// HTML фрагменты текста с найденными словами, выделенными тегами <b></b>.
+	// Остальной текст экранирован, других тегов во фрагментах нет.
+	Snippet string `protobuf:"bytes,8,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	// Релевантность.
+	Rank float64 `protobuf:"fixed64,9,opt,name=rank,proto3" json:"rank,omitempty"`
+}
+
+func (x *SearchResponse_Hit) Reset() {
+	*x = SearchResponse_Hit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_search_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResponse_Hit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse_Hit) ProtoMessage() {}
+
+func (x *SearchResponse_Hit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_search_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse_Hit.ProtoReflect.Descriptor instead.
+func (*SearchResponse_Hit) Descriptor() ([]byte, []int) {
+	return file_api_search_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *SearchResponse_Hit) GetKind() SearchResponse_Hit_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return SearchResponse_Hit_KIND_THREAD
+}
+
+func (x *SearchResponse_Hit) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SearchResponse_Hit) GetThread() int32 {
+	if x != nil {
+		return x.Thread
+	}
+	return 0
+}
+
+func (x *SearchResponse_Hit) GetForum() string {
+	if x != nil {
+		return x.Forum
+	}
+	return ""
+}
+
+func (x *SearchResponse_Hit) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *SearchResponse_Hit) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+func (x *SearchResponse_Hit) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SearchResponse_Hit) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *SearchResponse_Hit) GetRank() float64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+var File_api_search_proto protoreflect.FileDescriptor
+
+var file_api_search_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x1f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d,
+	0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xd6, 0x03, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x12, 0x53, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x37, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x4d, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69,
+	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x36, 0x0a, 0x08, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12,
+	0x14, 0x0a, 0x10, 0x4c, 0x41, 0x4e, 0x47, 0x55, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x55, 0x53, 0x53,
+	0x49, 0x41, 0x4e, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x4c, 0x41, 0x4e, 0x47, 0x55, 0x41, 0x47,
+	0x45, 0x5f, 0x45, 0x4e, 0x47, 0x4c, 0x49, 0x53, 0x48, 0x10, 0x01, 0x22, 0x3e, 0x0a, 0x06, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f,
+	0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f,
+	0x54, 0x48, 0x52, 0x45, 0x41, 0x44, 0x53, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x41, 0x52,
+	0x47, 0x45, 0x54, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x53, 0x10, 0x02, 0x22, 0xb3, 0x03, 0x0a, 0x0e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47,
+	0x0a, 0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e,
+	0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x48, 0x69,
+	0x74, 0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a,
+	0xaf, 0x02, 0x0a, 0x03, 0x48, 0x69, 0x74, 0x12, 0x4c, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x38, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x48, 0x69, 0x74, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x6f,
+	0x72, 0x75, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6e,
+	0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0x26, 0x0a, 0x04, 0x4b, 0x69, 0x6e,
+	0x64, 0x12, 0x0f, 0x0a, 0x0b, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x41, 0x44,
+	0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x10,
+	0x01, 0x32, 0x88, 0x01, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x7e, 0x0a, 0x06,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x2e, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12,
+	0x0b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x2d, 0x5a, 0x2b,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x6d,
+	0x35, 0x37, 0x35, 0x38, 0x2f, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2d, 0x74, 0x65, 0x73, 0x74, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_search_proto_rawDescOnce sync.Once
+	file_api_search_proto_rawDescData = file_api_search_proto_rawDesc
+)
+
+func file_api_search_proto_rawDescGZIP() []byte {
+	file_api_search_proto_rawDescOnce.Do(func() {
+		file_api_search_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_search_proto_rawDescData)
+	})
+	return file_api_search_proto_rawDescData
+}
+
+var file_api_search_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_search_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_api_search_proto_goTypes = []interface{}{
+	(SearchRequest_Language)(0),  // 0: github.storm5758.Forum_test.api.SearchRequest.Language
+	(SearchRequest_Target)(0),    // 1: github.storm5758.Forum_test.api.SearchRequest.Target
+	(SearchResponse_Hit_Kind)(0), // 2: github.storm5758.Forum_test.api.SearchResponse.Hit.Kind
+	(*SearchRequest)(nil),        // 3: github.storm5758.Forum_test.api.SearchRequest
+	(*SearchResponse)(nil),       // 4: github.storm5758.Forum_test.api.SearchResponse
+	(*SearchResponse_Hit)(nil),   // 5: github.storm5758.Forum_test.api.SearchResponse.Hit
+}
+var file_api_search_proto_depIdxs = []int32{
+	0, // 0: github.storm5758.Forum_test.api.SearchRequest.language:type_name -> github.storm5758.Forum_test.api.SearchRequest.Language
+	1, // 1: github.storm5758.Forum_test.api.SearchRequest.target:type_name -> github.storm5758.Forum_test.api.SearchRequest.Target
+	5, // 2: github.storm5758.Forum_test.api.SearchResponse.hits:type_name -> github.storm5758.Forum_test.api.SearchResponse.Hit
+	2, // 3: github.storm5758.Forum_test.api.SearchResponse.Hit.kind:type_name -> github.storm5758.Forum_test.api.SearchResponse.Hit.Kind
+	3, // 4: github.storm5758.Forum_test.api.Search.Search:input_type -> github.storm5758.Forum_test.api.SearchRequest
+	4, // 5: github.storm5758.Forum_test.api.Search.Search:output_type -> github.storm5758.Forum_test.api.SearchResponse
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_api_search_proto_init() }
+func file_api_search_proto_init() {
+	if File_api_search_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_search_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_search_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_search_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResponse_Hit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_search_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_search_proto_goTypes,
+		DependencyIndexes: file_api_search_proto_depIdxs,
+		EnumInfos:         file_api_search_proto_enumTypes,
+		MessageInfos:      file_api_search_proto_msgTypes,
+	}.Build()
+	File_api_search_proto = out.File
+	file_api_search_proto_rawDesc = nil
+	file_api_search_proto_goTypes = nil
+	file_api_search_proto_depIdxs = nil
+}
@@ -43,7 +43,7 @@ func NewAdminClient(cc grpc.ClientConnInterface) AdminClient {
 
 func (c *adminClient) Clear(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/github.moguchev.BD_Forum.api.Admin/Clear", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Admin/Clear", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +52,7 @@ func (c *adminClient) Clear(ctx context.Context, in *emptypb.Empty, opts ...grpc
 
 func (c *adminClient) Status(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StatusResponse, error) {
 	out := new(StatusResponse)
-	err := c.cc.Invoke(ctx, "/github.moguchev.BD_Forum.api.Admin/Status", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Admin/Status", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -107,7 +107,7 @@ func _Admin_Clear_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/github.moguchev.BD_Forum.api.Admin/Clear",
+		FullMethod: "/github.storm5758.Forum_test.api.Admin/Clear",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(AdminServer).Clear(ctx, req.(*emptypb.Empty))
@@ -125,7 +125,7 @@ func _Admin_Status_Handler(srv interface{}, ctx context.Context, dec func(interf
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/github.moguchev.BD_Forum.api.Admin/Status",
+		FullMethod: "/github.storm5758.Forum_test.api.Admin/Status",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(AdminServer).Status(ctx, req.(*emptypb.Empty))
@@ -137,7 +137,7 @@ func _Admin_Status_Handler(srv interface{}, ctx context.Context, dec func(interf
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var Admin_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "github.moguchev.BD_Forum.api.Admin",
+	ServiceName: "github.storm5758.Forum_test.api.Admin",
 	HandlerType: (*AdminServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
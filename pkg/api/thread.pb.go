@@ -28,6 +28,7 @@ const (
 	ThreadGetPostsRequest_THREAD_GET_POSTS_REQUEST_SORT_FLAT        ThreadGetPostsRequest_ThreadGetPostsRequestSort = 0
 	ThreadGetPostsRequest_THREAD_GET_POSTS_REQUEST_SORT_TREE        ThreadGetPostsRequest_ThreadGetPostsRequestSort = 1
 	ThreadGetPostsRequest_THREAD_GET_POSTS_REQUEST_SORT_PARENT_TREE ThreadGetPostsRequest_ThreadGetPostsRequestSort = 2
+	ThreadGetPostsRequest_THREAD_GET_POSTS_REQUEST_SORT_TOP         ThreadGetPostsRequest_ThreadGetPostsRequestSort = 3
 )
 
 // Enum value maps for ThreadGetPostsRequest_ThreadGetPostsRequestSort.
@@ -36,11 +37,13 @@ var (
 		0: "THREAD_GET_POSTS_REQUEST_SORT_FLAT",
 		1: "THREAD_GET_POSTS_REQUEST_SORT_TREE",
 		2: "THREAD_GET_POSTS_REQUEST_SORT_PARENT_TREE",
+		3: "THREAD_GET_POSTS_REQUEST_SORT_TOP",
 	}
 	ThreadGetPostsRequest_ThreadGetPostsRequestSort_value = map[string]int32{
 		"THREAD_GET_POSTS_REQUEST_SORT_FLAT":        0,
 		"THREAD_GET_POSTS_REQUEST_SORT_TREE":        1,
 		"THREAD_GET_POSTS_REQUEST_SORT_PARENT_TREE": 2,
+		"THREAD_GET_POSTS_REQUEST_SORT_TOP":         3,
 	}
 )
 
@@ -198,9 +201,14 @@ type ThreadGetPostsRequest struct {
 	//  * parent_tree - древовидные с пагинацией по родительским (parent_tree),
 	//    на странице N родительских комментов и все комментарии прикрепленные
 	//    к ним, в древвидном отображение.
+	//  * top - как parent_tree, но родительские комментарии выводятся по убыванию
+	//    рейтинга (score), при равном рейтинге - в порядке создания.
 	//
 	// Подробности: https://park.mail.ru/blog/topic/view/1191/
-	Sort ThreadGetPostsRequest_ThreadGetPostsRequestSort `protobuf:"varint,5,opt,name=sort,proto3,enum=github.moguchev.BD_Forum.api.ThreadGetPostsRequest_ThreadGetPostsRequestSort" json:"sort,omitempty"`
+	Sort ThreadGetPostsRequest_ThreadGetPostsRequestSort `protobuf:"varint,5,opt,name=sort,proto3,enum=github.storm5758.Forum_test.api.ThreadGetPostsRequest_ThreadGetPostsRequestSort" json:"sort,omitempty"`
+	// Токен страницы из next_page_token предыдущего ответа.
+	// Вид сортировки и её направление берутся из токена, since игнорируется.
+	PageToken string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
 func (x *ThreadGetPostsRequest) Reset() {
@@ -270,6 +278,70 @@ func (x *ThreadGetPostsRequest) GetSort() ThreadGetPostsRequest_ThreadGetPostsRe
 	return ThreadGetPostsRequest_THREAD_GET_POSTS_REQUEST_SORT_FLAT
 }
 
+func (x *ThreadGetPostsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ThreadGetPostsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Posts []*models.Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	// Токен следующей страницы, пустой на последней странице.
+	// В HTTP-ответе передаётся в заголовке X-Next-Page-Token.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ThreadGetPostsResponse) Reset() {
+	*x = ThreadGetPostsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_thread_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThreadGetPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadGetPostsResponse) ProtoMessage() {}
+
+func (x *ThreadGetPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_thread_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadGetPostsResponse.ProtoReflect.Descriptor instead.
+func (*ThreadGetPostsResponse) Descriptor() ([]byte, []int) {
+	return file_api_thread_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ThreadGetPostsResponse) GetPosts() []*models.Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *ThreadGetPostsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type ThreadUpdateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -284,7 +356,7 @@ type ThreadUpdateRequest struct {
 func (x *ThreadUpdateRequest) Reset() {
 	*x = ThreadUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_thread_proto_msgTypes[3]
+		mi := &file_api_thread_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -297,7 +369,7 @@ func (x *ThreadUpdateRequest) String() string {
 func (*ThreadUpdateRequest) ProtoMessage() {}
 
 func (x *ThreadUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_thread_proto_msgTypes[3]
+	mi := &file_api_thread_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -310,7 +382,7 @@ func (x *ThreadUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ThreadUpdateRequest.ProtoReflect.Descriptor instead.
 func (*ThreadUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_api_thread_proto_rawDescGZIP(), []int{3}
+	return file_api_thread_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ThreadUpdateRequest) GetSlugOrId() string {
@@ -341,7 +413,7 @@ type ThreadVoteRequest struct {
 func (x *ThreadVoteRequest) Reset() {
 	*x = ThreadVoteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_thread_proto_msgTypes[4]
+		mi := &file_api_thread_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -354,7 +426,7 @@ func (x *ThreadVoteRequest) String() string {
 func (*ThreadVoteRequest) ProtoMessage() {}
 
 func (x *ThreadVoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_thread_proto_msgTypes[4]
+	mi := &file_api_thread_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -367,7 +439,7 @@ func (x *ThreadVoteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ThreadVoteRequest.ProtoReflect.Descriptor instead.
 func (*ThreadVoteRequest) Descriptor() ([]byte, []int) {
-	return file_api_thread_proto_rawDescGZIP(), []int{4}
+	return file_api_thread_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ThreadVoteRequest) GetSlugOrId() string {
@@ -384,6 +456,343 @@ func (x *ThreadVoteRequest) GetVote() *models.Vote {
 	return nil
 }
 
+type SubscribeThreadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Идентификатор ветки обсуждения.
+	SlugOrId string `protobuf:"bytes,1,opt,name=slug_or_id,json=slugOrId,proto3" json:"slug_or_id,omitempty"`
+	// Идентификатор последнего полученного сообщения, для продолжения подписки.
+	// Без него отправляются только сообщения, созданные после подписки.
+	LastPostId int64 `protobuf:"varint,2,opt,name=last_post_id,json=lastPostId,proto3" json:"last_post_id,omitempty"`
+}
+
+func (x *SubscribeThreadRequest) Reset() {
+	*x = SubscribeThreadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_thread_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeThreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeThreadRequest) ProtoMessage() {}
+
+func (x *SubscribeThreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_thread_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeThreadRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeThreadRequest) Descriptor() ([]byte, []int) {
+	return file_api_thread_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SubscribeThreadRequest) GetSlugOrId() string {
+	if x != nil {
+		return x.SlugOrId
+	}
+	return ""
+}
+
+func (x *SubscribeThreadRequest) GetLastPostId() int64 {
+	if x != nil {
+		return x.LastPostId
+	}
+	return 0
+}
+
+type ThreadEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Идентификатор ветки обсуждения.
+	SlugOrId string `protobuf:"bytes,1,opt,name=slug_or_id,json=slugOrId,proto3" json:"slug_or_id,omitempty"`
+	// Идентификатор последнего полученного события, для продолжения подписки.
+	// Пропущенные новые сообщения отправляются повторно, изменения - нет,
+	// но первое событие с состоянием ветки содержит актуальный рейтинг.
+	LastEventId int64 `protobuf:"varint,2,opt,name=last_event_id,json=lastEventId,proto3" json:"last_event_id,omitempty"`
+}
+
+func (x *ThreadEventsRequest) Reset() {
+	*x = ThreadEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_thread_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThreadEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadEventsRequest) ProtoMessage() {}
+
+func (x *ThreadEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_thread_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadEventsRequest.ProtoReflect.Descriptor instead.
+func (*ThreadEventsRequest) Descriptor() ([]byte, []int) {
+	return file_api_thread_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ThreadEventsRequest) GetSlugOrId() string {
+	if x != nil {
+		return x.SlugOrId
+	}
+	return ""
+}
+
+func (x *ThreadEventsRequest) GetLastEventId() int64 {
+	if x != nil {
+		return x.LastEventId
+	}
+	return 0
+}
+
+type ThreadEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Идентификатор события: наибольший id отправленного к этому моменту сообщения.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Types that are assignable to Event:
+	//	*ThreadEvent_Post
+	//	*ThreadEvent_PostEdited
+	//	*ThreadEvent_Thread
+	Event isThreadEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *ThreadEvent) Reset() {
+	*x = ThreadEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_thread_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThreadEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadEvent) ProtoMessage() {}
+
+func (x *ThreadEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_thread_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadEvent.ProtoReflect.Descriptor instead.
+func (*ThreadEvent) Descriptor() ([]byte, []int) {
+	return file_api_thread_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ThreadEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (m *ThreadEvent) GetEvent() isThreadEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *ThreadEvent) GetPost() *models.Post {
+	if x, ok := x.GetEvent().(*ThreadEvent_Post); ok {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *ThreadEvent) GetPostEdited() *models.Post {
+	if x, ok := x.GetEvent().(*ThreadEvent_PostEdited); ok {
+		return x.PostEdited
+	}
+	return nil
+}
+
+func (x *ThreadEvent) GetThread() *models.Thread {
+	if x, ok := x.GetEvent().(*ThreadEvent_Thread); ok {
+		return x.Thread
+	}
+	return nil
+}
+
+type isThreadEvent_Event interface {
+	isThreadEvent_Event()
+}
+
+type ThreadEvent_Post struct {
+	// Новое сообщение.
+	Post *models.Post `protobuf:"bytes,2,opt,name=post,proto3,oneof"`
+}
+
+type ThreadEvent_PostEdited struct {
+	// Изменённое сообщение.
+	PostEdited *models.Post `protobuf:"bytes,3,opt,name=post_edited,json=postEdited,proto3,oneof"`
+}
+
+type ThreadEvent_Thread struct {
+	// Состояние ветки: при подписке и при изменении рейтинга.
+	Thread *models.Thread `protobuf:"bytes,4,opt,name=thread,proto3,oneof"`
+}
+
+func (*ThreadEvent_Post) isThreadEvent_Event() {}
+
+func (*ThreadEvent_PostEdited) isThreadEvent_Event() {}
+
+func (*ThreadEvent_Thread) isThreadEvent_Event() {}
+
+type ThreadSetStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Идентификатор ветки обсуждения.
+	SlugOrId string `protobuf:"bytes,1,opt,name=slug_or_id,json=slugOrId,proto3" json:"slug_or_id,omitempty"`
+	// Новое состояние ветки.
+	Status models.Thread_Status `protobuf:"varint,2,opt,name=status,proto3,enum=github.storm5758.Forum_test.api.models.Thread_Status" json:"status,omitempty"`
+}
+
+func (x *ThreadSetStatusRequest) Reset() {
+	*x = ThreadSetStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_thread_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThreadSetStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadSetStatusRequest) ProtoMessage() {}
+
+func (x *ThreadSetStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_thread_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadSetStatusRequest.ProtoReflect.Descriptor instead.
+func (*ThreadSetStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_thread_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ThreadSetStatusRequest) GetSlugOrId() string {
+	if x != nil {
+		return x.SlugOrId
+	}
+	return ""
+}
+
+func (x *ThreadSetStatusRequest) GetStatus() models.Thread_Status {
+	if x != nil {
+		return x.Status
+	}
+	return models.Thread_Status(0)
+}
+
+type ThreadPinRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Идентификатор ветки обсуждения.
+	SlugOrId string `protobuf:"bytes,1,opt,name=slug_or_id,json=slugOrId,proto3" json:"slug_or_id,omitempty"`
+	// Закрепить (true) или открепить (false) ветку.
+	Pinned bool `protobuf:"varint,2,opt,name=pinned,proto3" json:"pinned,omitempty"`
+}
+
+func (x *ThreadPinRequest) Reset() {
+	*x = ThreadPinRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_thread_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThreadPinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadPinRequest) ProtoMessage() {}
+
+func (x *ThreadPinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_thread_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadPinRequest.ProtoReflect.Descriptor instead.
+func (*ThreadPinRequest) Descriptor() ([]byte, []int) {
+	return file_api_thread_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ThreadPinRequest) GetSlugOrId() string {
+	if x != nil {
+		return x.SlugOrId
+	}
+	return ""
+}
+
+func (x *ThreadPinRequest) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
 // Сообщение для обновления ветки обсуждения на форуме.
 // Пустые параметры остаются без изменений.
 type ThreadUpdateRequest_ThreadUpdate struct {
@@ -395,12 +804,16 @@ type ThreadUpdateRequest_ThreadUpdate struct {
 	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	// Заголовок ветки обсуждения.
 	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// Новые метки ветки обсуждения, заменяют текущие.
+	Tags []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Удалить все метки ветки обсуждения.
+	ClearTags bool `protobuf:"varint,4,opt,name=clear_tags,json=clearTags,proto3" json:"clear_tags,omitempty"`
 }
 
 func (x *ThreadUpdateRequest_ThreadUpdate) Reset() {
 	*x = ThreadUpdateRequest_ThreadUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_thread_proto_msgTypes[5]
+		mi := &file_api_thread_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -413,7 +826,7 @@ func (x *ThreadUpdateRequest_ThreadUpdate) String() string {
 func (*ThreadUpdateRequest_ThreadUpdate) ProtoMessage() {}
 
 func (x *ThreadUpdateRequest_ThreadUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_api_thread_proto_msgTypes[5]
+	mi := &file_api_thread_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -426,7 +839,7 @@ func (x *ThreadUpdateRequest_ThreadUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ThreadUpdateRequest_ThreadUpdate.ProtoReflect.Descriptor instead.
 func (*ThreadUpdateRequest_ThreadUpdate) Descriptor() ([]byte, []int) {
-	return file_api_thread_proto_rawDescGZIP(), []int{3, 0}
+	return file_api_thread_proto_rawDescGZIP(), []int{4, 0}
 }
 
 func (x *ThreadUpdateRequest_ThreadUpdate) GetMessage() string {
@@ -443,129 +856,240 @@ func (x *ThreadUpdateRequest_ThreadUpdate) GetTitle() string {
 	return ""
 }
 
+func (x *ThreadUpdateRequest_ThreadUpdate) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ThreadUpdateRequest_ThreadUpdate) GetClearTags() bool {
+	if x != nil {
+		return x.ClearTags
+	}
+	return false
+}
+
 var File_api_thread_proto protoreflect.FileDescriptor
 
 var file_api_thread_proto_rawDesc = []byte{
 	0x0a, 0x10, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x1c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x6d, 0x6f, 0x67, 0x75, 0x63,
-	0x68, 0x65, 0x76, 0x2e, 0x42, 0x44, 0x5f, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69,
-	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x15, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x70, 0x6f, 0x73, 0x74,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x7a, 0x0a, 0x13, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67,
-	0x12, 0x49, 0x0a, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2b, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68,
-	0x65, 0x76, 0x2e, 0x42, 0x44, 0x5f, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x42, 0x04, 0xe2,
-	0x41, 0x01, 0x02, 0x52, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x22, 0x33, 0x0a, 0x13, 0x54,
-	0x68, 0x72, 0x65, 0x61, 0x64, 0x47, 0x65, 0x74, 0x4f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x0a, 0x73, 0x6c, 0x75, 0x67, 0x5f, 0x6f, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6c, 0x75, 0x67, 0x4f, 0x72, 0x49, 0x64,
-	0x22, 0xfb, 0x02, 0x0a, 0x15, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x47, 0x65, 0x74, 0x50, 0x6f,
-	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65,
-	0x73, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x14,
-	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0a, 0x73, 0x6c,
-	0x75, 0x67, 0x5f, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
-	0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x73, 0x6c, 0x75, 0x67, 0x4f, 0x72, 0x49, 0x64, 0x12, 0x61,
-	0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x4d, 0x2e, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68, 0x65, 0x76, 0x2e, 0x42,
-	0x44, 0x5f, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x68, 0x72, 0x65,
-	0x61, 0x64, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x73, 0x6f, 0x72,
-	0x74, 0x22, 0x9a, 0x01, 0x0a, 0x19, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x47, 0x65, 0x74, 0x50,
-	0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x12,
+	0x74, 0x6f, 0x12, 0x1f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d,
+	0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x15, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x61, 0x70, 0x69, 0x2f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x7d, 0x0a, 0x13, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x04, 0x73, 0x6c, 0x75,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x04, 0x73,
+	0x6c, 0x75, 0x67, 0x12, 0x4c, 0x0a, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x22, 0x33, 0x0a, 0x13, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x47, 0x65, 0x74, 0x4f, 0x6e,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x0a, 0x73, 0x6c, 0x75, 0x67,
+	0x5f, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6c,
+	0x75, 0x67, 0x4f, 0x72, 0x49, 0x64, 0x22, 0xc4, 0x03, 0x0a, 0x15, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x64, 0x65, 0x73, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x12, 0x22, 0x0a, 0x0a, 0x73, 0x6c, 0x75, 0x67, 0x5f, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x73, 0x6c, 0x75, 0x67,
+	0x4f, 0x72, 0x49, 0x64, 0x12, 0x64, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x50, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x53, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xc1, 0x01, 0x0a, 0x19, 0x54, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x22, 0x54, 0x48, 0x52, 0x45, 0x41,
+	0x44, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4c, 0x41, 0x54, 0x10, 0x00, 0x12,
 	0x26, 0x0a, 0x22, 0x54, 0x48, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x50, 0x4f,
 	0x53, 0x54, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54,
-	0x5f, 0x46, 0x4c, 0x41, 0x54, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x54, 0x48, 0x52, 0x45, 0x41,
+	0x5f, 0x54, 0x52, 0x45, 0x45, 0x10, 0x01, 0x12, 0x2d, 0x0a, 0x29, 0x54, 0x48, 0x52, 0x45, 0x41,
 	0x44, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55,
-	0x45, 0x53, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x52, 0x45, 0x45, 0x10, 0x01, 0x12,
-	0x2d, 0x0a, 0x29, 0x54, 0x48, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x50, 0x4f,
-	0x53, 0x54, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54,
-	0x5f, 0x50, 0x41, 0x52, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x52, 0x45, 0x45, 0x10, 0x02, 0x22, 0xd7,
-	0x01, 0x0a, 0x13, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x73, 0x6c, 0x75, 0x67, 0x5f, 0x6f,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02,
-	0x52, 0x08, 0x73, 0x6c, 0x75, 0x67, 0x4f, 0x72, 0x49, 0x64, 0x12, 0x5c, 0x0a, 0x06, 0x74, 0x68,
-	0x72, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68, 0x65, 0x76, 0x2e, 0x42, 0x44, 0x5f,
-	0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x68,
-	0x72, 0x65, 0x61, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02,
-	0x52, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x1a, 0x3e, 0x0a, 0x0c, 0x54, 0x68, 0x72, 0x65,
-	0x61, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x7c, 0x0a, 0x11, 0x54, 0x68, 0x72, 0x65,
-	0x61, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a,
-	0x0a, 0x73, 0x6c, 0x75, 0x67, 0x5f, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x73, 0x6c, 0x75, 0x67, 0x4f, 0x72, 0x49,
-	0x64, 0x12, 0x43, 0x0a, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x29, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68, 0x65,
-	0x76, 0x2e, 0x42, 0x44, 0x5f, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02,
-	0x52, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x32, 0x98, 0x06, 0x0a, 0x06, 0x54, 0x68, 0x72, 0x65, 0x61,
-	0x64, 0x12, 0x98, 0x01, 0x0a, 0x0c, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x12, 0x31, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x6d, 0x6f, 0x67, 0x75,
-	0x63, 0x68, 0x65, 0x76, 0x2e, 0x42, 0x44, 0x5f, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x6d,
-	0x6f, 0x67, 0x75, 0x63, 0x68, 0x65, 0x76, 0x2e, 0x42, 0x44, 0x5f, 0x46, 0x6f, 0x72, 0x75, 0x6d,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x68, 0x72, 0x65,
-	0x61, 0x64, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x2f, 0x7b, 0x73, 0x6c, 0x75, 0x67, 0x7d, 0x2f, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x3a, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x98, 0x01, 0x0a,
-	0x0c, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x47, 0x65, 0x74, 0x4f, 0x6e, 0x65, 0x12, 0x31, 0x2e,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68, 0x65, 0x76, 0x2e,
-	0x42, 0x44, 0x5f, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x68, 0x72,
-	0x65, 0x61, 0x64, 0x47, 0x65, 0x74, 0x4f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2b, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68,
-	0x65, 0x76, 0x2e, 0x42, 0x44, 0x5f, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x45, 0x53, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x50, 0x41, 0x52, 0x45, 0x4e, 0x54, 0x5f,
+	0x54, 0x52, 0x45, 0x45, 0x10, 0x02, 0x12, 0x25, 0x0a, 0x21, 0x54, 0x48, 0x52, 0x45, 0x41, 0x44,
+	0x5f, 0x47, 0x45, 0x54, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45,
+	0x53, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x4f, 0x50, 0x10, 0x03, 0x22, 0x84, 0x01,
+	0x0a, 0x16, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d,
+	0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8d, 0x02, 0x0a, 0x13, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a,
+	0x73, 0x6c, 0x75, 0x67, 0x5f, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x73, 0x6c, 0x75, 0x67, 0x4f, 0x72, 0x49, 0x64,
+	0x12, 0x5f, 0x0a, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x41, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35,
+	0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x1a, 0x71, 0x0a, 0x0c, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x74,
+	0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6c, 0x65, 0x61, 0x72,
+	0x54, 0x61, 0x67, 0x73, 0x22, 0x7f, 0x0a, 0x11, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x56, 0x6f,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x73, 0x6c, 0x75,
+	0x67, 0x5f, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2,
+	0x41, 0x01, 0x02, 0x52, 0x08, 0x73, 0x6c, 0x75, 0x67, 0x4f, 0x72, 0x49, 0x64, 0x12, 0x46, 0x0a,
+	0x04, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46,
+	0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52,
+	0x04, 0x76, 0x6f, 0x74, 0x65, 0x22, 0x5e, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x22, 0x0a, 0x0a, 0x73, 0x6c, 0x75, 0x67, 0x5f, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x73, 0x6c, 0x75, 0x67, 0x4f,
+	0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x5d, 0x0a, 0x13, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a,
+	0x73, 0x6c, 0x75, 0x67, 0x5f, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x73, 0x6c, 0x75, 0x67, 0x4f, 0x72, 0x49, 0x64,
+	0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x22, 0x85, 0x02, 0x0a, 0x0b, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x42, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38,
+	0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x70,
+	0x6f, 0x73, 0x74, 0x45, 0x64, 0x69, 0x74, 0x65, 0x64, 0x12, 0x48, 0x0a, 0x06, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72,
+	0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x48, 0x00, 0x52, 0x06, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x8b, 0x01, 0x0a,
+	0x16, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x53, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x73, 0x6c, 0x75, 0x67, 0x5f,
+	0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01,
+	0x02, 0x52, 0x08, 0x73, 0x6c, 0x75, 0x67, 0x4f, 0x72, 0x49, 0x64, 0x12, 0x4d, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46,
+	0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4e, 0x0a, 0x10, 0x54, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x50, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22,
+	0x0a, 0x0a, 0x73, 0x6c, 0x75, 0x67, 0x5f, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x73, 0x6c, 0x75, 0x67, 0x4f, 0x72,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x32, 0xfb, 0x0a, 0x0a, 0x06, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x9e, 0x01, 0x0a, 0x0c, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x34, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e,
+	0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x22, 0x28, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x22, 0x3a, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x22, 0x18, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x2f, 0x7b, 0x73, 0x6c, 0x75, 0x67, 0x7d, 0x2f,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x9e, 0x01, 0x0a, 0x0c, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x47, 0x65, 0x74, 0x4f, 0x6e, 0x65, 0x12, 0x34, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d,
+	0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x47, 0x65, 0x74, 0x4f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38,
+	0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e,
 	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x22, 0x28, 0x82,
 	0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x68, 0x72, 0x65,
 	0x61, 0x64, 0x2f, 0x7b, 0x73, 0x6c, 0x75, 0x67, 0x5f, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
-	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x9a, 0x01, 0x0a, 0x0e, 0x54, 0x68, 0x72, 0x65,
-	0x61, 0x64, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x33, 0x2e, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68, 0x65, 0x76, 0x2e, 0x42, 0x44, 0x5f,
-	0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64,
-	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2b, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68, 0x65,
-	0x76, 0x2e, 0x42, 0x44, 0x5f, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x22, 0x26, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x68, 0x72, 0x65, 0x61,
-	0x64, 0x2f, 0x7b, 0x73, 0x6c, 0x75, 0x67, 0x5f, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70,
-	0x6f, 0x73, 0x74, 0x73, 0x12, 0xa0, 0x01, 0x0a, 0x0c, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x31, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x6d,
-	0x6f, 0x67, 0x75, 0x63, 0x68, 0x65, 0x76, 0x2e, 0x42, 0x44, 0x5f, 0x46, 0x6f, 0x72, 0x75, 0x6d,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68, 0x65, 0x76, 0x2e, 0x42, 0x44, 0x5f, 0x46, 0x6f,
-	0x72, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54,
-	0x68, 0x72, 0x65, 0x61, 0x64, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x22, 0x20, 0x2f,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0xb0, 0x01, 0x0a, 0x0e, 0x54, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x36, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f,
+	0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x37, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x27, 0x62, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2f, 0x7b, 0x73, 0x6c, 0x75, 0x67, 0x5f, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0xa6, 0x01, 0x0a, 0x0c, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x34, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46,
+	0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2e, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d,
+	0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2f, 0x7b, 0x73, 0x6c, 0x75, 0x67, 0x5f, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x3a, 0x06, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x12, 0x9d, 0x01, 0x0a, 0x0a, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x56, 0x6f,
+	0x74, 0x65, 0x12, 0x32, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x1d,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2f, 0x7b, 0x73, 0x6c, 0x75,
+	0x67, 0x5f, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x6f, 0x74, 0x65, 0x3a, 0x04, 0x76,
+	0x6f, 0x74, 0x65, 0x12, 0x7a, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x37, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2c, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37,
+	0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x30, 0x01, 0x12,
+	0x74, 0x0a, 0x0c, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x34, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37,
+	0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0xa6, 0x01, 0x0a, 0x0f, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x53, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x37, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72,
+	0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x53, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f,
 	0x61, 0x70, 0x69, 0x2f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2f, 0x7b, 0x73, 0x6c, 0x75, 0x67,
-	0x5f, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x3a,
-	0x06, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x97, 0x01, 0x0a, 0x0a, 0x54, 0x68, 0x72, 0x65,
-	0x61, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x2f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68, 0x65, 0x76, 0x2e, 0x42, 0x44, 0x5f, 0x46, 0x6f, 0x72, 0x75,
-	0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x56, 0x6f, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68, 0x65, 0x76, 0x2e, 0x42, 0x44, 0x5f, 0x46, 0x6f, 0x72,
-	0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x68,
-	0x72, 0x65, 0x61, 0x64, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x1d, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x2f, 0x7b, 0x73, 0x6c, 0x75, 0x67, 0x5f,
-	0x6f, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x6f, 0x74, 0x65, 0x3a, 0x04, 0x76, 0x6f, 0x74,
-	0x65, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x6d, 0x6f, 0x67, 0x75, 0x63, 0x68, 0x65, 0x76, 0x2f, 0x42, 0x44, 0x2d, 0x46, 0x6f, 0x72, 0x75,
-	0x6d, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5f, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x97,
+	0x01, 0x0a, 0x09, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x50, 0x69, 0x6e, 0x12, 0x31, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38, 0x2e,
+	0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x50, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2e, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37,
+	0x35, 0x38, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x22,
+	0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x2f, 0x7b, 0x73, 0x6c, 0x75, 0x67, 0x5f, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x70, 0x69, 0x6e, 0x3a, 0x01, 0x2a, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x35, 0x37, 0x35, 0x38,
+	0x2f, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x2d, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x61, 0x70, 0x69, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -581,38 +1105,59 @@ func file_api_thread_proto_rawDescGZIP() []byte {
 }
 
 var file_api_thread_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_thread_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_thread_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_api_thread_proto_goTypes = []interface{}{
-	(ThreadGetPostsRequest_ThreadGetPostsRequestSort)(0), // 0: github.moguchev.BD_Forum.api.ThreadGetPostsRequest.ThreadGetPostsRequestSort
-	(*ThreadCreateRequest)(nil),                          // 1: github.moguchev.BD_Forum.api.ThreadCreateRequest
-	(*ThreadGetOneRequest)(nil),                          // 2: github.moguchev.BD_Forum.api.ThreadGetOneRequest
-	(*ThreadGetPostsRequest)(nil),                        // 3: github.moguchev.BD_Forum.api.ThreadGetPostsRequest
-	(*ThreadUpdateRequest)(nil),                          // 4: github.moguchev.BD_Forum.api.ThreadUpdateRequest
-	(*ThreadVoteRequest)(nil),                            // 5: github.moguchev.BD_Forum.api.ThreadVoteRequest
-	(*ThreadUpdateRequest_ThreadUpdate)(nil),             // 6: github.moguchev.BD_Forum.api.ThreadUpdateRequest.ThreadUpdate
-	(*models.Thread)(nil),                                // 7: github.moguchev.BD_Forum.api.models.Thread
-	(*models.Vote)(nil),                                  // 8: github.moguchev.BD_Forum.api.models.Vote
+	(ThreadGetPostsRequest_ThreadGetPostsRequestSort)(0), // 0: github.storm5758.Forum_test.api.ThreadGetPostsRequest.ThreadGetPostsRequestSort
+	(*ThreadCreateRequest)(nil),                          // 1: github.storm5758.Forum_test.api.ThreadCreateRequest
+	(*ThreadGetOneRequest)(nil),                          // 2: github.storm5758.Forum_test.api.ThreadGetOneRequest
+	(*ThreadGetPostsRequest)(nil),                        // 3: github.storm5758.Forum_test.api.ThreadGetPostsRequest
+	(*ThreadGetPostsResponse)(nil),                       // 4: github.storm5758.Forum_test.api.ThreadGetPostsResponse
+	(*ThreadUpdateRequest)(nil),                          // 5: github.storm5758.Forum_test.api.ThreadUpdateRequest
+	(*ThreadVoteRequest)(nil),                            // 6: github.storm5758.Forum_test.api.ThreadVoteRequest
+	(*SubscribeThreadRequest)(nil),                       // 7: github.storm5758.Forum_test.api.SubscribeThreadRequest
+	(*ThreadEventsRequest)(nil),                          // 8: github.storm5758.Forum_test.api.ThreadEventsRequest
+	(*ThreadEvent)(nil),                                  // 9: github.storm5758.Forum_test.api.ThreadEvent
+	(*ThreadSetStatusRequest)(nil),                       // 10: github.storm5758.Forum_test.api.ThreadSetStatusRequest
+	(*ThreadPinRequest)(nil),                             // 11: github.storm5758.Forum_test.api.ThreadPinRequest
+	(*ThreadUpdateRequest_ThreadUpdate)(nil),             // 12: github.storm5758.Forum_test.api.ThreadUpdateRequest.ThreadUpdate
+	(*models.Thread)(nil),                                // 13: github.storm5758.Forum_test.api.models.Thread
+	(*models.Post)(nil),                                  // 14: github.storm5758.Forum_test.api.models.Post
+	(*models.Vote)(nil),                                  // 15: github.storm5758.Forum_test.api.models.Vote
+	(models.Thread_Status)(0),                            // 16: github.storm5758.Forum_test.api.models.Thread.Status
 }
 var file_api_thread_proto_depIdxs = []int32{
-	7, // 0: github.moguchev.BD_Forum.api.ThreadCreateRequest.thread:type_name -> github.moguchev.BD_Forum.api.models.Thread
-	0, // 1: github.moguchev.BD_Forum.api.ThreadGetPostsRequest.sort:type_name -> github.moguchev.BD_Forum.api.ThreadGetPostsRequest.ThreadGetPostsRequestSort
-	6, // 2: github.moguchev.BD_Forum.api.ThreadUpdateRequest.thread:type_name -> github.moguchev.BD_Forum.api.ThreadUpdateRequest.ThreadUpdate
-	8, // 3: github.moguchev.BD_Forum.api.ThreadVoteRequest.vote:type_name -> github.moguchev.BD_Forum.api.models.Vote
-	1, // 4: github.moguchev.BD_Forum.api.Thread.ThreadCreate:input_type -> github.moguchev.BD_Forum.api.ThreadCreateRequest
-	2, // 5: github.moguchev.BD_Forum.api.Thread.ThreadGetOne:input_type -> github.moguchev.BD_Forum.api.ThreadGetOneRequest
-	3, // 6: github.moguchev.BD_Forum.api.Thread.ThreadGetPosts:input_type -> github.moguchev.BD_Forum.api.ThreadGetPostsRequest
-	4, // 7: github.moguchev.BD_Forum.api.Thread.ThreadUpdate:input_type -> github.moguchev.BD_Forum.api.ThreadUpdateRequest
-	5, // 8: github.moguchev.BD_Forum.api.Thread.ThreadVote:input_type -> github.moguchev.BD_Forum.api.ThreadVoteRequest
-	7, // 9: github.moguchev.BD_Forum.api.Thread.ThreadCreate:output_type -> github.moguchev.BD_Forum.api.models.Thread
-	7, // 10: github.moguchev.BD_Forum.api.Thread.ThreadGetOne:output_type -> github.moguchev.BD_Forum.api.models.Thread
-	7, // 11: github.moguchev.BD_Forum.api.Thread.ThreadGetPosts:output_type -> github.moguchev.BD_Forum.api.models.Thread
-	7, // 12: github.moguchev.BD_Forum.api.Thread.ThreadUpdate:output_type -> github.moguchev.BD_Forum.api.models.Thread
-	7, // 13: github.moguchev.BD_Forum.api.Thread.ThreadVote:output_type -> github.moguchev.BD_Forum.api.models.Thread
-	9, // [9:14] is the sub-list for method output_type
-	4, // [4:9] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	13, // 0: github.storm5758.Forum_test.api.ThreadCreateRequest.thread:type_name -> github.storm5758.Forum_test.api.models.Thread
+	0,  // 1: github.storm5758.Forum_test.api.ThreadGetPostsRequest.sort:type_name -> github.storm5758.Forum_test.api.ThreadGetPostsRequest.ThreadGetPostsRequestSort
+	14, // 2: github.storm5758.Forum_test.api.ThreadGetPostsResponse.posts:type_name -> github.storm5758.Forum_test.api.models.Post
+	12, // 3: github.storm5758.Forum_test.api.ThreadUpdateRequest.thread:type_name -> github.storm5758.Forum_test.api.ThreadUpdateRequest.ThreadUpdate
+	15, // 4: github.storm5758.Forum_test.api.ThreadVoteRequest.vote:type_name -> github.storm5758.Forum_test.api.models.Vote
+	14, // 5: github.storm5758.Forum_test.api.ThreadEvent.post:type_name -> github.storm5758.Forum_test.api.models.Post
+	14, // 6: github.storm5758.Forum_test.api.ThreadEvent.post_edited:type_name -> github.storm5758.Forum_test.api.models.Post
+	13, // 7: github.storm5758.Forum_test.api.ThreadEvent.thread:type_name -> github.storm5758.Forum_test.api.models.Thread
+	16, // 8: github.storm5758.Forum_test.api.ThreadSetStatusRequest.status:type_name -> github.storm5758.Forum_test.api.models.Thread.Status
+	1,  // 9: github.storm5758.Forum_test.api.Thread.ThreadCreate:input_type -> github.storm5758.Forum_test.api.ThreadCreateRequest
+	2,  // 10: github.storm5758.Forum_test.api.Thread.ThreadGetOne:input_type -> github.storm5758.Forum_test.api.ThreadGetOneRequest
+	3,  // 11: github.storm5758.Forum_test.api.Thread.ThreadGetPosts:input_type -> github.storm5758.Forum_test.api.ThreadGetPostsRequest
+	5,  // 12: github.storm5758.Forum_test.api.Thread.ThreadUpdate:input_type -> github.storm5758.Forum_test.api.ThreadUpdateRequest
+	6,  // 13: github.storm5758.Forum_test.api.Thread.ThreadVote:input_type -> github.storm5758.Forum_test.api.ThreadVoteRequest
+	7,  // 14: github.storm5758.Forum_test.api.Thread.SubscribeThread:input_type -> github.storm5758.Forum_test.api.SubscribeThreadRequest
+	8,  // 15: github.storm5758.Forum_test.api.Thread.ThreadEvents:input_type -> github.storm5758.Forum_test.api.ThreadEventsRequest
+	10, // 16: github.storm5758.Forum_test.api.Thread.ThreadSetStatus:input_type -> github.storm5758.Forum_test.api.ThreadSetStatusRequest
+	11, // 17: github.storm5758.Forum_test.api.Thread.ThreadPin:input_type -> github.storm5758.Forum_test.api.ThreadPinRequest
+	13, // 18: github.storm5758.Forum_test.api.Thread.ThreadCreate:output_type -> github.storm5758.Forum_test.api.models.Thread
+	13, // 19: github.storm5758.Forum_test.api.Thread.ThreadGetOne:output_type -> github.storm5758.Forum_test.api.models.Thread
+	4,  // 20: github.storm5758.Forum_test.api.Thread.ThreadGetPosts:output_type -> github.storm5758.Forum_test.api.ThreadGetPostsResponse
+	13, // 21: github.storm5758.Forum_test.api.Thread.ThreadUpdate:output_type -> github.storm5758.Forum_test.api.models.Thread
+	13, // 22: github.storm5758.Forum_test.api.Thread.ThreadVote:output_type -> github.storm5758.Forum_test.api.models.Thread
+	14, // 23: github.storm5758.Forum_test.api.Thread.SubscribeThread:output_type -> github.storm5758.Forum_test.api.models.Post
+	9,  // 24: github.storm5758.Forum_test.api.Thread.ThreadEvents:output_type -> github.storm5758.Forum_test.api.ThreadEvent
+	13, // 25: github.storm5758.Forum_test.api.Thread.ThreadSetStatus:output_type -> github.storm5758.Forum_test.api.models.Thread
+	13, // 26: github.storm5758.Forum_test.api.Thread.ThreadPin:output_type -> github.storm5758.Forum_test.api.models.Thread
+	18, // [18:27] is the sub-list for method output_type
+	9,  // [9:18] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_api_thread_proto_init() }
@@ -658,7 +1203,7 @@ func file_api_thread_proto_init() {
 			}
 		}
 		file_api_thread_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ThreadUpdateRequest); i {
+			switch v := v.(*ThreadGetPostsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -670,7 +1215,7 @@ func file_api_thread_proto_init() {
 			}
 		}
 		file_api_thread_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ThreadVoteRequest); i {
+			switch v := v.(*ThreadUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -682,6 +1227,78 @@ func file_api_thread_proto_init() {
 			}
 		}
 		file_api_thread_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThreadVoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_thread_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeThreadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_thread_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThreadEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_thread_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThreadEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_thread_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThreadSetStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_thread_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThreadPinRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_thread_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ThreadUpdateRequest_ThreadUpdate); i {
 			case 0:
 				return &v.state
@@ -694,13 +1311,18 @@ func file_api_thread_proto_init() {
 			}
 		}
 	}
+	file_api_thread_proto_msgTypes[8].OneofWrappers = []interface{}{
+		(*ThreadEvent_Post)(nil),
+		(*ThreadEvent_PostEdited)(nil),
+		(*ThreadEvent_Thread)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_thread_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   6,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
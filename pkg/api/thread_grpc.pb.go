@@ -36,7 +36,7 @@ type ThreadClient interface {
 	// Получение списка сообщений в данной ветке форуме.
 	//
 	// Сообщения выводятся отсортированные по дате создания.
-	ThreadGetPosts(ctx context.Context, in *ThreadGetPostsRequest, opts ...grpc.CallOption) (*models.Thread, error)
+	ThreadGetPosts(ctx context.Context, in *ThreadGetPostsRequest, opts ...grpc.CallOption) (*ThreadGetPostsResponse, error)
 	// Обновление ветки
 	//
 	// Обновление ветки обсуждения на форуме.
@@ -48,6 +48,26 @@ type ThreadClient interface {
 	// Один пользователь учитывается только один раз и может изменить своё
 	// мнение.
 	ThreadVote(ctx context.Context, in *ThreadVoteRequest, opts ...grpc.CallOption) (*models.Thread, error)
+	// Подписка на новые сообщения ветки
+	//
+	// Сообщения отправляются по мере создания, в порядке возрастания id.
+	// Если указан last_post_id, сначала отправляются сообщения, созданные после него.
+	SubscribeThread(ctx context.Context, in *SubscribeThreadRequest, opts ...grpc.CallOption) (Thread_SubscribeThreadClient, error)
+	// События ветки
+	//
+	// Новые сообщения, изменения сообщений и рейтинга ветки.
+	// Первым событием отправляется текущее состояние ветки.
+	// Для браузеров доступно как Server-Sent Events: GET /api/thread/{slug_or_id}/events.
+	ThreadEvents(ctx context.Context, in *ThreadEventsRequest, opts ...grpc.CallOption) (Thread_ThreadEventsClient, error)
+	// Изменение состояния ветки
+	//
+	// Закрытие ветки для новых сообщений, перенос в архив или открытие.
+	// Ветка в архиве доступна только для чтения.
+	ThreadSetStatus(ctx context.Context, in *ThreadSetStatusRequest, opts ...grpc.CallOption) (*models.Thread, error)
+	// Закрепление ветки
+	//
+	// Закреплённые ветки выводятся в списке веток форума первыми.
+	ThreadPin(ctx context.Context, in *ThreadPinRequest, opts ...grpc.CallOption) (*models.Thread, error)
 }
 
 type threadClient struct {
@@ -60,7 +80,7 @@ func NewThreadClient(cc grpc.ClientConnInterface) ThreadClient {
 
 func (c *threadClient) ThreadCreate(ctx context.Context, in *ThreadCreateRequest, opts ...grpc.CallOption) (*models.Thread, error) {
 	out := new(models.Thread)
-	err := c.cc.Invoke(ctx, "/github.moguchev.BD_Forum.api.Thread/ThreadCreate", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Thread/ThreadCreate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -69,16 +89,16 @@ func (c *threadClient) ThreadCreate(ctx context.Context, in *ThreadCreateRequest
 
 func (c *threadClient) ThreadGetOne(ctx context.Context, in *ThreadGetOneRequest, opts ...grpc.CallOption) (*models.Thread, error) {
 	out := new(models.Thread)
-	err := c.cc.Invoke(ctx, "/github.moguchev.BD_Forum.api.Thread/ThreadGetOne", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Thread/ThreadGetOne", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *threadClient) ThreadGetPosts(ctx context.Context, in *ThreadGetPostsRequest, opts ...grpc.CallOption) (*models.Thread, error) {
-	out := new(models.Thread)
-	err := c.cc.Invoke(ctx, "/github.moguchev.BD_Forum.api.Thread/ThreadGetPosts", in, out, opts...)
+func (c *threadClient) ThreadGetPosts(ctx context.Context, in *ThreadGetPostsRequest, opts ...grpc.CallOption) (*ThreadGetPostsResponse, error) {
+	out := new(ThreadGetPostsResponse)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Thread/ThreadGetPosts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -87,7 +107,7 @@ func (c *threadClient) ThreadGetPosts(ctx context.Context, in *ThreadGetPostsReq
 
 func (c *threadClient) ThreadUpdate(ctx context.Context, in *ThreadUpdateRequest, opts ...grpc.CallOption) (*models.Thread, error) {
 	out := new(models.Thread)
-	err := c.cc.Invoke(ctx, "/github.moguchev.BD_Forum.api.Thread/ThreadUpdate", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Thread/ThreadUpdate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +116,89 @@ func (c *threadClient) ThreadUpdate(ctx context.Context, in *ThreadUpdateRequest
 
 func (c *threadClient) ThreadVote(ctx context.Context, in *ThreadVoteRequest, opts ...grpc.CallOption) (*models.Thread, error) {
 	out := new(models.Thread)
-	err := c.cc.Invoke(ctx, "/github.moguchev.BD_Forum.api.Thread/ThreadVote", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Thread/ThreadVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadClient) SubscribeThread(ctx context.Context, in *SubscribeThreadRequest, opts ...grpc.CallOption) (Thread_SubscribeThreadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Thread_ServiceDesc.Streams[0], "/github.storm5758.Forum_test.api.Thread/SubscribeThread", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &threadSubscribeThreadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Thread_SubscribeThreadClient interface {
+	Recv() (*models.Post, error)
+	grpc.ClientStream
+}
+
+type threadSubscribeThreadClient struct {
+	grpc.ClientStream
+}
+
+func (x *threadSubscribeThreadClient) Recv() (*models.Post, error) {
+	m := new(models.Post)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *threadClient) ThreadEvents(ctx context.Context, in *ThreadEventsRequest, opts ...grpc.CallOption) (Thread_ThreadEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Thread_ServiceDesc.Streams[1], "/github.storm5758.Forum_test.api.Thread/ThreadEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &threadThreadEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Thread_ThreadEventsClient interface {
+	Recv() (*ThreadEvent, error)
+	grpc.ClientStream
+}
+
+type threadThreadEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *threadThreadEventsClient) Recv() (*ThreadEvent, error) {
+	m := new(ThreadEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *threadClient) ThreadSetStatus(ctx context.Context, in *ThreadSetStatusRequest, opts ...grpc.CallOption) (*models.Thread, error) {
+	out := new(models.Thread)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Thread/ThreadSetStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadClient) ThreadPin(ctx context.Context, in *ThreadPinRequest, opts ...grpc.CallOption) (*models.Thread, error) {
+	out := new(models.Thread)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Thread/ThreadPin", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -120,7 +222,7 @@ type ThreadServer interface {
 	// Получение списка сообщений в данной ветке форуме.
 	//
 	// Сообщения выводятся отсортированные по дате создания.
-	ThreadGetPosts(context.Context, *ThreadGetPostsRequest) (*models.Thread, error)
+	ThreadGetPosts(context.Context, *ThreadGetPostsRequest) (*ThreadGetPostsResponse, error)
 	// Обновление ветки
 	//
 	// Обновление ветки обсуждения на форуме.
@@ -132,6 +234,26 @@ type ThreadServer interface {
 	// Один пользователь учитывается только один раз и может изменить своё
 	// мнение.
 	ThreadVote(context.Context, *ThreadVoteRequest) (*models.Thread, error)
+	// Подписка на новые сообщения ветки
+	//
+	// Сообщения отправляются по мере создания, в порядке возрастания id.
+	// Если указан last_post_id, сначала отправляются сообщения, созданные после него.
+	SubscribeThread(*SubscribeThreadRequest, Thread_SubscribeThreadServer) error
+	// События ветки
+	//
+	// Новые сообщения, изменения сообщений и рейтинга ветки.
+	// Первым событием отправляется текущее состояние ветки.
+	// Для браузеров доступно как Server-Sent Events: GET /api/thread/{slug_or_id}/events.
+	ThreadEvents(*ThreadEventsRequest, Thread_ThreadEventsServer) error
+	// Изменение состояния ветки
+	//
+	// Закрытие ветки для новых сообщений, перенос в архив или открытие.
+	// Ветка в архиве доступна только для чтения.
+	ThreadSetStatus(context.Context, *ThreadSetStatusRequest) (*models.Thread, error)
+	// Закрепление ветки
+	//
+	// Закреплённые ветки выводятся в списке веток форума первыми.
+	ThreadPin(context.Context, *ThreadPinRequest) (*models.Thread, error)
 	mustEmbedUnimplementedThreadServer()
 }
 
@@ -145,7 +267,7 @@ func (UnimplementedThreadServer) ThreadCreate(context.Context, *ThreadCreateRequ
 func (UnimplementedThreadServer) ThreadGetOne(context.Context, *ThreadGetOneRequest) (*models.Thread, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ThreadGetOne not implemented")
 }
-func (UnimplementedThreadServer) ThreadGetPosts(context.Context, *ThreadGetPostsRequest) (*models.Thread, error) {
+func (UnimplementedThreadServer) ThreadGetPosts(context.Context, *ThreadGetPostsRequest) (*ThreadGetPostsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ThreadGetPosts not implemented")
 }
 func (UnimplementedThreadServer) ThreadUpdate(context.Context, *ThreadUpdateRequest) (*models.Thread, error) {
@@ -154,6 +276,18 @@ func (UnimplementedThreadServer) ThreadUpdate(context.Context, *ThreadUpdateRequ
 func (UnimplementedThreadServer) ThreadVote(context.Context, *ThreadVoteRequest) (*models.Thread, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ThreadVote not implemented")
 }
+func (UnimplementedThreadServer) SubscribeThread(*SubscribeThreadRequest, Thread_SubscribeThreadServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeThread not implemented")
+}
+func (UnimplementedThreadServer) ThreadEvents(*ThreadEventsRequest, Thread_ThreadEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ThreadEvents not implemented")
+}
+func (UnimplementedThreadServer) ThreadSetStatus(context.Context, *ThreadSetStatusRequest) (*models.Thread, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ThreadSetStatus not implemented")
+}
+func (UnimplementedThreadServer) ThreadPin(context.Context, *ThreadPinRequest) (*models.Thread, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ThreadPin not implemented")
+}
 func (UnimplementedThreadServer) mustEmbedUnimplementedThreadServer() {}
 
 // UnsafeThreadServer may be embedded to opt out of forward compatibility for this service.
@@ -177,7 +311,7 @@ func _Thread_ThreadCreate_Handler(srv interface{}, ctx context.Context, dec func
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/github.moguchev.BD_Forum.api.Thread/ThreadCreate",
+		FullMethod: "/github.storm5758.Forum_test.api.Thread/ThreadCreate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ThreadServer).ThreadCreate(ctx, req.(*ThreadCreateRequest))
@@ -195,7 +329,7 @@ func _Thread_ThreadGetOne_Handler(srv interface{}, ctx context.Context, dec func
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/github.moguchev.BD_Forum.api.Thread/ThreadGetOne",
+		FullMethod: "/github.storm5758.Forum_test.api.Thread/ThreadGetOne",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ThreadServer).ThreadGetOne(ctx, req.(*ThreadGetOneRequest))
@@ -213,7 +347,7 @@ func _Thread_ThreadGetPosts_Handler(srv interface{}, ctx context.Context, dec fu
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/github.moguchev.BD_Forum.api.Thread/ThreadGetPosts",
+		FullMethod: "/github.storm5758.Forum_test.api.Thread/ThreadGetPosts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ThreadServer).ThreadGetPosts(ctx, req.(*ThreadGetPostsRequest))
@@ -231,7 +365,7 @@ func _Thread_ThreadUpdate_Handler(srv interface{}, ctx context.Context, dec func
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/github.moguchev.BD_Forum.api.Thread/ThreadUpdate",
+		FullMethod: "/github.storm5758.Forum_test.api.Thread/ThreadUpdate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ThreadServer).ThreadUpdate(ctx, req.(*ThreadUpdateRequest))
@@ -249,7 +383,7 @@ func _Thread_ThreadVote_Handler(srv interface{}, ctx context.Context, dec func(i
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/github.moguchev.BD_Forum.api.Thread/ThreadVote",
+		FullMethod: "/github.storm5758.Forum_test.api.Thread/ThreadVote",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ThreadServer).ThreadVote(ctx, req.(*ThreadVoteRequest))
@@ -257,11 +391,89 @@ func _Thread_ThreadVote_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Thread_SubscribeThread_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeThreadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ThreadServer).SubscribeThread(m, &threadSubscribeThreadServer{stream})
+}
+
+type Thread_SubscribeThreadServer interface {
+	Send(*models.Post) error
+	grpc.ServerStream
+}
+
+type threadSubscribeThreadServer struct {
+	grpc.ServerStream
+}
+
+func (x *threadSubscribeThreadServer) Send(m *models.Post) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Thread_ThreadEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ThreadEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ThreadServer).ThreadEvents(m, &threadThreadEventsServer{stream})
+}
+
+type Thread_ThreadEventsServer interface {
+	Send(*ThreadEvent) error
+	grpc.ServerStream
+}
+
+type threadThreadEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *threadThreadEventsServer) Send(m *ThreadEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Thread_ThreadSetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ThreadSetStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServer).ThreadSetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.Thread/ThreadSetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServer).ThreadSetStatus(ctx, req.(*ThreadSetStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Thread_ThreadPin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ThreadPinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServer).ThreadPin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.Thread/ThreadPin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServer).ThreadPin(ctx, req.(*ThreadPinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Thread_ServiceDesc is the grpc.ServiceDesc for Thread service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var Thread_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "github.moguchev.BD_Forum.api.Thread",
+	ServiceName: "github.storm5758.Forum_test.api.Thread",
 	HandlerType: (*ThreadServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
@@ -284,7 +496,26 @@ var Thread_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ThreadVote",
 			Handler:    _Thread_ThreadVote_Handler,
 		},
+		{
+			MethodName: "ThreadSetStatus",
+			Handler:    _Thread_ThreadSetStatus_Handler,
+		},
+		{
+			MethodName: "ThreadPin",
+			Handler:    _Thread_ThreadPin_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeThread",
+			Handler:       _Thread_SubscribeThread_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ThreadEvents",
+			Handler:       _Thread_ThreadEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/thread.proto",
 }
@@ -35,6 +35,14 @@ type UserClient interface {
 	//
 	// Изменение информации в профиле пользователя.
 	UserUpdate(ctx context.Context, in *UserUpdateRequest, opts ...grpc.CallOption) (*models.User, error)
+	// Загрузка аватара
+	//
+	// Изображение PNG, JPEG или GIF до 2 МБ и не больше 4096x4096 обрезается до квадрата
+	// и сохраняется в стандартных размерах. Через HTTP шлюз загружается формой multipart/form-data
+	// с файлом в поле avatar: POST /api/user/{nickname}/avatar.
+	UserAvatarUpload(ctx context.Context, in *UserAvatarUploadRequest, opts ...grpc.CallOption) (*models.User, error)
+	// Удаление аватара
+	UserAvatarDelete(ctx context.Context, in *UserAvatarDeleteRequest, opts ...grpc.CallOption) (*models.User, error)
 }
 
 type userClient struct {
@@ -47,7 +55,7 @@ func NewUserClient(cc grpc.ClientConnInterface) UserClient {
 
 func (c *userClient) UserCreate(ctx context.Context, in *UserCreateRequest, opts ...grpc.CallOption) (*models.User, error) {
 	out := new(models.User)
-	err := c.cc.Invoke(ctx, "/github.moguchev.BD_Forum.api.User/UserCreate", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.User/UserCreate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -56,7 +64,7 @@ func (c *userClient) UserCreate(ctx context.Context, in *UserCreateRequest, opts
 
 func (c *userClient) UserGetOne(ctx context.Context, in *UserGetOneRequest, opts ...grpc.CallOption) (*models.User, error) {
 	out := new(models.User)
-	err := c.cc.Invoke(ctx, "/github.moguchev.BD_Forum.api.User/UserGetOne", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.User/UserGetOne", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -65,7 +73,25 @@ func (c *userClient) UserGetOne(ctx context.Context, in *UserGetOneRequest, opts
 
 func (c *userClient) UserUpdate(ctx context.Context, in *UserUpdateRequest, opts ...grpc.CallOption) (*models.User, error) {
 	out := new(models.User)
-	err := c.cc.Invoke(ctx, "/github.moguchev.BD_Forum.api.User/UserUpdate", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.User/UserUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UserAvatarUpload(ctx context.Context, in *UserAvatarUploadRequest, opts ...grpc.CallOption) (*models.User, error) {
+	out := new(models.User)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.User/UserAvatarUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UserAvatarDelete(ctx context.Context, in *UserAvatarDeleteRequest, opts ...grpc.CallOption) (*models.User, error) {
+	out := new(models.User)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.User/UserAvatarDelete", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -88,6 +114,14 @@ type UserServer interface {
 	//
 	// Изменение информации в профиле пользователя.
 	UserUpdate(context.Context, *UserUpdateRequest) (*models.User, error)
+	// Загрузка аватара
+	//
+	// Изображение PNG, JPEG или GIF до 2 МБ и не больше 4096x4096 обрезается до квадрата
+	// и сохраняется в стандартных размерах. Через HTTP шлюз загружается формой multipart/form-data
+	// с файлом в поле avatar: POST /api/user/{nickname}/avatar.
+	UserAvatarUpload(context.Context, *UserAvatarUploadRequest) (*models.User, error)
+	// Удаление аватара
+	UserAvatarDelete(context.Context, *UserAvatarDeleteRequest) (*models.User, error)
 	mustEmbedUnimplementedUserServer()
 }
 
@@ -104,6 +138,12 @@ func (UnimplementedUserServer) UserGetOne(context.Context, *UserGetOneRequest) (
 func (UnimplementedUserServer) UserUpdate(context.Context, *UserUpdateRequest) (*models.User, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserUpdate not implemented")
 }
+func (UnimplementedUserServer) UserAvatarUpload(context.Context, *UserAvatarUploadRequest) (*models.User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserAvatarUpload not implemented")
+}
+func (UnimplementedUserServer) UserAvatarDelete(context.Context, *UserAvatarDeleteRequest) (*models.User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserAvatarDelete not implemented")
+}
 func (UnimplementedUserServer) mustEmbedUnimplementedUserServer() {}
 
 // UnsafeUserServer may be embedded to opt out of forward compatibility for this service.
@@ -127,7 +167,7 @@ func _User_UserCreate_Handler(srv interface{}, ctx context.Context, dec func(int
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/github.moguchev.BD_Forum.api.User/UserCreate",
+		FullMethod: "/github.storm5758.Forum_test.api.User/UserCreate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(UserServer).UserCreate(ctx, req.(*UserCreateRequest))
@@ -145,7 +185,7 @@ func _User_UserGetOne_Handler(srv interface{}, ctx context.Context, dec func(int
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/github.moguchev.BD_Forum.api.User/UserGetOne",
+		FullMethod: "/github.storm5758.Forum_test.api.User/UserGetOne",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(UserServer).UserGetOne(ctx, req.(*UserGetOneRequest))
@@ -163,7 +203,7 @@ func _User_UserUpdate_Handler(srv interface{}, ctx context.Context, dec func(int
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/github.moguchev.BD_Forum.api.User/UserUpdate",
+		FullMethod: "/github.storm5758.Forum_test.api.User/UserUpdate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(UserServer).UserUpdate(ctx, req.(*UserUpdateRequest))
@@ -171,11 +211,47 @@ func _User_UserUpdate_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_UserAvatarUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserAvatarUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UserAvatarUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.User/UserAvatarUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UserAvatarUpload(ctx, req.(*UserAvatarUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UserAvatarDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserAvatarDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UserAvatarDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.User/UserAvatarDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UserAvatarDelete(ctx, req.(*UserAvatarDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // User_ServiceDesc is the grpc.ServiceDesc for User service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var User_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "github.moguchev.BD_Forum.api.User",
+	ServiceName: "github.storm5758.Forum_test.api.User",
 	HandlerType: (*UserServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
@@ -190,6 +266,14 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UserUpdate",
 			Handler:    _User_UserUpdate_Handler,
 		},
+		{
+			MethodName: "UserAvatarUpload",
+			Handler:    _User_UserAvatarUpload_Handler,
+		},
+		{
+			MethodName: "UserAvatarDelete",
+			Handler:    _User_UserAvatarDelete_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/user.proto",
@@ -0,0 +1,290 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: api/webhook.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// WebhookClient is the client API for Webhook service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WebhookClient interface {
+	// Регистрация веб-хука
+	//
+	// Регистрация адреса, на который POST-запросами отправляются события форума.
+	//
+	// Тело запроса - событие в JSON, заголовок X-Forum-Signature содержит подпись
+	// "sha256=" + hex(HMAC-SHA256(secret, X-Forum-Timestamp + "." + тело)).
+	// Доставка считается успешной при ответе 2xx, иначе повторяется с увеличивающейся паузой.
+	// Хост адреса должен разрешаться только в публичные адреса, перенаправления не выполняются.
+	WebhookCreate(ctx context.Context, in *WebhookCreateRequest, opts ...grpc.CallOption) (*WebhookCreateResponse, error)
+	// Веб-хуки форума
+	//
+	// Получение списка веб-хуков форума без секретов.
+	WebhookList(ctx context.Context, in *WebhookListRequest, opts ...grpc.CallOption) (*WebhookListResponse, error)
+	// Удаление веб-хука
+	//
+	// Удаление веб-хука вместе с историей его доставок.
+	WebhookDelete(ctx context.Context, in *WebhookDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Доставки веб-хука
+	//
+	// Получение последних доставок веб-хука, в том числе недоставленных событий.
+	WebhookDeliveries(ctx context.Context, in *WebhookDeliveriesRequest, opts ...grpc.CallOption) (*WebhookDeliveriesResponse, error)
+	// Повторная доставка
+	//
+	// Повторная отправка события, доставка которого завершена, например недоставленного.
+	WebhookRedeliver(ctx context.Context, in *WebhookRedeliverRequest, opts ...grpc.CallOption) (*WebhookDelivery, error)
+}
+
+type webhookClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWebhookClient(cc grpc.ClientConnInterface) WebhookClient {
+	return &webhookClient{cc}
+}
+
+func (c *webhookClient) WebhookCreate(ctx context.Context, in *WebhookCreateRequest, opts ...grpc.CallOption) (*WebhookCreateResponse, error) {
+	out := new(WebhookCreateResponse)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Webhook/WebhookCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookClient) WebhookList(ctx context.Context, in *WebhookListRequest, opts ...grpc.CallOption) (*WebhookListResponse, error) {
+	out := new(WebhookListResponse)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Webhook/WebhookList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookClient) WebhookDelete(ctx context.Context, in *WebhookDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Webhook/WebhookDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookClient) WebhookDeliveries(ctx context.Context, in *WebhookDeliveriesRequest, opts ...grpc.CallOption) (*WebhookDeliveriesResponse, error) {
+	out := new(WebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Webhook/WebhookDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookClient) WebhookRedeliver(ctx context.Context, in *WebhookRedeliverRequest, opts ...grpc.CallOption) (*WebhookDelivery, error) {
+	out := new(WebhookDelivery)
+	err := c.cc.Invoke(ctx, "/github.storm5758.Forum_test.api.Webhook/WebhookRedeliver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WebhookServer is the server API for Webhook service.
+// All implementations must embed UnimplementedWebhookServer
+// for forward compatibility
+type WebhookServer interface {
+	// Регистрация веб-хука
+	//
+	// Регистрация адреса, на который POST-запросами отправляются события форума.
+	//
+	// Тело запроса - событие в JSON, заголовок X-Forum-Signature содержит подпись
+	// "sha256=" + hex(HMAC-SHA256(secret, X-Forum-Timestamp + "." + тело)).
+	// Доставка считается успешной при ответе 2xx, иначе повторяется с увеличивающейся паузой.
+	// Хост адреса должен разрешаться только в публичные адреса, перенаправления не выполняются.
+	WebhookCreate(context.Context, *WebhookCreateRequest) (*WebhookCreateResponse, error)
+	// Веб-хуки форума
+	//
+	// Получение списка веб-хуков форума без секретов.
+	WebhookList(context.Context, *WebhookListRequest) (*WebhookListResponse, error)
+	// Удаление веб-хука
+	//
+	// Удаление веб-хука вместе с историей его доставок.
+	WebhookDelete(context.Context, *WebhookDeleteRequest) (*emptypb.Empty, error)
+	// Доставки веб-хука
+	//
+	// Получение последних доставок веб-хука, в том числе недоставленных событий.
+	WebhookDeliveries(context.Context, *WebhookDeliveriesRequest) (*WebhookDeliveriesResponse, error)
+	// Повторная доставка
+	//
+	// Повторная отправка события, доставка которого завершена, например недоставленного.
+	WebhookRedeliver(context.Context, *WebhookRedeliverRequest) (*WebhookDelivery, error)
+	mustEmbedUnimplementedWebhookServer()
+}
+
+// UnimplementedWebhookServer must be embedded to have forward compatible implementations.
+type UnimplementedWebhookServer struct {
+}
+
+func (UnimplementedWebhookServer) WebhookCreate(context.Context, *WebhookCreateRequest) (*WebhookCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebhookCreate not implemented")
+}
+func (UnimplementedWebhookServer) WebhookList(context.Context, *WebhookListRequest) (*WebhookListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebhookList not implemented")
+}
+func (UnimplementedWebhookServer) WebhookDelete(context.Context, *WebhookDeleteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebhookDelete not implemented")
+}
+func (UnimplementedWebhookServer) WebhookDeliveries(context.Context, *WebhookDeliveriesRequest) (*WebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebhookDeliveries not implemented")
+}
+func (UnimplementedWebhookServer) WebhookRedeliver(context.Context, *WebhookRedeliverRequest) (*WebhookDelivery, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebhookRedeliver not implemented")
+}
+func (UnimplementedWebhookServer) mustEmbedUnimplementedWebhookServer() {}
+
+// UnsafeWebhookServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WebhookServer will
+// result in compilation errors.
+type UnsafeWebhookServer interface {
+	mustEmbedUnimplementedWebhookServer()
+}
+
+func RegisterWebhookServer(s grpc.ServiceRegistrar, srv WebhookServer) {
+	s.RegisterService(&Webhook_ServiceDesc, srv)
+}
+
+func _Webhook_WebhookCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServer).WebhookCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.Webhook/WebhookCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServer).WebhookCreate(ctx, req.(*WebhookCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Webhook_WebhookList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServer).WebhookList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.Webhook/WebhookList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServer).WebhookList(ctx, req.(*WebhookListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Webhook_WebhookDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServer).WebhookDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.Webhook/WebhookDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServer).WebhookDelete(ctx, req.(*WebhookDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Webhook_WebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServer).WebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.Webhook/WebhookDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServer).WebhookDeliveries(ctx, req.(*WebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Webhook_WebhookRedeliver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookRedeliverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServer).WebhookRedeliver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/github.storm5758.Forum_test.api.Webhook/WebhookRedeliver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServer).WebhookRedeliver(ctx, req.(*WebhookRedeliverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Webhook_ServiceDesc is the grpc.ServiceDesc for Webhook service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Webhook_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "github.storm5758.Forum_test.api.Webhook",
+	HandlerType: (*WebhookServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WebhookCreate",
+			Handler:    _Webhook_WebhookCreate_Handler,
+		},
+		{
+			MethodName: "WebhookList",
+			Handler:    _Webhook_WebhookList_Handler,
+		},
+		{
+			MethodName: "WebhookDelete",
+			Handler:    _Webhook_WebhookDelete_Handler,
+		},
+		{
+			MethodName: "WebhookDeliveries",
+			Handler:    _Webhook_WebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "WebhookRedeliver",
+			Handler:    _Webhook_WebhookRedeliver_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/webhook.proto",
+}